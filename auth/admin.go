@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/models"
+)
+
+// adminRole returns the role of the admin whose key is apiKey. The bootstrap key of the config has
+// models.AdminRoleAdmin unless it is disabled.
+// Errors with ErrUnauthenticated if no admin has the key.
+func (s *service) adminRole(apiKey string) (string, error) {
+	if apiKey == "" {
+		return "", ErrUnauthenticated
+	} else if s.xlnApiKey == apiKey && s.bootstrapKeyDisabled {
+		log.WithError(ErrUnauthenticated).Warn("failed to authenticate admin. Reason: bootstrap api key is disabled")
+		return "", ErrUnauthenticated
+	} else if s.xlnApiKey == apiKey {
+		return models.AdminRoleAdmin, nil
+	}
+	if admin, err := (*s.admins).Authenticate(apiKey); err == models.ErrAdminNotFound {
+		return "", ErrUnauthenticated
+	} else if err != nil {
+		log.WithError(err).Error("failed to authenticate admin")
+		return "", ErrInternal
+	} else {
+		return admin.Role, nil
+	}
+}
+
+// authorizeAdmin reads the admin credentials from the request context, and returns the role of the admin that they
+// belong to. Errors with ErrUnauthenticated if they do not belong to an admin, and with ErrInsufficientScope if the
+// role of the admin does not allow the selector.
+func (s *service) authorizeAdmin(ctx context.Context, selector string) (string, error) {
+	md, err := getMetadata(ctx)
+	if err != nil {
+		return "", err
+	}
+	apiKey, err := getStringHeader(md, AdminApiKeyHeader)
+	if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"selector": selector,
+		}).Warn("failed to authenticate admin")
+		return "", err
+	}
+	role, err := s.adminRole(apiKey)
+	if err == ErrUnauthenticated {
+		log.WithError(err).WithFields(log.Fields{
+			"selector": selector,
+			"apiKey":   apiKey,
+		}).Warn("failed to authenticate admin. Reason: unrecognized api key was used")
+		return "", err
+	} else if err != nil {
+		return "", err
+	} else if !adminRoleAllows(role, selector) {
+		log.WithError(ErrInsufficientScope).WithFields(log.Fields{
+			"selector": selector,
+			"role":     role,
+		}).Warn("failed to authorize admin")
+		return role, ErrInsufficientScope
+	}
+	return role, nil
+}
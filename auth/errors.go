@@ -8,10 +8,16 @@ var (
 	MsgInvalidHeaderFormat     = "invalid header format"
 	MsgMissingUsername         = "missing x-username"
 	MsgMissingUsernameOrWallet = "missing x-username or wallet"
+	MsgInsufficientScope       = "credentials do not allow the method"
+	MsgAmountNotAllowed        = "amount exceeds the maximum that the credentials allow"
+	MsgNoPolicy                = "method has no authorization policy"
 	ErrUnauthenticated         = errors.New("authentication failed")
 	ErrInvalidHeaderFormat     = errors.New(MsgInvalidHeaderFormat)
 	ErrParsingContext          = errors.New("unable to get metadata from context")
 	ErrMissingUsername         = errors.New(MsgMissingUsername)
 	ErrMissingUsernameOrWallet = errors.New(MsgMissingUsernameOrWallet)
 	ErrInternal                = errors.New("internal error")
+	ErrInsufficientScope       = errors.New(MsgInsufficientScope)
+	ErrAmountNotAllowed        = errors.New(MsgAmountNotAllowed)
+	ErrNoPolicy                = errors.New(MsgNoPolicy)
 )
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/resources/macaroon"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// header that the HTTP gateway forwards the address of HTTP clients in
+const forwardedForHeader = "x-forwarded-for"
+
+// authorizeMacaroon errors with ErrUnauthenticated unless the hex encoded macaroon was baked by XLN for the user, and
+// for the user's wallet if walletId is set, and its caveats allow the request. Errors with ErrInsufficientScope if the
+// caveats do not allow the selector, or if a macaroon of a wallet is used for an RPC of its user.
+func (s *service) authorizeMacaroon(ctx context.Context, encoded, username string, walletId *string,
+	selector string) error {
+	owner, caveats, err := (*s.macaroons).Verify(encoded)
+	if err != nil || owner != username {
+		return ErrUnauthenticated
+	}
+	if walletId == nil {
+		if caveats.WalletID != nil {
+			log.WithError(ErrInsufficientScope).WithFields(log.Fields{
+				"selector": selector,
+				"user":     username,
+				"wallet":   *caveats.WalletID,
+			}).Warn("failed to authorize macaroon. Reason: macaroon of a wallet was used for an RPC of its user")
+			return ErrInsufficientScope
+		} else if exists, err := s.userExists(username); err != nil || !exists {
+			return ErrUnauthenticated
+		}
+	} else if (caveats.WalletID != nil && *caveats.WalletID != *walletId) || !s.userMatchesWallet(username, *walletId) {
+		return ErrUnauthenticated
+	}
+	if caveats.IPAddress != nil {
+		if ip := requestIP(ctx); ip == nil || !ip.Equal(net.ParseIP(*caveats.IPAddress)) {
+			log.WithError(ErrUnauthenticated).WithFields(log.Fields{
+				"selector": selector,
+				"user":     username,
+				"ip":       ip,
+			}).Warn("failed to authorize macaroon. Reason: request is not from the ip address of the macaroon")
+			return ErrUnauthenticated
+		}
+	}
+	if !caveats.AllowsMethod(selector) {
+		log.WithError(ErrInsufficientScope).WithFields(log.Fields{
+			"selector": selector,
+			"user":     username,
+		}).Warn("failed to authorize macaroon")
+		return ErrInsufficientScope
+	}
+	return nil
+}
+
+// macaroonCaveats returns the caveats of the macaroon of the request context, or nil if the credentials of the
+// context are not a macaroon.
+func (s *service) macaroonCaveats(ctx context.Context) (*macaroon.Caveats, error) {
+	md, err := getMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	encoded, keyType, err := getApiKey(md)
+	if err != nil {
+		return nil, err
+	} else if keyType != Macaroon {
+		return nil, nil
+	}
+	_, caveats, err := (*s.macaroons).Verify(encoded)
+	return caveats, err
+}
+
+// requestIP returns the address of the client of the request context. The address of clients of the HTTP gateway,
+// which connects to the gRPC server over loopback, is the one that the gateway forwards.
+func requestIP(ctx context.Context) net.IP {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return nil
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return ip
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md[forwardedForHeader]) > 0 {
+		forwarded := strings.Split(md[forwardedForHeader][len(md[forwardedForHeader])-1], ",")
+		return net.ParseIP(strings.TrimSpace(forwarded[len(forwarded)-1]))
+	}
+	return ip
+}
@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Requirement is the identity that the callers of an RPC must be authorized as.
+type Requirement int
+
+const (
+	// anyone may call the RPC. RPCs that need to identify their callers do so themselves
+	RequireNone Requirement = iota
+	// the admin credentials
+	RequireAdmin
+	// the credentials of the user of the x-username header
+	RequireUser
+	// the credentials of the wallet that the request sets, or of the wallet's user
+	RequireWallet
+	// RequireWallet if the request sets a wallet, and RequireUser otherwise
+	RequireUserOrWallet
+)
+
+// policies are the identities that the callers of each RPC must be authorized as, by full method name. RPCs that are
+// not listed cannot be called. The scopes that scoped API keys need for each RPC are in selectorScopes.
+var policies = map[string]Requirement{
+	"/xlnrpc.Xln/GetInfo":                   RequireNone,
+	"/xlnrpc.Xln/Validate":                  RequireNone,
+	"/xlnrpc.Xln/UserLogin":                 RequireNone,
+	"/xlnrpc.Xln/WalletLogin":               RequireNone,
+	"/xlnrpc.Xln/LoginStatus":               RequireNone,
+	"/xlnrpc.Xln/CreateWallet":              RequireUser,
+	"/xlnrpc.Xln/ListWallets":               RequireUser,
+	"/xlnrpc.Xln/ListUserTransactions":      RequireUser,
+	"/xlnrpc.Xln/GetUser":                   RequireUser,
+	"/xlnrpc.Xln/SetPaymentDefaults":        RequireUser,
+	"/xlnrpc.Xln/UserLinkWallet":            RequireUser,
+	"/xlnrpc.Xln/CreateApiKey":              RequireUser,
+	"/xlnrpc.Xln/ListApiKeys":               RequireUser,
+	"/xlnrpc.Xln/RevokeApiKey":              RequireUser,
+	"/xlnrpc.Xln/DeleteWallet":              RequireWallet,
+	"/xlnrpc.Xln/UpdateWalletOptions":       RequireWallet,
+	"/xlnrpc.Xln/GetWallet":                 RequireWallet,
+	"/xlnrpc.Xln/ListWalletTransactions":    RequireWallet,
+	"/xlnrpc.Xln/GetWalletTransaction":      RequireWallet,
+	"/xlnrpc.Xln/CreateInvoice":             RequireWallet,
+	"/xlnrpc.Xln/ListWalletInvoices":        RequireWallet,
+	"/xlnrpc.Xln/GetWalletInvoice":          RequireWallet,
+	"/xlnrpc.Xln/CreateHoldInvoice":         RequireWallet,
+	"/xlnrpc.Xln/SettleHoldInvoice":         RequireWallet,
+	"/xlnrpc.Xln/CancelHoldInvoice":         RequireWallet,
+	"/xlnrpc.Xln/PayInvoice":                RequireWallet,
+	"/xlnrpc.Xln/PayInvoiceSync":            RequireWallet,
+	"/xlnrpc.Xln/SendKeysend":               RequireWallet,
+	"/xlnrpc.Xln/QuotePayment":              RequireWallet,
+	"/xlnrpc.Xln/BatchPayInvoices":          RequireWallet,
+	"/xlnrpc.Xln/ListWalletPendingInvoices": RequireWallet,
+	"/xlnrpc.Xln/ListWalletPendingPayments": RequireWallet,
+	"/xlnrpc.Xln/Transfer":                  RequireWallet,
+	"/xlnrpc.Xln/BatchTransfer":             RequireWallet,
+	"/xlnrpc.Xln/TransferToUser":            RequireWallet,
+	"/xlnrpc.Xln/LinkWallet":                RequireWallet,
+	"/xlnrpc.Xln/CreateLNURLW":              RequireWallet,
+	"/xlnrpc.Xln/GetLNURLW":                 RequireWallet,
+	"/xlnrpc.Xln/CreateLNURLP":              RequireWallet,
+	"/xlnrpc.Xln/GetLNURLP":                 RequireWallet,
+	"/xlnrpc.Xln/CreateSchedule":            RequireWallet,
+	"/xlnrpc.Xln/ListSchedules":             RequireWallet,
+	"/xlnrpc.Xln/CancelSchedule":            RequireWallet,
+	"/xlnrpc.Xln/SubscribeWalletEvents":     RequireWallet,
+	"/xlnrpc.Xln/CreateWebhook":             RequireUserOrWallet,
+	"/xlnrpc.Xln/ListWebhooks":              RequireUserOrWallet,
+	"/xlnrpc.Xln/DeleteWebhook":             RequireUserOrWallet,
+	"/xlnrpc.Xln/RotateApiKey":              RequireUserOrWallet,
+
+	"/xlnrpc.XlnAdmin/GetInfo":                 RequireAdmin,
+	"/xlnrpc.XlnAdmin/CreateUser":              RequireAdmin,
+	"/xlnrpc.XlnAdmin/DeleteUser":              RequireAdmin,
+	"/xlnrpc.XlnAdmin/UpdateWallet":            RequireAdmin,
+	"/xlnrpc.XlnAdmin/ListUsers":               RequireAdmin,
+	"/xlnrpc.XlnAdmin/AdminDeleteWallet":       RequireAdmin,
+	"/xlnrpc.XlnAdmin/GetInvoice":              RequireAdmin,
+	"/xlnrpc.XlnAdmin/ListPendingInvoices":     RequireAdmin,
+	"/xlnrpc.XlnAdmin/ListPendingPayments":     RequireAdmin,
+	"/xlnrpc.XlnAdmin/GetReconciliationReport": RequireAdmin,
+	"/xlnrpc.XlnAdmin/GetUserFeeSchedule":      RequireAdmin,
+	"/xlnrpc.XlnAdmin/SetUserFeeSchedule":      RequireAdmin,
+	"/xlnrpc.XlnAdmin/BakeMacaroon":            RequireAdmin,
+	"/xlnrpc.XlnAdmin/CreateAdmin":             RequireAdmin,
+	"/xlnrpc.XlnAdmin/ListAdmins":              RequireAdmin,
+	"/xlnrpc.XlnAdmin/UpdateAdminRole":         RequireAdmin,
+	"/xlnrpc.XlnAdmin/RotateAdminKey":          RequireAdmin,
+	"/xlnrpc.XlnAdmin/DeleteAdmin":             RequireAdmin,
+
+	"/xlnrpc.LNURL/Auth":              RequireNone,
+	"/xlnrpc.LNURL/RequestWithdraw":   RequireNone,
+	"/xlnrpc.LNURL/Withdraw":          RequireNone,
+	"/xlnrpc.LNURL/RequestPay":        RequireNone,
+	"/xlnrpc.LNURL/Pay":               RequireNone,
+	"/xlnrpc.LNURL/RequestAddressPay": RequireNone,
+	"/xlnrpc.LNURL/AddressPay":        RequireNone,
+}
+
+// HasPolicy returns true if the RPC of fullMethod may be called.
+func HasPolicy(fullMethod string) bool {
+	_, ok := policies[fullMethod]
+	return ok
+}
+
+// selectorOf returns the selector of the RPC of fullMethod, such as Xln.PayInvoice for /xlnrpc.Xln/PayInvoice.
+func selectorOf(fullMethod string) string {
+	service, method := "", strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(method, "/"); i >= 0 {
+		service, method = method[:i], method[i+1:]
+	}
+	return service[strings.LastIndex(service, ".")+1:] + "." + method
+}
+
+type identityKey struct{}
+
+// IdentityFromContext returns the identity that the caller of the RPC of ctx was authorized as, which is empty if
+// the RPC does not require callers to be authorized.
+func IdentityFromContext(ctx context.Context) *IdentityType {
+	if identity, ok := ctx.Value(identityKey{}).(*IdentityType); ok {
+		return identity
+	}
+	return &IdentityType{}
+}
+
+func (s *service) Authorize(ctx context.Context, fullMethod string, request interface{}) (context.Context, error) {
+	requirement, ok := policies[fullMethod]
+	if !ok {
+		log.WithField("method", fullMethod).Error("refused call of method without an authorization policy")
+		return ctx, ErrNoPolicy
+	}
+	selector := selectorOf(fullMethod)
+	identity := &IdentityType{}
+	var walletId string
+	if requirement == RequireWallet || requirement == RequireUserOrWallet {
+		if req, ok := request.(interface{ GetWalletId() string }); ok {
+			walletId = req.GetWalletId()
+		} else {
+			log.WithField("method", fullMethod).Error("authorization policy requires a wallet that the request does not set")
+			return ctx, ErrInternal
+		}
+		if requirement == RequireUserOrWallet && walletId == "" {
+			requirement = RequireUser
+		}
+	}
+
+	switch requirement {
+	case RequireNone:
+		return ctx, nil
+	case RequireAdmin:
+		role, err := s.authorizeAdmin(ctx, selector)
+		if err != nil {
+			return ctx, err
+		}
+		identity.Admin, identity.Role = true, role
+	case RequireUser:
+		username, err := s.ValidateUserCredentials(ctx, selector)
+		if err != nil {
+			return ctx, err
+		}
+		identity.User = username
+	default:
+		username, err := s.ValidateWalletCredentials(ctx, walletId, selector)
+		if err != nil {
+			return ctx, err
+		}
+		identity.User, identity.Wallet = username, walletId
+	}
+	return context.WithValue(ctx, identityKey{}, identity), nil
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/xlnrpc"
+	"google.golang.org/grpc"
+)
+
+func TestEveryRpcHasPolicy(t *testing.T) {
+	for _, desc := range []grpc.ServiceDesc{xlnrpc.Xln_ServiceDesc, xlnrpc.XlnAdmin_ServiceDesc, xlnrpc.LNURL_ServiceDesc} {
+		for _, method := range desc.Methods {
+			require.True(t, HasPolicy("/"+desc.ServiceName+"/"+method.MethodName), method.MethodName)
+		}
+		for _, stream := range desc.Streams {
+			require.True(t, HasPolicy("/"+desc.ServiceName+"/"+stream.StreamName), stream.StreamName)
+		}
+	}
+}
+
+func TestSelectorOf(t *testing.T) {
+	require.Equal(t, "Xln.PayInvoice", selectorOf("/xlnrpc.Xln/PayInvoice"))
+	require.Equal(t, "XlnAdmin.GetInfo", selectorOf("/xlnrpc.XlnAdmin/GetInfo"))
+}
+
+func TestAuthorizeRejectsRpcsWithoutPolicy(t *testing.T) {
+	s := &service{}
+	ctx := context.Background()
+	_, err := s.Authorize(ctx, "/xlnrpc.Xln/Nonexistent", &xlnrpc.GetWalletRequest{})
+	require.Equal(t, ErrNoPolicy, err)
+
+	authorized, err := s.Authorize(ctx, "/xlnrpc.Xln/GetInfo", &xlnrpc.GetInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &IdentityType{}, IdentityFromContext(authorized))
+
+	_, err = s.Authorize(ctx, "/xlnrpc.Xln/GetWallet", &xlnrpc.GetInfoRequest{})
+	require.Equal(t, ErrInternal, err, "requests of wallet RPCs must set a wallet")
+}
+
+func TestAdminRoleAllows(t *testing.T) {
+	for selector := range adminSelectorRoles {
+		service, method := selector[:strings.Index(selector, ".")], selector[strings.Index(selector, ".")+1:]
+		require.True(t, HasPolicy("/xlnrpc."+service+"/"+method), selector)
+	}
+	require.True(t, adminRoleAllows(models.AdminRoleAuditor, "XlnAdmin.ListUsers"))
+	require.True(t, adminRoleAllows(models.AdminRoleAuditor, "Xln.GetWallet"))
+	require.False(t, adminRoleAllows(models.AdminRoleAuditor, "XlnAdmin.UpdateWallet"))
+	require.False(t, adminRoleAllows(models.AdminRoleAuditor, "Xln.PayInvoice"))
+	require.True(t, adminRoleAllows(models.AdminRoleSupport, "XlnAdmin.UpdateWallet"))
+	require.False(t, adminRoleAllows(models.AdminRoleSupport, "XlnAdmin.CreateUser"))
+	require.False(t, adminRoleAllows(models.AdminRoleSupport, "XlnAdmin.CreateAdmin"))
+	require.True(t, adminRoleAllows(models.AdminRoleAdmin, "XlnAdmin.CreateAdmin"))
+	require.False(t, adminRoleAllows("unknown", "XlnAdmin.GetInfo"))
+}
+
+func TestBootstrapKeyCanBeDisabled(t *testing.T) {
+	s := &service{xlnApiKey: "hodl"}
+	role, err := s.adminRole("hodl")
+	require.NoError(t, err)
+	require.Equal(t, models.AdminRoleAdmin, role)
+
+	s.bootstrapKeyDisabled = true
+	_, err = s.adminRole("hodl")
+	require.Equal(t, ErrUnauthenticated, err)
+}
@@ -0,0 +1,87 @@
+package auth
+
+import "github.com/xbit-gg/xln/models"
+
+// selectorScopes are the scopes that allow scoped API keys to call each RPC, by selector. Keys with any of the
+// scopes of a selector, or with models.ScopeAdmin, may call it. Selectors that are not listed require
+// models.ScopeAdmin, which is also the case for managing wallets, settings, webhooks and API keys.
+var selectorScopes = map[string][]string{
+	"Xln.GetUser":                   {models.ScopeRead},
+	"Xln.ListWallets":               {models.ScopeRead},
+	"Xln.GetWallet":                 {models.ScopeRead},
+	"Xln.ListUserTransactions":      {models.ScopeRead},
+	"Xln.ListWalletTransactions":    {models.ScopeRead},
+	"Xln.GetWalletTransaction":      {models.ScopeRead},
+	"Xln.ListWalletPendingPayments": {models.ScopeRead},
+	"Xln.GetLNURLW":                 {models.ScopeRead},
+	"Xln.GetLNURLP":                 {models.ScopeRead},
+	"Xln.ListSchedules":             {models.ScopeRead},
+	"Xln.ListWebhooks":              {models.ScopeRead},
+	"Xln.SubscribeWalletEvents":     {models.ScopeRead},
+
+	"Xln.ListWalletInvoices":        {models.ScopeRead, models.ScopeInvoice},
+	"Xln.GetWalletInvoice":          {models.ScopeRead, models.ScopeInvoice},
+	"Xln.ListWalletPendingInvoices": {models.ScopeRead, models.ScopeInvoice},
+	"Xln.CreateInvoice":             {models.ScopeInvoice},
+	"Xln.CreateHoldInvoice":         {models.ScopeInvoice},
+	"Xln.SettleHoldInvoice":         {models.ScopeInvoice},
+	"Xln.CancelHoldInvoice":         {models.ScopeInvoice},
+	"Xln.CreateLNURLP":              {models.ScopeInvoice},
+
+	"Xln.QuotePayment":     {models.ScopeRead, models.ScopePay},
+	"Xln.PayInvoice":       {models.ScopePay},
+	"Xln.PayInvoiceSync":   {models.ScopePay},
+	"Xln.BatchPayInvoices": {models.ScopePay},
+	"Xln.SendKeysend":      {models.ScopePay},
+	"Xln.CreateLNURLW":     {models.ScopePay},
+	"Xln.CreateSchedule":   {models.ScopePay},
+	"Xln.CancelSchedule":   {models.ScopePay},
+
+	"Xln.Transfer":       {models.ScopeTransfer},
+	"Xln.BatchTransfer":  {models.ScopeTransfer},
+	"Xln.TransferToUser": {models.ScopeTransfer},
+}
+
+// allowsSelector returns true if the key has one of the scopes that allow the RPC of the selector.
+func allowsSelector(key *models.ApiKey, selector string) bool {
+	if key.HasScope(models.ScopeAdmin) {
+		return true
+	}
+	for _, scope := range selectorScopes[selector] {
+		if key.HasScope(scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// adminSelectorRoles are the least privileged roles of admins that may call each RPC of the XlnAdmin service, by
+// selector. Selectors that are not listed require models.AdminRoleAdmin, which is also the case for managing admins.
+var adminSelectorRoles = map[string]string{
+	"XlnAdmin.GetInfo":                 models.AdminRoleAuditor,
+	"XlnAdmin.ListUsers":               models.AdminRoleAuditor,
+	"XlnAdmin.GetInvoice":              models.AdminRoleAuditor,
+	"XlnAdmin.ListPendingInvoices":     models.AdminRoleAuditor,
+	"XlnAdmin.ListPendingPayments":     models.AdminRoleAuditor,
+	"XlnAdmin.GetReconciliationReport": models.AdminRoleAuditor,
+	"XlnAdmin.GetUserFeeSchedule":      models.AdminRoleAuditor,
+
+	// admins with the support role may only lock and unlock wallets, which the RPC enforces
+	"XlnAdmin.UpdateWallet": models.AdminRoleSupport,
+}
+
+// adminRoleAllows returns true if admins of the role may call the RPC of the selector. Admins without
+// models.AdminRoleAdmin may only call the RPCs of users and wallets that models.ScopeRead allows.
+func adminRoleAllows(role, selector string) bool {
+	if role == models.AdminRoleAdmin {
+		return true
+	} else if required, ok := adminSelectorRoles[selector]; ok {
+		return models.AdminRoleAllows(role, required)
+	}
+	for _, scope := range selectorScopes[selector] {
+		if scope == models.ScopeRead {
+			return models.AdminRoleAllows(role, models.AdminRoleAuditor)
+		}
+	}
+	return false
+}
@@ -7,69 +7,132 @@ import (
 	"github.com/patrickmn/go-cache"
 	log "github.com/sirupsen/logrus"
 	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/resources/admin"
+	"github.com/xbit-gg/xln/resources/apikey"
+	"github.com/xbit-gg/xln/resources/macaroon"
 	"github.com/xbit-gg/xln/resources/user"
 	"github.com/xbit-gg/xln/resources/wallet"
+	"github.com/xbit-gg/xln/util"
 )
 
 type Service interface {
 	GetIdentityOfApiKey(apiKey string) (*IdentityType, error)
 
-	// ValidateAdminCredentials reads credentials from the request context and compares them to admin API key.
-	// Takes the name of the selector and logs it.
-	// An error is returned if the credentials do not match.
+	// Authorize validates the credentials of the request context as the policy of the RPC of fullMethod requires for
+	// request, and returns the context with the identity that they were authorized as, which IdentityFromContext
+	// reads.
+	// Errors with ErrNoPolicy if the RPC has no policy, and otherwise like the Validate methods.
+	Authorize(ctx context.Context, fullMethod string, request interface{}) (context.Context, error)
+
+	// ValidateAdminCredentials reads credentials from the request context and compares them to the keys of admin
+	// accounts, and to the bootstrap admin API key unless it is disabled.
+	// Takes the name of the selector, which the role of the admin must allow, and logs it.
+	// An error is returned if the credentials do not match, and ErrInsufficientScope if the role of the admin does
+	// not allow the selector.
 	ValidateAdminCredentials(ctx context.Context, selector string) error
 	// ValidateUserCredentials reads credentials from the request context and compares them to the API key of the user.
-	// The credentials are also compared with the admin credentials.
-	// Takes the name of the selector and logs it.
-	// An error is returned if the credentials do not match.
+	// The credentials are also compared with the admin credentials, with the scoped API keys of the user, and with the
+	// macaroons of the user. Admins without the admin role may only call the RPCs that the read scope allows.
+	// Takes the name of the selector, which scoped keys must have a scope for, and logs it.
+	// An error is returned if the credentials do not match, and ErrInsufficientScope if the scoped key or macaroon
+	// that they match does not allow the selector.
 	ValidateUserCredentials(ctx context.Context, selector string) (username string, err error)
 	// ValidateWalletCredentials reads creds from the request context and compares them to the API key of the wallet id.
-	// The credentials are also compared with the admin and user credentials.
-	// Takes the name of the selector and logs it.
-	// An error is returned if the credentials do not match.
+	// The credentials are also compared with the admin and user credentials, with the scoped API keys of the
+	// wallet and its user, and with the macaroons of the wallet and its user.
+	// Takes the name of the selector, which scoped keys must have a scope for, and logs it.
+	// An error is returned if the credentials do not match, and ErrInsufficientScope if the scoped key or macaroon
+	// that they match does not allow the selector.
 	ValidateWalletCredentials(ctx context.Context, walletId string, selector string) (username string, err error)
+	// HasFullAccess returns true unless the credentials of the request context are a scoped API key without the admin
+	// scope, a macaroon with caveats other than its wallet, or the key of an admin without the admin role.
+	// Credentials with full access may see the secrets of wallets and manage API keys.
+	// The credentials must already have been validated.
+	HasFullAccess(ctx context.Context) bool
+	// MaxPaymentAmount returns the maximum amount of each payment or transfer that the credentials of the request
+	// context allow, and whether they limit it at all. Only macaroons limit amounts.
+	// The credentials must already have been validated.
+	MaxPaymentAmount(ctx context.Context) (maxAmountMsat uint64, limited bool)
+	// CredentialsLifetime returns the ID of the scoped API key of the request context if the credentials are one,
+	// and the time that the credentials expire if they do. Only scoped keys can be revoked, and only scoped keys and
+	// macaroons expire.
+	// The credentials must already have been validated.
+	CredentialsLifetime(ctx context.Context) (apiKeyId *string, expiresAt *time.Time, err error)
+	// InvalidateApiKey forgets the cached API key of the user, or of the user's wallet if walletId is set, so that
+	// the key is looked up again once it has been rotated.
+	InvalidateApiKey(username string, walletId *string)
 }
 
 type service struct {
 	xlnApiKey string
-	users     *user.Manager
-	wallets   *wallet.Manager
+	// whether xlnApiKey is rejected, so that only admin accounts are admins
+	bootstrapKeyDisabled bool
+	users                *user.Manager
+	wallets              *wallet.Manager
+	apiKeys              *apikey.Manager
+	macaroons            *macaroon.Manager
+	admins               *admin.Manager
 
-	userKeys       *cache.Cache
-	walletKeys     *cache.Cache
-	walletUserKeys *cache.Cache
+	// hashes of the API keys of users by username, and of wallets by username/walletId
+	userKeys    *cache.Cache
+	walletKeys  *cache.Cache
+	userWallets *cache.Cache
 }
 
-// NewService returns a new authentication service.
-func NewService(xlnApiKey string, userManager *user.Manager, walletManager *wallet.Manager) Service {
+// NewService returns a new authentication service. The bootstrap admin key xlnApiKey is rejected if
+// bootstrapKeyDisabled is set.
+func NewService(xlnApiKey string, bootstrapKeyDisabled bool, userManager *user.Manager,
+	walletManager *wallet.Manager, apiKeyManager *apikey.Manager, macaroonManager *macaroon.Manager,
+	adminManager *admin.Manager) Service {
 	return &service{
-		xlnApiKey:      xlnApiKey,
-		users:          userManager,
-		wallets:        walletManager,
-		userKeys:       cache.New(12*time.Hour, 24*time.Hour),
-		walletKeys:     cache.New(12*time.Hour, 24*time.Hour),
-		walletUserKeys: cache.New(12*time.Hour, 24*time.Hour),
+		xlnApiKey:            xlnApiKey,
+		bootstrapKeyDisabled: bootstrapKeyDisabled,
+		users:                userManager,
+		wallets:              walletManager,
+		apiKeys:              apiKeyManager,
+		macaroons:            macaroonManager,
+		admins:               adminManager,
+		userKeys:             cache.New(12*time.Hour, 24*time.Hour),
+		walletKeys:           cache.New(12*time.Hour, 24*time.Hour),
+		userWallets:          cache.New(12*time.Hour, 24*time.Hour),
 	}
 }
 
 type IdentityType struct {
-	Admin  bool
-	User   string
+	Admin bool
+	// role of the admin if Admin is set
+	Role string
+	// user of the key, or the user that an RPC was authorized for
+	User string
+	// wallet of the key, or the wallet that an RPC of a wallet was authorized for
 	Wallet string
+	// scopes of the key if it is a scoped key
+	Scopes []string
 }
 
 func (s *service) GetIdentityOfApiKey(apiKey string) (*IdentityType, error) {
 	// check if admin key
-	if s.xlnApiKey == apiKey {
-		log.Info("admin requested information on its apikey privileges")
-		return &IdentityType{Admin: true}, nil
+	if role, err := s.adminRole(apiKey); err == nil {
+		log.WithField("role", role).Info("admin requested information on its apikey privileges")
+		return &IdentityType{Admin: true, Role: role}, nil
+	} else if err != ErrUnauthenticated {
+		return nil, err
 	}
 	// check if user key
 	if user, err := (*s.users).GetUserWithApiKey(apiKey); err == models.ErrUserNotFound {
 		// then check if api key belongs to wallet
 		if wallet, err := (*s.wallets).GetWalletWithApiKey(apiKey); err == models.ErrWalletNotFound {
-			// key doesn't belong to any resource.
-			return nil, nil
+			// then check if api key is a scoped key
+			if key, err := (*s.apiKeys).Authenticate(apiKey); err == models.ErrApiKeyNotFound {
+				// key doesn't belong to any resource.
+				return nil, nil
+			} else if err != nil {
+				return nil, err
+			} else if key.WalletID != nil {
+				return &IdentityType{Wallet: *key.WalletID, Scopes: key.ScopeList()}, nil
+			} else {
+				return &IdentityType{User: key.Username, Scopes: key.ScopeList()}, nil
+			}
 		} else if err != nil {
 			return nil, err
 		} else if wallet != nil {
@@ -89,26 +152,8 @@ func (s *service) GetIdentityOfApiKey(apiKey string) (*IdentityType, error) {
 }
 
 func (s *service) ValidateAdminCredentials(ctx context.Context, selector string) error {
-	md, err := getMetadata(ctx)
-	if err != nil {
-		return err
-	}
-	apiKey, err := getStringHeader(md, AdminApiKeyHeader)
-	if err != nil {
-		log.WithError(err).WithFields(log.Fields{
-			"selector": selector,
-		}).Warn("failed to authenticate admin")
-		return err
-	}
-	if s.xlnApiKey == apiKey {
-		return nil
-	} else {
-		log.WithError(ErrUnauthenticated).WithFields(log.Fields{
-			"selector": selector,
-			"apiKey":   apiKey,
-		}).Warn("failed to authenticate admin. Reason: unrecognized api key was used")
-		return ErrUnauthenticated
-	}
+	_, err := s.authorizeAdmin(ctx, selector)
+	return err
 }
 
 func (s *service) ValidateUserCredentials(ctx context.Context, selector string) (string, error) {
@@ -126,13 +171,22 @@ func (s *service) ValidateUserCredentials(ctx context.Context, selector string)
 	}
 	switch keyType {
 	case Admin:
-		if s.xlnApiKey != apiKey {
+		if role, err := s.adminRole(apiKey); err == ErrUnauthenticated {
 			log.WithError(ErrUnauthenticated).WithFields(log.Fields{
 				"selector": selector,
 				"apiKey":   apiKey,
 				"user":     username,
 			}).Warn("failed to authenticate admin. Reason: unrecognized api key was used")
 			return username, ErrUnauthenticated
+		} else if err != nil {
+			return username, err
+		} else if !adminRoleAllows(role, selector) {
+			log.WithError(ErrInsufficientScope).WithFields(log.Fields{
+				"selector": selector,
+				"role":     role,
+				"user":     username,
+			}).Warn("failed to authorize admin")
+			return username, ErrInsufficientScope
 		} else if exists, err := s.userExists(username); err == nil && exists {
 			log.WithFields(log.Fields{
 				"selector": selector,
@@ -145,6 +199,12 @@ func (s *service) ValidateUserCredentials(ctx context.Context, selector string)
 	case User:
 		if s.userKeyMatchesUser(username, apiKey) {
 			return username, nil
+		} else if err := s.authorizeScopedKey(apiKey, username, nil, selector); err != ErrUnauthenticated {
+			return username, err
+		}
+	case Macaroon:
+		if err := s.authorizeMacaroon(ctx, apiKey, username, nil, selector); err != ErrUnauthenticated {
+			return username, err
 		}
 	default:
 		return username, ErrInvalidHeaderFormat
@@ -176,7 +236,7 @@ func (s *service) ValidateWalletCredentials(ctx context.Context, walletId string
 
 	switch keyType {
 	case Admin:
-		if s.xlnApiKey != apiKey {
+		if role, err := s.adminRole(apiKey); err == ErrUnauthenticated {
 			log.WithError(ErrUnauthenticated).WithFields(log.Fields{
 				"selector": selector,
 				"apiKey":   apiKey,
@@ -184,6 +244,16 @@ func (s *service) ValidateWalletCredentials(ctx context.Context, walletId string
 				"wallet":   walletId,
 			}).Warn("failed to authenticate admin. Reason: unrecognized api key was used")
 			return username, ErrUnauthenticated
+		} else if err != nil {
+			return username, err
+		} else if !adminRoleAllows(role, selector) {
+			log.WithError(ErrInsufficientScope).WithFields(log.Fields{
+				"selector": selector,
+				"role":     role,
+				"user":     username,
+				"wallet":   walletId,
+			}).Warn("failed to authorize admin")
+			return username, ErrInsufficientScope
 		} else if exists, err := s.userExists(username); err == nil && exists {
 			log.WithFields(log.Fields{
 				"selector": selector,
@@ -196,12 +266,22 @@ func (s *service) ValidateWalletCredentials(ctx context.Context, walletId string
 		}
 	case User:
 		if s.userKeyMatchesUser(username, apiKey) &&
-			s.userMatchesWallet(username, walletId, apiKey) {
+			s.userMatchesWallet(username, walletId) {
+			return username, nil
+		} else if err := s.authorizeScopedKey(apiKey, username, nil, selector); err == ErrInsufficientScope {
+			return username, err
+		} else if err == nil && s.userMatchesWallet(username, walletId) {
 			return username, nil
 		}
 	case Wallet:
 		if s.walletKeyMatchesWallet(username, walletId, apiKey) {
 			return username, nil
+		} else if err := s.authorizeScopedKey(apiKey, username, &walletId, selector); err != ErrUnauthenticated {
+			return username, err
+		}
+	case Macaroon:
+		if err := s.authorizeMacaroon(ctx, apiKey, username, &walletId, selector); err != ErrUnauthenticated {
+			return username, err
 		}
 	default:
 		return username, ErrInvalidHeaderFormat
@@ -214,6 +294,94 @@ func (s *service) ValidateWalletCredentials(ctx context.Context, walletId string
 	return username, ErrUnauthenticated
 }
 
+func (s *service) HasFullAccess(ctx context.Context) bool {
+	md, err := getMetadata(ctx)
+	if err != nil {
+		return false
+	}
+	apiKey, keyType, err := getApiKey(md)
+	if err != nil {
+		return false
+	} else if keyType == Admin {
+		role, err := s.adminRole(apiKey)
+		return err == nil && role == models.AdminRoleAdmin
+	} else if keyType == Macaroon {
+		_, caveats, err := (*s.macaroons).Verify(apiKey)
+		// keys that restricted macaroons could create or rotate would not be restricted by their caveats
+		return err == nil && caveats.Methods == nil && caveats.MaxAmountMsat == nil && caveats.ExpiresAt == nil &&
+			caveats.IPAddress == nil
+	}
+	// validated credentials that are not scoped keys are the unscoped keys of users and wallets
+	if key, err := (*s.apiKeys).Authenticate(apiKey); err == models.ErrApiKeyNotFound {
+		return true
+	} else if err != nil {
+		return false
+	} else {
+		return key.HasScope(models.ScopeAdmin)
+	}
+}
+
+func (s *service) MaxPaymentAmount(ctx context.Context) (uint64, bool) {
+	caveats, err := s.macaroonCaveats(ctx)
+	if err != nil {
+		// credentials that cannot be read again allow nothing
+		return 0, true
+	} else if caveats == nil || caveats.MaxAmountMsat == nil {
+		return 0, false
+	}
+	return *caveats.MaxAmountMsat, true
+}
+
+func (s *service) CredentialsLifetime(ctx context.Context) (*string, *time.Time, error) {
+	md, err := getMetadata(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	apiKey, keyType, err := getApiKey(md)
+	if err != nil {
+		return nil, nil, err
+	} else if keyType == Admin {
+		return nil, nil, nil
+	} else if keyType == Macaroon {
+		_, caveats, err := (*s.macaroons).Verify(apiKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, caveats.ExpiresAt, nil
+	}
+	// validated credentials that are not scoped keys are the unscoped keys of users and wallets
+	if key, err := (*s.apiKeys).Authenticate(apiKey); err == models.ErrApiKeyNotFound {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, err
+	} else {
+		return &key.ID, key.ExpiresAt, nil
+	}
+}
+
+// authorizeScopedKey errors with ErrUnauthenticated unless apiKey is a scoped key of the user, or of the user's wallet
+// if walletId is set, and with ErrInsufficientScope if the key does not have a scope that allows the selector.
+func (s *service) authorizeScopedKey(apiKey, username string, walletId *string, selector string) error {
+	if apiKey == "" {
+		return ErrUnauthenticated
+	}
+	key, err := (*s.apiKeys).Authenticate(apiKey)
+	if err != nil {
+		return ErrUnauthenticated
+	} else if key.Username != username || (key.WalletID == nil) != (walletId == nil) ||
+		(walletId != nil && *key.WalletID != *walletId) {
+		return ErrUnauthenticated
+	} else if !allowsSelector(key, selector) {
+		log.WithError(ErrInsufficientScope).WithFields(log.Fields{
+			"selector": selector,
+			"user":     username,
+			"key":      key.ID,
+		}).Warn("failed to authorize api key")
+		return ErrInsufficientScope
+	}
+	return nil
+}
+
 func (s *service) userExists(username string) (bool, error) {
 	if username != "" {
 		if _, err := (*s.users).GetUser(username); err == models.ErrUserNotFound {
@@ -250,35 +418,31 @@ func (s *service) userKeyMatchesUser(username string, userKey string) bool {
 	if userKey == "" {
 		return false
 	}
-	var apiKey string
-	if cacheKey, contains := s.userKeys.Get(username); contains {
-		apiKey = cacheKey.(string)
+	var hash string
+	if cached, contains := s.userKeys.Get(username); contains {
+		hash = cached.(string)
 	} else {
 		log.WithField("user", username).Debug("Cache miss for user authentication")
 		u, err := (*s.users).GetUser(username)
 		if err != nil {
 			return false
 		}
-		apiKey = u.ApiKey
-		s.userKeys.SetDefault(username, apiKey)
+		hash = u.ApiKeyHash
+		s.userKeys.SetDefault(username, hash)
 	}
-	return apiKey == userKey
+	return util.KeyMatchesHash(userKey, hash)
 }
 
-func (s *service) userMatchesWallet(username string, walletId string, userKey string) bool {
-	if userKey == "" {
-		return false
-	}
-	if cacheKey, contains := s.walletUserKeys.Get(walletId); contains {
-		apiKey := cacheKey.(string)
-		return apiKey == userKey
+func (s *service) userMatchesWallet(username string, walletId string) bool {
+	if _, contains := s.userWallets.Get(username + "/" + walletId); contains {
+		return true
 	} else {
 		log.WithField("wallet", walletId).Debug("Cache miss for wallet authentication")
 		_, err := (*s.wallets).GetWallet(username, walletId)
 		if err != nil {
 			return false
 		} else {
-			s.walletUserKeys.SetDefault(walletId, userKey)
+			s.userWallets.SetDefault(username+"/"+walletId, true)
 			return true
 		}
 	}
@@ -288,17 +452,25 @@ func (s *service) walletKeyMatchesWallet(username, walletId, walletKey string) b
 	if walletKey == "" {
 		return false
 	}
-	var apiKey string
-	if cacheKey, contains := s.walletKeys.Get(walletId); contains {
-		apiKey = cacheKey.(string)
+	var hash string
+	if cached, contains := s.walletKeys.Get(username + "/" + walletId); contains {
+		hash = cached.(string)
 	} else {
 		log.WithField("wallet", walletId).Debug("Cache miss for wallet authentication")
 		w, err := (*s.wallets).GetWallet(username, walletId)
 		if err != nil {
 			return false
 		}
-		apiKey = w.ApiKey
-		s.walletKeys.SetDefault(walletId, apiKey)
+		hash = w.ApiKeyHash
+		s.walletKeys.SetDefault(username+"/"+walletId, hash)
+	}
+	return util.KeyMatchesHash(walletKey, hash)
+}
+
+func (s *service) InvalidateApiKey(username string, walletId *string) {
+	if walletId != nil {
+		s.walletKeys.Delete(username + "/" + *walletId)
+	} else {
+		s.userKeys.Delete(username)
 	}
-	return apiKey == walletKey
 }
@@ -13,6 +13,7 @@ const (
 	Admin HeaderApiKey = iota
 	User
 	Wallet
+	Macaroon
 	Invalid
 )
 
@@ -21,10 +22,12 @@ var (
 	AdminApiKeyHeader  = "x-admin-api-key"
 	UserApiKeyHeader   = "x-user-api-key"
 	WalletApiKeyHeader = "x-wallet-api-key"
+	MacaroonHeader     = "x-xln-macaroon" // hex encoded macaroon baked by XLN
 	apiKeyHeaders      = map[string]HeaderApiKey{
 		AdminApiKeyHeader:  Admin,
 		UserApiKeyHeader:   User,
 		WalletApiKeyHeader: Wallet,
+		MacaroonHeader:     Macaroon,
 	}
 )
 
@@ -3,6 +3,7 @@ package cfg
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/lightningnetwork/lnd"
@@ -11,16 +12,23 @@ import (
 
 // Config contains the options used to initialize XLN.
 type Config struct {
-	XLNDir                   string    `long:"xlndir" description:"The base directory that contains xln's data, logs, config file, etc."`
-	XLNConfig                string    `long:"config" description:"The path to the xln config file."`
-	XLNApiKey                string    `long:"apikey" description:"The api key required to manage xln and xln users."`
-	DatabaseConnectionString string    `long:"db" description:"The SQLite or PostgreSQL database connection string."`
-	MaxPayment               int64     `long:"maxpayment" description:"The maximum payment size in millisatoshis."`
-	ShowVersion              bool      `short:"v" long:"version" description:"Displays the version and then terminates."`
-	LogLevel                 log.Level `long:"log" description:"Logrus log level."`
+	XLNDir                   string        `long:"xlndir" description:"The base directory that contains xln's data, logs, config file, etc."`
+	XLNConfig                string        `long:"config" description:"The path to the xln config file."`
+	XLNApiKey                string        `long:"apikey" description:"The bootstrap api key, which manages xln with the admin role like an admin account. Used to create the first admin accounts."`
+	DisableBootstrapKey      bool          `long:"disablebootstrapkey" description:"Rejects the bootstrap api key, so that only admin accounts may manage xln."`
+	DatabaseConnectionString string        `long:"db" description:"The SQLite or PostgreSQL database connection string."`
+	MaxPayment               int64         `long:"maxpayment" description:"The maximum payment size in millisatoshis."`
+	MaxPaymentFeePpm         uint64        `long:"maxpaymentfeeppm" description:"The maximum routing fee of a payment, in parts per million of the payment size."`
+	MaxPaymentTimeout        time.Duration `long:"maxpaymenttimeout" description:"The maximum time that a payment may spend finding a route."`
+	MaxPaymentParts          uint32        `long:"maxpaymentparts" description:"The maximum number of parts that a payment may be split into."`
+	ReconcileInterval        time.Duration `long:"reconcileinterval" description:"How often wallets are reconciled against LND. Wallets are only reconciled on demand if 0."`
+	LoginSessionLifetime     time.Duration `long:"loginsessionlifetime" description:"How long the api keys that are issued by LNURL-auth logins are valid for."`
+	ShowVersion              bool          `short:"v" long:"version" description:"Displays the version and then terminates."`
+	LogLevel                 log.Level     `long:"log" description:"Logrus log level."`
 
 	Serving *Serving `group:"Serving" namespace:"serving"`
 	Lnd     *Lnd     `group:"LND" namespace:"lnd"`
+	Fees    *Fees    `group:"Fees" namespace:"fees"`
 }
 
 // DefaultConfig returns a Config populated with default options.
@@ -33,6 +41,11 @@ func DefaultConfig() *Config {
 		XLNApiKey:                "hodl",
 		DatabaseConnectionString: fmt.Sprintf("%s/.xln/xln.db", os.Getenv("HOME")),
 		MaxPayment:               500000 * 1000,
+		MaxPaymentFeePpm:         30000,
+		MaxPaymentTimeout:        5 * time.Minute,
+		MaxPaymentParts:          16,
+		ReconcileInterval:        time.Hour,
+		LoginSessionLifetime:     24 * time.Hour,
 		ShowVersion:              false,
 		LogLevel:                 log.InfoLevel,
 
@@ -54,6 +67,7 @@ func DefaultConfig() *Config {
 			TlsCert:       fmt.Sprintf("%s/.polar/networks/1/volumes/lnd/alice/tls.cert", os.Getenv("HOME")),
 			AdminMacaroon: fmt.Sprintf("%s/.polar/networks/1/volumes/lnd/alice/data/chain/bitcoin/regtest/admin.macaroon", os.Getenv("HOME")),
 		},
+		Fees: &Fees{},
 	}
 }
 
@@ -24,3 +24,16 @@ type Serving struct {
 	GrpcPort uint16 `long:"grpcon" description:"The port to serve gRPC on"`
 	Tls      *Tls
 }
+
+// Fees holds options related to the service fees that wallets are charged for payments, on top of any routing fees.
+// Each fee is a flat fee plus a proportion of the payment amount. Users may be given their own fees by an admin.
+type Fees struct {
+	RevenueUser      string `long:"revenueuser" description:"The user of the wallet that service fees are credited to"`
+	RevenueWallet    string `long:"revenuewallet" description:"The wallet that service fees are credited to. It and its user cannot be deleted"`
+	TransferBaseMsat uint64 `long:"transferbase" description:"The flat fee of transfers between wallets on this node, in millisatoshis"`
+	TransferPpm      uint64 `long:"transferppm" description:"The fee of transfers between wallets on this node, in parts per million of the amount"`
+	InternalBaseMsat uint64 `long:"internalbase" description:"The flat fee of payments of invoices of wallets on this node, in millisatoshis"`
+	InternalPpm      uint64 `long:"internalppm" description:"The fee of payments of invoices of wallets on this node, in parts per million of the amount"`
+	ExternalBaseMsat uint64 `long:"externalbase" description:"The flat fee of payments sent over lightning, in millisatoshis"`
+	ExternalPpm      uint64 `long:"externalppm" description:"The fee of payments sent over lightning, in parts per million of the amount"`
+}
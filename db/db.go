@@ -55,10 +55,31 @@ func ConnectDB(connectionString string) (*DB, error) {
 		log.WithError(err).Error("Failed to migrate DB")
 		return nil, err
 	}
+	repo := models.NewRepository()
+	if err := checkLedger(db, repo); err != nil {
+		log.WithError(err).Error("Failed to check ledger")
+		return nil, err
+	}
+	if assigned, err := repo.AssignWalletRoutingTags(db); err != nil {
+		log.WithError(err).Error("Failed to assign routing tags")
+		return nil, err
+	} else if assigned > 0 {
+		log.WithField("total", assigned).Info("Assigned routing tags to wallets")
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		hashed, err := repo.HashPlaintextApiKeys(tx)
+		if err == nil && hashed > 0 {
+			log.WithField("total", hashed).Info("Replaced plaintext api keys with their hashes")
+		}
+		return err
+	}); err != nil {
+		log.WithError(err).Error("Failed to hash api keys")
+		return nil, err
+	}
 
 	log.Info("Connected to DB")
 
-	return &DB{DB: db, Repo: models.NewRepository()}, err
+	return &DB{DB: db, Repo: repo}, err
 }
 
 func migrate(db *gorm.DB) error {
@@ -66,10 +87,49 @@ func migrate(db *gorm.DB) error {
 		&models.User{},
 		&models.Wallet{},
 		&models.Withdraw{},
+		&models.Pay{},
 		&models.Transaction{},
+		&models.LedgerEntry{},
 		&models.PendingInvoice{},
 		&models.PendingPayment{},
+		&models.SettleCursor{},
 		&models.Auth{},
+		&models.Webhook{},
+		&models.WebhookDelivery{},
+		&models.IdempotencyKey{},
+		&models.SpendingPolicy{},
+		&models.PaymentDefaults{},
+		&models.FeeSchedule{},
+		&models.Schedule{},
+		&models.ScheduleRun{},
+		&models.ApiKey{},
+		&models.MacaroonRootKey{},
+		&models.Admin{},
 	)
 	return err
 }
+
+// checkLedger records the opening balances of wallets that predate the ledger, and warns of any wallet
+// whose balance does not match its ledger entries.
+func checkLedger(db *gorm.DB, repo models.Repository) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		seeded, err := repo.SeedLedgerOpeningBalances(tx)
+		if err != nil {
+			return err
+		} else if seeded > 0 {
+			log.WithField("total", seeded).Info("Recorded opening balances of wallets in ledger")
+		}
+		mismatched, err := repo.ListLedgerMismatchedWallets(tx)
+		if err != nil {
+			return err
+		}
+		for _, wallet := range mismatched {
+			log.WithFields(log.Fields{
+				"user":    wallet.Username,
+				"wallet":  wallet.ID,
+				"balance": wallet.Balance,
+			}).Warn("Wallet balance does not match ledger")
+		}
+		return nil
+	})
+}
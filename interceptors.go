@@ -0,0 +1,67 @@
+package xln
+
+import (
+	"context"
+
+	"github.com/xbit-gg/xln/auth"
+	"google.golang.org/grpc"
+)
+
+// authUnaryInterceptor authorizes the caller of each unary RPC as the RPC's policy requires, and calls the RPC with
+// the identity that the caller was authorized as in its context.
+func (xln *XLN) authUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := xln.AuthService.Authorize(ctx, info.FullMethod, req)
+	if err != nil {
+		return nil, handleAuthErr(err)
+	}
+	return handler(ctx, req)
+}
+
+// authStreamInterceptor is authUnaryInterceptor for streaming RPCs. Callers are authorized once the first request of
+// the stream is received, as the policies of wallets need the wallet that the request sets.
+func (xln *XLN) authStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	return handler(srv, &authorizedStream{
+		ServerStream: ss,
+		ctx:          ss.Context(),
+		authorize: func(req interface{}) (context.Context, error) {
+			return xln.AuthService.Authorize(ss.Context(), info.FullMethod, req)
+		},
+	})
+}
+
+// authorizedStream is a stream whose caller is authorized when its first request is received. Nothing can be sent on
+// the stream until then.
+type authorizedStream struct {
+	grpc.ServerStream
+
+	ctx        context.Context
+	authorize  func(req interface{}) (context.Context, error)
+	authorized bool
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	} else if s.authorized {
+		return nil
+	}
+	ctx, err := s.authorize(m)
+	if err != nil {
+		return handleAuthErr(err)
+	}
+	s.ctx, s.authorized = ctx, true
+	return nil
+}
+
+func (s *authorizedStream) SendMsg(m interface{}) error {
+	if !s.authorized {
+		return handleAuthErr(auth.ErrUnauthenticated)
+	}
+	return s.ServerStream.SendMsg(m)
+}
@@ -25,7 +25,9 @@ type Manager interface {
 	UserLinkAuth(username string, label string) (lnurl string, err error)
 	WalletLinkAuth(username string, walletId *string, label string) (lnurl string, err error)
 
-	GetAuthKey(k1 string) (apikey string, err error)
+	// ConsumeAuth returns the ln auth record of k1 once it is authenticated, and removes it so that the login can
+	// only be completed once.
+	ConsumeAuth(k1 string) (*models.Auth, error)
 
 	LNURLAuthenticate(k1 string, sig string, key string) error
 }
@@ -118,28 +120,16 @@ func (m *manager) LNURLAuthenticate(k1 string, sig string, key string) error {
 	return err
 }
 
-func (m *manager) GetAuthKey(k1 string) (string, error) {
+func (m *manager) ConsumeAuth(k1 string) (*models.Auth, error) {
 	auth, err := m.db.Repo.GetAuth(m.db.DB, k1)
 	if err != nil {
-		return "", err
-	}
-	if auth.Authed {
-		if auth.UserUsername != nil { // if user
-			if user, err := m.db.Repo.GetUser(m.db.DB, *auth.UserUsername); err != nil {
-				return "", err
-			} else {
-				return user.ApiKey, nil
-			}
-		} else { // if wallet
-			if wal, err := m.db.Repo.GetWallet(m.db.DB, *auth.UserUsername, *auth.WalletID); err != nil {
-				return "", err
-			} else {
-				return wal.ApiKey, nil
-			}
-		}
-	} else {
-		return "", fmt.Errorf("auth token %s is unauthenticated", k1)
+		return nil, err
+	} else if !auth.Authed {
+		return nil, fmt.Errorf("auth token %s is unauthenticated", k1)
+	} else if err := m.db.Repo.DeleteAuth(m.db.DB, k1); err != nil {
+		return nil, err
 	}
+	return auth, nil
 }
 
 func (m *manager) createAuth(username string, walletId *string, link bool, label string) (string, error) {
@@ -0,0 +1,200 @@
+package pay
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	golnurl "github.com/fiatjaf/go-lnurl"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/resources/invoice"
+)
+
+const payCallbackEndpoint = "https://%s/lnurl/pay/callback?k1=%s"
+const payRequestEndpoint = "https://%s/lnurl/pay/request?k1=%s"
+const addressCallbackEndpoint = "https://%s/.well-known/lnurlp/%s/callback"
+
+// minSendable is the smallest amount that can be sent to a pay link, as invoices cannot
+// be created for fractions of a satoshi by every wallet.
+const minSendable = 1000
+
+type Manager interface {
+	CreateLNURLP(username, walletId, description string, minMsat, maxMsat uint64) (lnurl string, err error)
+	GetLNURLP(username, walletId, k1 string) (lnurl string, err error)
+	GetPayRequest(k1 string) (pay *models.Pay, callback, metadata string, err error)
+	GetPayInvoice(k1 string, amount uint64) (pr string, err error)
+	GetAddressPayRequest(name string) (callback, metadata string, minMsat, maxMsat uint64, err error)
+	GetAddressPayInvoice(name string, amount uint64) (pr string, err error)
+}
+
+type manager struct {
+	hostname   string
+	db         *db.DB
+	invoices   invoice.Manager
+	maxPayment uint64
+}
+
+func NewManager(hostname string, db *db.DB, invoices invoice.Manager, maxPayment int64) Manager {
+	return &manager{
+		hostname:   hostname,
+		db:         db,
+		invoices:   invoices,
+		maxPayment: uint64(maxPayment),
+	}
+}
+
+func (m *manager) CreateLNURLP(username, walletId, description string, minMsat, maxMsat uint64) (lnurl string, err error) {
+	wallet, err := m.db.Repo.GetWallet(m.db.DB, username, walletId)
+	if err != nil {
+		return "", err
+	} else if wallet.Locked {
+		return "", models.ErrLockedWallet
+	}
+
+	if minMsat == 0 {
+		minMsat = minSendable
+	}
+	if maxMsat == 0 {
+		maxMsat = m.maxPayment
+	}
+	if minMsat < minSendable {
+		return "", fmt.Errorf("minimum sendable must be at least %d msat", minSendable)
+	} else if maxMsat > m.maxPayment {
+		return "", fmt.Errorf("maximum sendable of %d msat is greater than the maximum payment size", maxMsat)
+	} else if minMsat > maxMsat {
+		return "", fmt.Errorf("minimum sendable cannot be greater than maximum sendable")
+	}
+	if description == "" && wallet.Name != nil {
+		description = *wallet.Name
+	}
+
+	pay, err := m.db.Repo.CreatePay(m.db.DB, &models.Pay{
+		WalletID:    walletId,
+		Username:    username,
+		Description: description,
+		MinMsat:     minMsat,
+		MaxMsat:     maxMsat,
+	})
+	if err != nil {
+		return "", err
+	} else {
+		return m.createInitPayLink(pay.K1)
+	}
+}
+
+func (m *manager) GetLNURLP(username, walletId, k1 string) (lnurl string, err error) {
+	pay, err := m.db.Repo.GetWalletPay(m.db.DB, username, walletId, k1)
+	if err != nil {
+		return "", err
+	} else {
+		return m.createInitPayLink(pay.K1)
+	}
+}
+
+func (m *manager) GetPayRequest(k1 string) (pay *models.Pay, callback, metadata string, err error) {
+	pay, err = m.db.Repo.GetPay(m.db.DB, k1)
+	if err != nil {
+		return nil, "", "", err
+	}
+	metadata, err = encodeMetadata(pay.Description)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return pay, fmt.Sprintf(payCallbackEndpoint, m.hostname, pay.K1), metadata, nil
+}
+
+// GetPayInvoice creates an invoice for the wallet of the pay link that commits to the hash of the
+// pay link's metadata, as required by LUD-06.
+func (m *manager) GetPayInvoice(k1 string, amount uint64) (pr string, err error) {
+	pay, err := m.db.Repo.GetPay(m.db.DB, k1)
+	if err != nil {
+		return "", err
+	}
+	if amount < pay.MinMsat {
+		return "", fmt.Errorf("amount is below the minimum sendable value")
+	} else if amount > pay.MaxMsat {
+		return "", fmt.Errorf("amount exceeds the maximum sendable value")
+	}
+	metadata, err := encodeMetadata(pay.Description)
+	if err != nil {
+		return "", err
+	}
+	descriptionHash := sha256.Sum256([]byte(metadata))
+	inv, err := m.invoices.CreateInvoice(pay.Username, pay.WalletID, "", descriptionHash[:], int64(amount), 0)
+	if err != nil {
+		return "", err
+	}
+	return inv.PaymentRequest, nil
+}
+
+// GetAddressPayRequest resolves the lightning address <name>@<hostname> (LUD-16) to the wallet
+// that has name as its address alias.
+func (m *manager) GetAddressPayRequest(name string) (callback, metadata string, minMsat, maxMsat uint64, err error) {
+	name = strings.ToLower(name)
+	if _, err = m.db.Repo.GetWalletWithAddressAlias(m.db.DB, name); err != nil {
+		return "", "", 0, 0, err
+	}
+	metadata, err = m.encodeAddressMetadata(name)
+	if err != nil {
+		return "", "", 0, 0, err
+	}
+	return fmt.Sprintf(addressCallbackEndpoint, m.hostname, name), metadata, minSendable, m.maxPayment, nil
+}
+
+// GetAddressPayInvoice creates an invoice for the wallet that the lightning address <name>@<hostname>
+// resolves to that commits to the hash of the address' metadata.
+func (m *manager) GetAddressPayInvoice(name string, amount uint64) (pr string, err error) {
+	name = strings.ToLower(name)
+	wallet, err := m.db.Repo.GetWalletWithAddressAlias(m.db.DB, name)
+	if err != nil {
+		return "", err
+	}
+	if amount < minSendable {
+		return "", fmt.Errorf("amount is below the minimum sendable value")
+	} else if amount > m.maxPayment {
+		return "", fmt.Errorf("amount exceeds the maximum sendable value")
+	}
+	metadata, err := m.encodeAddressMetadata(name)
+	if err != nil {
+		return "", err
+	}
+	descriptionHash := sha256.Sum256([]byte(metadata))
+	inv, err := m.invoices.CreateInvoice(wallet.Username, wallet.ID, "", descriptionHash[:], int64(amount), 0)
+	if err != nil {
+		return "", err
+	}
+	return inv.PaymentRequest, nil
+}
+
+func (m *manager) createInitPayLink(k1 string) (lnurl string, err error) {
+	lnurl, err = golnurl.LNURLEncode(fmt.Sprintf(payRequestEndpoint, m.hostname, k1))
+	if err != nil {
+		return "", err
+	}
+	return lnurl, nil
+}
+
+// encodeAddressMetadata returns the LUD-16 metadata of a lightning address, which identifies the address.
+func (m *manager) encodeAddressMetadata(name string) (string, error) {
+	address := fmt.Sprintf("%s@%s", name, m.hostname)
+	metadata, err := json.Marshal([][]string{
+		{"text/plain", fmt.Sprintf("Payment to %s", address)},
+		{"text/identifier", address},
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(metadata), nil
+}
+
+// encodeMetadata returns the LUD-06 metadata of a pay link. The result must be stable, since the
+// invoices minted by the callback commit to its hash.
+func encodeMetadata(description string) (string, error) {
+	metadata, err := json.Marshal([][]string{{"text/plain", description}})
+	if err != nil {
+		return "", err
+	}
+	return string(metadata), nil
+}
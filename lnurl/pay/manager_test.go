@@ -0,0 +1,295 @@
+package pay
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	golnurl "github.com/fiatjaf/go-lnurl"
+	"github.com/stretchr/testify/suite"
+	"github.com/xbit-gg/xln/cfg"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/resources/invoice"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func TestPayManager(t *testing.T) {
+	suite.Run(t, new(payManagerSuite))
+}
+
+type payManagerSuite struct {
+	suite.Suite
+	mgr            Manager
+	mockRepo       mockRepo
+	mockInvoiceMgr mockInvoiceManager
+	mock           sqlmock.Sqlmock
+}
+
+func (s *payManagerSuite) SetupSuite() {
+	var (
+		err   error
+		sqlDB *sql.DB
+	)
+	sqlDB, s.mock, err = sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+	sDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	s.mockRepo = mockRepo{}
+	s.mockInvoiceMgr = mockInvoiceManager{}
+	s.mgr = NewManager(
+		cfg.DefaultConfig().Serving.Hostname,
+		&db.DB{DB: sDB, Repo: &s.mockRepo},
+		&s.mockInvoiceMgr,
+		cfg.DefaultConfig().MaxPayment,
+	)
+}
+
+func (s *payManagerSuite) AfterTest(_, _ string) {
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+	s.mockRepo = mockRepo{}
+	s.mockInvoiceMgr = mockInvoiceManager{}
+}
+
+func (s *payManagerSuite) TestCreateLNURLPSucceedsWhenRepoSucceeds() {
+	expectedPay := &models.Pay{
+		WalletID:    "test-walletid",
+		Username:    "test-username",
+		Description: "test-description",
+		MinMsat:     1000,
+		MaxMsat:     2000,
+		K1:          "test-k1",
+	}
+	s.mockRepo.mockGetWallet = func(tx *gorm.DB, username, walletId string) (*models.Wallet, error) {
+		return &models.Wallet{ID: walletId, Username: username}, nil
+	}
+	s.mockRepo.mockCreatePay = func(tx *gorm.DB, actualPay *models.Pay) (*models.Pay, error) {
+		s.Require().Empty(actualPay.K1)
+		s.Require().Equal(expectedPay.Description, actualPay.Description)
+		s.Require().Equal(expectedPay.MinMsat, actualPay.MinMsat)
+		s.Require().Equal(expectedPay.MaxMsat, actualPay.MaxMsat)
+		s.Require().Equal(expectedPay.Username, actualPay.Username)
+		s.Require().Equal(expectedPay.WalletID, actualPay.WalletID)
+		return expectedPay, nil
+	}
+
+	actualLNURL, actualErr := s.mgr.CreateLNURLP(expectedPay.Username, expectedPay.WalletID,
+		expectedPay.Description, expectedPay.MinMsat, expectedPay.MaxMsat)
+	s.Require().Nil(actualErr)
+	decodedLNURL, decodeErr := golnurl.LNURLDecode(actualLNURL)
+	s.Require().Nil(decodeErr, "should not error when decoding lnurl")
+	s.Require().Equal(
+		fmt.Sprintf("https://localhost:5551/lnurl/pay/request?k1=%s", expectedPay.K1),
+		decodedLNURL)
+}
+
+func (s *payManagerSuite) TestCreateLNURLPUsesDefaultBounds() {
+	walletName := "test-wallet-name"
+	s.mockRepo.mockGetWallet = func(tx *gorm.DB, username, walletId string) (*models.Wallet, error) {
+		return &models.Wallet{ID: walletId, Username: username, Name: &walletName}, nil
+	}
+	s.mockRepo.mockCreatePay = func(tx *gorm.DB, actualPay *models.Pay) (*models.Pay, error) {
+		s.Require().Equal(walletName, actualPay.Description)
+		s.Require().Equal(uint64(minSendable), actualPay.MinMsat)
+		s.Require().Equal(uint64(cfg.DefaultConfig().MaxPayment), actualPay.MaxMsat)
+		actualPay.K1 = "test-k1"
+		return actualPay, nil
+	}
+
+	_, actualErr := s.mgr.CreateLNURLP("test-username", "test-walletid", "", 0, 0)
+	s.Require().Nil(actualErr)
+}
+
+func (s *payManagerSuite) TestCreateLNURLPErrorsWhenBoundsInvalid() {
+	s.mockRepo.mockGetWallet = func(tx *gorm.DB, username, walletId string) (*models.Wallet, error) {
+		return &models.Wallet{ID: walletId, Username: username}, nil
+	}
+	testInputs := [][2]uint64{
+		{1, 2000},
+		{3000, 2000},
+		{1000, uint64(cfg.DefaultConfig().MaxPayment) + 1},
+	}
+	for _, bounds := range testInputs {
+		actualLNURL, actualErr := s.mgr.CreateLNURLP("test-username", "test-walletid", "", bounds[0], bounds[1])
+		s.Require().NotNil(actualErr)
+		s.Require().Empty(actualLNURL)
+	}
+}
+
+func (s *payManagerSuite) TestCreateLNURLPErrorsWhenWalletLocked() {
+	s.mockRepo.mockGetWallet = func(tx *gorm.DB, username, walletId string) (*models.Wallet, error) {
+		return &models.Wallet{ID: walletId, Username: username, Locked: true}, nil
+	}
+
+	actualLNURL, actualErr := s.mgr.CreateLNURLP("test-username", "test-walletid", "test-description", 1000, 2000)
+	s.Require().Equal(models.ErrLockedWallet, actualErr)
+	s.Require().Empty(actualLNURL)
+}
+
+func (s *payManagerSuite) TestGetPayRequestSucceedsPermissionlesslyWhenRepoSucceeds() {
+	expectedPay := &models.Pay{
+		WalletID:    "test-walletid",
+		Username:    "test-username",
+		Description: "test-description",
+		MinMsat:     1000,
+		MaxMsat:     2000,
+		K1:          "test-k1",
+	}
+	s.mockRepo.mockGetPay = func(tx *gorm.DB, actualK1 string) (*models.Pay, error) {
+		s.Require().Equal(expectedPay.K1, actualK1)
+		return expectedPay, nil
+	}
+
+	actualPay, actualCallback, actualMetadata, actualErr := s.mgr.GetPayRequest(expectedPay.K1)
+	s.Require().Nil(actualErr)
+	s.Require().Equal(expectedPay, actualPay)
+	s.Require().Equal("https://localhost:5551/lnurl/pay/callback?k1=test-k1", actualCallback)
+	s.Require().Equal(`[["text/plain","test-description"]]`, actualMetadata)
+}
+
+func (s *payManagerSuite) TestGetPayRequestErrorsWhenRepoErrors() {
+	expectedErr := errors.New("test-error")
+	s.mockRepo.mockGetPay = func(tx *gorm.DB, actualK1 string) (*models.Pay, error) {
+		return nil, expectedErr
+	}
+
+	actualPay, actualCallback, actualMetadata, actualErr := s.mgr.GetPayRequest("test-k1")
+	s.Require().Equal(expectedErr, actualErr)
+	s.Require().Nil(actualPay)
+	s.Require().Empty(actualCallback)
+	s.Require().Empty(actualMetadata)
+}
+
+func (s *payManagerSuite) TestGetPayInvoiceCommitsToMetadata() {
+	expectedPay := &models.Pay{
+		WalletID:    "test-walletid",
+		Username:    "test-username",
+		Description: "test-description",
+		MinMsat:     1000,
+		MaxMsat:     2000,
+		K1:          "test-k1",
+	}
+	expectedPr := "test-payment-request"
+	expectedHash := sha256.Sum256([]byte(`[["text/plain","test-description"]]`))
+	s.mockRepo.mockGetPay = func(tx *gorm.DB, actualK1 string) (*models.Pay, error) {
+		return expectedPay, nil
+	}
+	s.mockInvoiceMgr.mockCreateInvoice = func(username, walletId, memo string, descriptionHash []byte, value, expiry int64) (*models.Invoice, error) {
+		s.Require().Equal(expectedPay.Username, username)
+		s.Require().Equal(expectedPay.WalletID, walletId)
+		s.Require().Empty(memo)
+		s.Require().Equal(expectedHash[:], descriptionHash)
+		s.Require().Equal(int64(1500), value)
+		return &models.Invoice{PaymentRequest: expectedPr}, nil
+	}
+
+	actualPr, actualErr := s.mgr.GetPayInvoice(expectedPay.K1, 1500)
+	s.Require().Nil(actualErr)
+	s.Require().Equal(expectedPr, actualPr)
+}
+
+func (s *payManagerSuite) TestGetPayInvoiceErrorsWhenAmountOutOfBounds() {
+	s.mockRepo.mockGetPay = func(tx *gorm.DB, actualK1 string) (*models.Pay, error) {
+		return &models.Pay{K1: actualK1, MinMsat: 1000, MaxMsat: 2000}, nil
+	}
+
+	for _, amount := range []uint64{999, 2001} {
+		actualPr, actualErr := s.mgr.GetPayInvoice("test-k1", amount)
+		s.Require().NotNil(actualErr)
+		s.Require().Empty(actualPr)
+	}
+}
+
+func (s *payManagerSuite) TestGetAddressPayRequestResolvesAlias() {
+	s.mockRepo.mockGetWalletWithAddressAlias = func(tx *gorm.DB, alias string) (*models.Wallet, error) {
+		s.Require().Equal("satoshi", alias)
+		return &models.Wallet{ID: "test-walletid", Username: "test-username"}, nil
+	}
+
+	actualCallback, actualMetadata, actualMin, actualMax, actualErr := s.mgr.GetAddressPayRequest("Satoshi")
+	s.Require().Nil(actualErr)
+	s.Require().Equal("https://localhost:5551/.well-known/lnurlp/satoshi/callback", actualCallback)
+	s.Require().Equal(
+		`[["text/plain","Payment to satoshi@localhost:5551"],["text/identifier","satoshi@localhost:5551"]]`,
+		actualMetadata)
+	s.Require().Equal(uint64(minSendable), actualMin)
+	s.Require().Equal(uint64(cfg.DefaultConfig().MaxPayment), actualMax)
+}
+
+func (s *payManagerSuite) TestGetAddressPayRequestErrorsWhenAliasUnknown() {
+	s.mockRepo.mockGetWalletWithAddressAlias = func(tx *gorm.DB, alias string) (*models.Wallet, error) {
+		return nil, models.ErrAddressAliasNotFound
+	}
+
+	actualCallback, actualMetadata, _, _, actualErr := s.mgr.GetAddressPayRequest("satoshi")
+	s.Require().Equal(models.ErrAddressAliasNotFound, actualErr)
+	s.Require().Empty(actualCallback)
+	s.Require().Empty(actualMetadata)
+}
+
+func (s *payManagerSuite) TestGetAddressPayInvoiceCreatesInvoiceForAliasedWallet() {
+	expectedPr := "test-payment-request"
+	expectedHash := sha256.Sum256(
+		[]byte(`[["text/plain","Payment to satoshi@localhost:5551"],["text/identifier","satoshi@localhost:5551"]]`))
+	s.mockRepo.mockGetWalletWithAddressAlias = func(tx *gorm.DB, alias string) (*models.Wallet, error) {
+		return &models.Wallet{ID: "test-walletid", Username: "test-username"}, nil
+	}
+	s.mockInvoiceMgr.mockCreateInvoice = func(username, walletId, memo string, descriptionHash []byte, value, expiry int64) (*models.Invoice, error) {
+		s.Require().Equal("test-username", username)
+		s.Require().Equal("test-walletid", walletId)
+		s.Require().Equal(expectedHash[:], descriptionHash)
+		s.Require().Equal(int64(5000), value)
+		return &models.Invoice{PaymentRequest: expectedPr}, nil
+	}
+
+	actualPr, actualErr := s.mgr.GetAddressPayInvoice("satoshi", 5000)
+	s.Require().Nil(actualErr)
+	s.Require().Equal(expectedPr, actualPr)
+}
+
+type mockRepo struct {
+	models.Repository
+
+	mockCreatePay    func(tx *gorm.DB, pay *models.Pay) (*models.Pay, error)
+	mockGetWalletPay func(tx *gorm.DB, username, walletId, k1 string) (*models.Pay, error)
+	mockGetPay       func(tx *gorm.DB, k1 string) (*models.Pay, error)
+	mockGetWallet    func(tx *gorm.DB, username, walletId string) (*models.Wallet, error)
+
+	mockGetWalletWithAddressAlias func(tx *gorm.DB, alias string) (*models.Wallet, error)
+}
+
+func (m *mockRepo) CreatePay(tx *gorm.DB, pay *models.Pay) (*models.Pay, error) {
+	return m.mockCreatePay(tx, pay)
+}
+
+func (m *mockRepo) GetWalletPay(tx *gorm.DB, username, walletId, k1 string) (*models.Pay, error) {
+	return m.mockGetWalletPay(tx, username, walletId, k1)
+}
+
+func (m *mockRepo) GetPay(tx *gorm.DB, k1 string) (*models.Pay, error) {
+	return m.mockGetPay(tx, k1)
+}
+
+func (m *mockRepo) GetWallet(tx *gorm.DB, username, walletId string) (*models.Wallet, error) {
+	return m.mockGetWallet(tx, username, walletId)
+}
+
+func (m *mockRepo) GetWalletWithAddressAlias(tx *gorm.DB, alias string) (*models.Wallet, error) {
+	return m.mockGetWalletWithAddressAlias(tx, alias)
+}
+
+type mockInvoiceManager struct {
+	invoice.Manager
+
+	mockCreateInvoice func(username, walletId, memo string, descriptionHash []byte, value, expiry int64) (*models.Invoice, error)
+}
+
+func (m *mockInvoiceManager) CreateInvoice(username, walletId, memo string, descriptionHash []byte, value, expiry int64) (*models.Invoice, error) {
+	return m.mockCreateInvoice(username, walletId, memo, descriptionHash, value, expiry)
+}
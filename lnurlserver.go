@@ -12,6 +12,7 @@ const (
 	OkStatus       = "OK"
 	ErrorStatus    = "ERROR"
 	TagWithdrawReq = "withdrawRequest"
+	TagPayReq      = "payRequest"
 	errorDetails   = "error details: %v"
 )
 
@@ -92,3 +93,121 @@ func (x lnurlServer) Withdraw(ctx context.Context, request *xlnrpc.WithdrawReque
 
 	return SuccessResponse, nil
 }
+
+func (x lnurlServer) RequestPay(ctx context.Context, request *xlnrpc.RequestPayRequest) (*xlnrpc.RequestPayResponse, error) {
+	log.WithField("req", request).Debug("LNURL.RequestPay called")
+	p, callback, metadata, err := x.xln.LNURLPay.GetPayRequest(request.K1)
+	if err != nil {
+		log.WithError(err).Warn("RequestPay request failed")
+		res := xlnrpc.RequestPayResponse{
+			Status: ErrorStatus,
+			Reason: fmt.Sprintf(errorDetails, err),
+		}
+		return &res, nil
+	}
+
+	res := xlnrpc.RequestPayResponse{
+		Status:      OkStatus,
+		Tag:         TagPayReq,
+		Callback:    callback,
+		MinSendable: p.MinMsat,
+		MaxSendable: p.MaxMsat,
+		Metadata:    metadata,
+	}
+
+	return &res, nil
+}
+
+func (x lnurlServer) Pay(ctx context.Context, request *xlnrpc.PayRequest) (*xlnrpc.PayResponse, error) {
+	log.WithField("req", request).Debug("LNURL.Pay called")
+	if request.K1 == "" {
+		res := xlnrpc.PayResponse{
+			Status: ErrorStatus,
+			Reason: fmt.Sprintf(errorDetails, "must provide k1"),
+		}
+		return &res, nil
+	} else if request.Amount == 0 {
+		res := xlnrpc.PayResponse{
+			Status: ErrorStatus,
+			Reason: fmt.Sprintf(errorDetails, "must provide amount"),
+		}
+		return &res, nil
+	}
+
+	pr, err := x.xln.LNURLPay.GetPayInvoice(request.K1, request.Amount)
+	if err != nil {
+		log.WithError(err).Warn("Pay request failed")
+		res := xlnrpc.PayResponse{
+			Status: ErrorStatus,
+			Reason: fmt.Sprintf(errorDetails, err),
+		}
+		return &res, nil
+	}
+
+	res := xlnrpc.PayResponse{
+		Status: OkStatus,
+		Pr:     pr,
+		Routes: []string{},
+	}
+
+	return &res, nil
+}
+
+func (x lnurlServer) RequestAddressPay(ctx context.Context, request *xlnrpc.RequestAddressPayRequest) (*xlnrpc.RequestPayResponse, error) {
+	log.WithField("req", request).Debug("LNURL.RequestAddressPay called")
+	callback, metadata, minMsat, maxMsat, err := x.xln.LNURLPay.GetAddressPayRequest(request.Name)
+	if err != nil {
+		log.WithError(err).Warn("RequestAddressPay request failed")
+		res := xlnrpc.RequestPayResponse{
+			Status: ErrorStatus,
+			Reason: fmt.Sprintf(errorDetails, err),
+		}
+		return &res, nil
+	}
+
+	res := xlnrpc.RequestPayResponse{
+		Status:      OkStatus,
+		Tag:         TagPayReq,
+		Callback:    callback,
+		MinSendable: minMsat,
+		MaxSendable: maxMsat,
+		Metadata:    metadata,
+	}
+
+	return &res, nil
+}
+
+func (x lnurlServer) AddressPay(ctx context.Context, request *xlnrpc.AddressPayRequest) (*xlnrpc.PayResponse, error) {
+	log.WithField("req", request).Debug("LNURL.AddressPay called")
+	if request.Name == "" {
+		res := xlnrpc.PayResponse{
+			Status: ErrorStatus,
+			Reason: fmt.Sprintf(errorDetails, "must provide name"),
+		}
+		return &res, nil
+	} else if request.Amount == 0 {
+		res := xlnrpc.PayResponse{
+			Status: ErrorStatus,
+			Reason: fmt.Sprintf(errorDetails, "must provide amount"),
+		}
+		return &res, nil
+	}
+
+	pr, err := x.xln.LNURLPay.GetAddressPayInvoice(request.Name, request.Amount)
+	if err != nil {
+		log.WithError(err).Warn("AddressPay request failed")
+		res := xlnrpc.PayResponse{
+			Status: ErrorStatus,
+			Reason: fmt.Sprintf(errorDetails, err),
+		}
+		return &res, nil
+	}
+
+	res := xlnrpc.PayResponse{
+		Status: OkStatus,
+		Pr:     pr,
+		Routes: []string{},
+	}
+
+	return &res, nil
+}
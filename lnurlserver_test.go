@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/xbit-gg/xln/lnd"
+	"github.com/xbit-gg/xln/lnurl/pay"
 	"github.com/xbit-gg/xln/lnurl/withdraw"
 	"github.com/xbit-gg/xln/models"
 	"github.com/xbit-gg/xln/resources/invoice"
@@ -19,16 +20,18 @@ func TestLNURLServer(t *testing.T) {
 
 type lnurlServerSuite struct {
 	suite.Suite
-	mockLnurlMgr  mockLnurlWithdrawManager
-	mockInvMgr    mockInvoiceManager
-	mockLndClient lnd.Client
-	lnurlServer   lnurlServer
+	mockLnurlMgr    mockLnurlWithdrawManager
+	mockLnurlPayMgr mockLnurlPayManager
+	mockInvMgr      mockInvoiceManager
+	mockLndClient   lnd.Client
+	lnurlServer     lnurlServer
 }
 
 func (s *lnurlServerSuite) SetupSuite() {
 	s.mockLnurlMgr = mockLnurlWithdrawManager{}
+	s.mockLnurlPayMgr = mockLnurlPayManager{}
 	s.mockInvMgr = mockInvoiceManager{}
-	s.lnurlServer.xln = &XLN{Invoices: &s.mockInvMgr, LNURLWithdraw: &s.mockLnurlMgr}
+	s.lnurlServer.xln = &XLN{Invoices: &s.mockInvMgr, LNURLWithdraw: &s.mockLnurlMgr, LNURLPay: &s.mockLnurlPayMgr}
 }
 
 func (s *lnurlServerSuite) TestRequestWithdrawGivesLNURLRPCErrorWhenErrors() {
@@ -147,6 +150,98 @@ func (s *lnurlServerSuite) TestWithdrawGivesLNURLRPCErrorWhenInvoiceMgrErrors()
 	s.Require().NotEmpty(actualRes.Reason)
 }
 
+func (s *lnurlServerSuite) TestRequestPayGivesLNURLRPCErrorWhenErrors() {
+	expectedErr := errors.New("test error")
+	s.mockLnurlPayMgr.mockGetPayRequest = func(actualK1 string) (*models.Pay, string, string, error) {
+		return nil, "", "", expectedErr
+	}
+	actualRes, err := s.lnurlServer.RequestPay(
+		context.Background(),
+		&xlnrpc.RequestPayRequest{K1: "test-k1"},
+	)
+	s.Require().Nil(err)
+	s.Require().NotNil(actualRes)
+	s.Require().Equal("ERROR", actualRes.Status)
+	s.Require().NotEmpty(actualRes.Reason)
+}
+
+func (s *lnurlServerSuite) TestRequestPaySucceedsWhenK1Exists() {
+	expectedK1 := "test-k1"
+	expectedCallback := "test-callback"
+	expectedMetadata := "test-metadata"
+	expectedP := models.Pay{
+		K1:          expectedK1,
+		MinMsat:     1000,
+		MaxMsat:     2000,
+		Description: "test-description",
+	}
+	s.mockLnurlPayMgr.mockGetPayRequest = func(actualK1 string) (*models.Pay, string, string, error) {
+		s.Require().Equal(expectedK1, actualK1)
+		return &expectedP, expectedCallback, expectedMetadata, nil
+	}
+	actualRes, err := s.lnurlServer.RequestPay(
+		context.Background(),
+		&xlnrpc.RequestPayRequest{K1: expectedK1},
+	)
+	s.Require().Nil(err)
+	s.Require().NotNil(actualRes)
+	s.Require().Equal("OK", actualRes.Status)
+	s.Require().Equal("payRequest", actualRes.Tag)
+	s.Require().Equal(expectedCallback, actualRes.Callback)
+	s.Require().Equal(expectedMetadata, actualRes.Metadata)
+	s.Require().Equal(expectedP.MinMsat, actualRes.MinSendable)
+	s.Require().Equal(expectedP.MaxMsat, actualRes.MaxSendable)
+}
+
+func (s *lnurlServerSuite) TestPayGivesLNURLRPCErrorWhenParamsInvalid() {
+	testInputs := []*xlnrpc.PayRequest{
+		{K1: "", Amount: 0},
+		{K1: "nonempty string", Amount: 0},
+		{K1: "", Amount: 1000},
+	}
+	for _, input := range testInputs {
+		actualRes, err := s.lnurlServer.Pay(context.Background(), input)
+		s.Require().Nil(err)
+		s.Require().NotNil(actualRes)
+		s.Require().Equal("ERROR", actualRes.Status)
+	}
+}
+
+func (s *lnurlServerSuite) TestPaySucceedsWhenPayMgrSucceeds() {
+	expectedK1 := "test-k1"
+	expectedAmount := uint64(1000)
+	expectedPr := "test-payment-request"
+	s.mockLnurlPayMgr.mockGetPayInvoice = func(actualK1 string, actualAmount uint64) (string, error) {
+		s.Require().Equal(expectedK1, actualK1)
+		s.Require().Equal(expectedAmount, actualAmount)
+		return expectedPr, nil
+	}
+	actualRes, err := s.lnurlServer.Pay(
+		context.Background(),
+		&xlnrpc.PayRequest{K1: expectedK1, Amount: expectedAmount},
+	)
+	s.Require().Nil(err)
+	s.Require().NotNil(actualRes)
+	s.Require().Equal("OK", actualRes.Status)
+	s.Require().Equal(expectedPr, actualRes.Pr)
+	s.Require().NotNil(actualRes.Routes)
+}
+
+func (s *lnurlServerSuite) TestPayGivesLNURLRPCErrorWhenPayMgrErrors() {
+	s.mockLnurlPayMgr.mockGetPayInvoice = func(actualK1 string, actualAmount uint64) (string, error) {
+		return "", errors.New("expected-pay-error")
+	}
+	actualRes, err := s.lnurlServer.Pay(
+		context.Background(),
+		&xlnrpc.PayRequest{K1: "test-k1", Amount: 1000},
+	)
+	s.Require().Nil(err)
+	s.Require().NotNil(actualRes)
+	s.Require().Equal("ERROR", actualRes.Status)
+	s.Require().NotEmpty(actualRes.Reason)
+	s.Require().Empty(actualRes.Pr)
+}
+
 type mockLnurlWithdrawManager struct {
 	withdraw.Manager
 
@@ -157,6 +252,21 @@ func (m *mockLnurlWithdrawManager) GetWithdrawRequest(k1 string) (*models.Withdr
 	return m.mockGetWithdrawRequest(k1)
 }
 
+type mockLnurlPayManager struct {
+	pay.Manager
+
+	mockGetPayRequest func(k1 string) (*models.Pay, string, string, error)
+	mockGetPayInvoice func(k1 string, amount uint64) (string, error)
+}
+
+func (m *mockLnurlPayManager) GetPayRequest(k1 string) (*models.Pay, string, string, error) {
+	return m.mockGetPayRequest(k1)
+}
+
+func (m *mockLnurlPayManager) GetPayInvoice(k1 string, amount uint64) (string, error) {
+	return m.mockGetPayInvoice(k1, amount)
+}
+
 type mockInvoiceManager struct {
 	invoice.Manager
 	mockPayWithdrawInvoice func(k1, pr string) error
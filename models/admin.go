@@ -0,0 +1,156 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/util"
+	"gorm.io/gorm"
+)
+
+// Roles of admin accounts, which determine the XlnAdmin RPCs that an admin may call. Each role may call the RPCs of
+// the roles before it.
+const (
+	// RPCs that do not change anything
+	AdminRoleAuditor = "auditor"
+	// locking and unlocking wallets
+	AdminRoleSupport = "support"
+	// every RPC, including the management of admin accounts
+	AdminRoleAdmin = "admin"
+)
+
+// AdminRoles lists every role of admin accounts, from least to most privileged.
+var AdminRoles = []string{AdminRoleAuditor, AdminRoleSupport, AdminRoleAdmin}
+
+// AdminRoleAllows returns true if admins of role may call the RPCs of required.
+func AdminRoleAllows(role, required string) bool {
+	rank := func(r string) int {
+		for i, known := range AdminRoles {
+			if known == r {
+				return i
+			}
+		}
+		return -1
+	}
+	return rank(role) >= 0 && rank(role) >= rank(required)
+}
+
+// Admin is an account that manages XLN through the XlnAdmin service, which may only call the RPCs of its role.
+type Admin struct {
+	Name      string `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// AdminRoleX constant
+	Role string
+	// only a salted hash of the key is stored, which is looked up by the key's prefix.
+	// Key is only set when the key is generated, which is when the admin is created or the key is rotated
+	Key       string `gorm:"-"`
+	KeyPrefix string `gorm:"size:8;index"`
+	KeyHash   string
+}
+
+func (r *repository) CreateAdmin(tx *gorm.DB, admin *Admin) error {
+	if admin == nil {
+		return fmt.Errorf("%s. Reason: %v", MsgCreateAdminFailed, MsgReceivedNil)
+	} else if err := tx.Create(admin).Error; err != nil {
+		if strings.Contains(err.Error(), gormMsgSubstrUniqueConstraintFailed) ||
+			strings.Contains(err.Error(), gormMsgSubstrDuplicateKey) {
+			return fmt.Errorf("%s. Reason: %v", MsgCreateAdminFailed, MsgDuplicateAdminName)
+		}
+		log.WithError(err).WithField("admin", admin.Name).Error(MsgCreateAdminFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgCreateAdminFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) GetAdmin(tx *gorm.DB, name string) (*Admin, error) {
+	var admin Admin
+	if err := tx.Take(&admin, "name = ?", name).Error; err == gorm.ErrRecordNotFound {
+		return nil, ErrAdminNotFound
+	} else if err != nil {
+		log.WithError(err).WithField("admin", name).Error(MsgGetAdminFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetAdminFailed, ErrInternal)
+	} else {
+		return &admin, nil
+	}
+}
+
+func (r *repository) GetAdminWithKey(tx *gorm.DB, key string) (*Admin, error) {
+	var admins []*Admin
+	if err := tx.Find(&admins, "key_prefix = ?", util.KeyPrefix(key)).Error; err != nil {
+		log.WithError(err).Error(MsgGetAdminFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetAdminFailed, ErrInternal)
+	}
+	for _, admin := range admins {
+		if util.KeyMatchesHash(key, admin.KeyHash) {
+			return admin, nil
+		}
+	}
+	return nil, ErrAdminNotFound
+}
+
+func (r *repository) ListAdmins(tx *gorm.DB) ([]*Admin, error) {
+	var admins []*Admin
+	if err := tx.Order("created_at").Find(&admins).Error; err != nil {
+		log.WithError(err).Error(MsgListAdminsFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgListAdminsFailed, ErrInternal)
+	} else {
+		return admins, nil
+	}
+}
+
+func (r *repository) CountAdminsWithRole(tx *gorm.DB, role string) (int64, error) {
+	var count int64
+	if err := tx.Model(&Admin{}).Where("role = ?", role).Count(&count).Error; err != nil {
+		log.WithError(err).WithField("role", role).Error(MsgListAdminsFailed)
+		return 0, fmt.Errorf("%s. Reason: %v", MsgListAdminsFailed, ErrInternal)
+	} else {
+		return count, nil
+	}
+}
+
+func (r *repository) UpdateAdminRole(tx *gorm.DB, name, role string) error {
+	if res := tx.Model(&Admin{}).Where("name = ?", name).Update("role", role); res.Error != nil {
+		log.WithError(res.Error).WithFields(log.Fields{
+			"admin": name,
+			"role":  role,
+		}).Error(MsgUpdateAdminFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgUpdateAdminFailed, ErrInternal)
+	} else if res.RowsAffected == 0 {
+		return ErrAdminNotFound
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) RotateAdminKey(tx *gorm.DB, name string) (string, error) {
+	key, prefix, hash, err := genHashedKey()
+	if err != nil {
+		log.WithError(err).WithField("admin", name).Error(MsgRotateApiKeyFailed)
+		return "", fmt.Errorf("%s. Reason: %v", MsgRotateApiKeyFailed, ErrInternal)
+	}
+	if res := tx.Model(&Admin{}).Where("name = ?", name).
+		Updates(Admin{KeyPrefix: prefix, KeyHash: hash}); res.Error != nil {
+		log.WithError(res.Error).WithField("admin", name).Error(MsgRotateApiKeyFailed)
+		return "", fmt.Errorf("%s. Reason: %v", MsgRotateApiKeyFailed, ErrInternal)
+	} else if res.RowsAffected == 0 {
+		return "", ErrAdminNotFound
+	} else {
+		return key, nil
+	}
+}
+
+func (r *repository) DeleteAdmin(tx *gorm.DB, name string) error {
+	if res := tx.Where("name = ?", name).Delete(&Admin{}); res.Error != nil {
+		log.WithError(res.Error).WithField("admin", name).Error(MsgDeleteAdminFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgDeleteAdminFailed, ErrInternal)
+	} else if res.RowsAffected == 0 {
+		return ErrAdminNotFound
+	} else {
+		return nil
+	}
+}
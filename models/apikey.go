@@ -0,0 +1,244 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/util"
+	"gorm.io/gorm"
+)
+
+// Scopes of API keys, which determine the RPCs that a key may call
+const (
+	// RPCs that do not change anything
+	ScopeRead = "read"
+	// creating and managing the invoices of wallets
+	ScopeInvoice = "invoice"
+	// paying invoices and sending funds over lightning
+	ScopePay = "pay"
+	// transferring funds to wallets on this node
+	ScopeTransfer = "transfer"
+	// every RPC of the user, including the management of wallets and API keys. Keys of wallets with it may call
+	// every RPC of their wallet
+	ScopeAdmin = "admin"
+)
+
+// Scopes lists every scope of API keys.
+var Scopes = []string{ScopeRead, ScopeInvoice, ScopePay, ScopeTransfer, ScopeAdmin}
+
+// ApiKey is a named key of a user, or of one of the user's wallets if WalletID is set, which may only call the RPCs
+// of its scopes. The keys of users apply to all of the user's wallets.
+type ApiKey struct {
+	ID string `gorm:"primaryKey" sql:"type:uuid"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// the keys of a user are removed when the user is deleted, and those of a wallet when the wallet is deleted
+	Username string  `gorm:"index:idx_api_keys_wallet"`
+	WalletID *string `gorm:"index:idx_api_keys_wallet"`
+	Wallet   Wallet  `gorm:"foreignKey:username,wallet_id;references:username,id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+
+	Name string
+	// only a salted hash of the key is stored, which is looked up by the key's prefix.
+	// Key is only set when the key is created
+	Key       string `gorm:"-"`
+	KeyPrefix string `gorm:"size:8;index"`
+	KeyHash   string
+	// comma separated list of the key's scopes, which are ScopeX constants
+	Scopes string
+	// the key is rejected after ExpiresAt if it is set
+	ExpiresAt *time.Time
+	// time that the key was last used, to the precision that the auth service records it
+	LastUsedAt *time.Time
+}
+
+// ScopeList returns the scopes of the key.
+func (k *ApiKey) ScopeList() []string {
+	if k.Scopes == "" {
+		return nil
+	}
+	return strings.Split(k.Scopes, ",")
+}
+
+// HasScope returns true if the key has the scope, or has ScopeAdmin.
+func (k *ApiKey) HasScope(scope string) bool {
+	for _, s := range k.ScopeList() {
+		if s == scope || s == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// Expired returns true if the key may no longer be used at time now.
+func (k *ApiKey) Expired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+func (r *repository) CreateApiKey(tx *gorm.DB, key *ApiKey) error {
+	if key == nil {
+		return fmt.Errorf("%s. Reason: %v", MsgCreateApiKeyFailed, MsgReceivedNil)
+	} else if err := tx.Create(key).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"user":   key.Username,
+			"wallet": key.WalletID,
+		}).Error(MsgCreateApiKeyFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgCreateApiKeyFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) GetApiKey(tx *gorm.DB, key string) (*ApiKey, error) {
+	var apiKeys []*ApiKey
+	if err := tx.Find(&apiKeys, "key_prefix = ?", util.KeyPrefix(key)).Error; err != nil {
+		log.WithError(err).Error(MsgGetApiKeyFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetApiKeyFailed, ErrInternal)
+	}
+	for _, apiKey := range apiKeys {
+		if util.KeyMatchesHash(key, apiKey.KeyHash) {
+			return apiKey, nil
+		}
+	}
+	return nil, ErrApiKeyNotFound
+}
+
+func (r *repository) GetUserApiKey(tx *gorm.DB, username, id string) (*ApiKey, error) {
+	apiKey := ApiKey{}
+	if err := tx.Take(&apiKey, "username = ? AND id = ?", username, id).Error; err == gorm.ErrRecordNotFound {
+		return nil, ErrApiKeyNotFound
+	} else if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"user": username,
+			"key":  id,
+		}).Error(MsgGetApiKeyFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetApiKeyFailed, ErrInternal)
+	} else {
+		return &apiKey, nil
+	}
+}
+
+func (r *repository) ListUserApiKeys(tx *gorm.DB, username string) ([]*ApiKey, error) {
+	var keys []*ApiKey
+	if err := tx.Order("created_at").Find(&keys, "username = ?", username).Error; err != nil {
+		log.WithError(err).WithField("user", username).Error(MsgListApiKeysFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgListApiKeysFailed, ErrInternal)
+	} else {
+		return keys, nil
+	}
+}
+
+func (r *repository) UpdateApiKeyLastUsed(tx *gorm.DB, id string, time time.Time) error {
+	if err := tx.Model(&ApiKey{}).Where("id = ?", id).UpdateColumn("last_used_at", time).Error; err != nil {
+		log.WithError(err).WithField("key", id).Error(MsgUpdateApiKeyFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgUpdateApiKeyFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) DeleteApiKey(tx *gorm.DB, username, id string) error {
+	if res := tx.Where("username = ? AND id = ?", username, id).Delete(&ApiKey{}); res.Error != nil {
+		log.WithError(res.Error).WithFields(log.Fields{
+			"user": username,
+			"key":  id,
+		}).Error(MsgDeleteApiKeyFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgDeleteApiKeyFailed, ErrInternal)
+	} else if res.RowsAffected == 0 {
+		return ErrApiKeyNotFound
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) HashPlaintextApiKeys(tx *gorm.DB) (int, error) {
+	// wallets that predate webhook secrets signed webhook deliveries with their api keys, which must not be kept in
+	// plaintext, so they are given new secrets that receivers have to fetch again
+	if exists, err := hasColumn(tx, &Wallet{}, "api_key"); err != nil {
+		log.WithError(err).Error(MsgHashApiKeysFailed)
+		return 0, fmt.Errorf("%s. Reason: %v", MsgHashApiKeysFailed, ErrInternal)
+	} else if exists {
+		var wallets []*Wallet
+		if err := tx.Select("id", "username").Where("webhook_secret IS NULL OR webhook_secret = ''").
+			Find(&wallets).Error; err != nil {
+			log.WithError(err).Error(MsgHashApiKeysFailed)
+			return 0, fmt.Errorf("%s. Reason: %v", MsgHashApiKeysFailed, ErrInternal)
+		}
+		for _, wallet := range wallets {
+			secret, err := util.GenKey()
+			if err != nil {
+				log.WithError(err).Error(MsgHashApiKeysFailed)
+				return 0, fmt.Errorf("%s. Reason: %v", MsgHashApiKeysFailed, ErrInternal)
+			} else if err := tx.Model(&Wallet{}).Where("username = ? AND id = ?", wallet.Username, wallet.ID).
+				UpdateColumn("webhook_secret", secret).Error; err != nil {
+				log.WithError(err).Error(MsgHashApiKeysFailed)
+				return 0, fmt.Errorf("%s. Reason: %v", MsgHashApiKeysFailed, ErrInternal)
+			}
+		}
+		if len(wallets) > 0 {
+			log.WithField("total", len(wallets)).Warn("Generated new webhook secrets for wallets that signed " +
+				"webhook deliveries with their api keys. Receivers must fetch the secrets of these wallets again")
+		}
+	}
+	total := 0
+	for _, plaintext := range []struct {
+		model                interface{}
+		column, prefix, hash string
+	}{
+		{&User{}, "api_key", "api_key_prefix", "api_key_hash"},
+		{&Wallet{}, "api_key", "api_key_prefix", "api_key_hash"},
+		{&ApiKey{}, "key", "key_prefix", "key_hash"},
+	} {
+		if exists, err := hasColumn(tx, plaintext.model, plaintext.column); err != nil {
+			log.WithError(err).Error(MsgHashApiKeysFailed)
+			return 0, fmt.Errorf("%s. Reason: %v", MsgHashApiKeysFailed, ErrInternal)
+		} else if !exists {
+			continue
+		}
+		var keys []string
+		if err := tx.Model(plaintext.model).Where(plaintext.column+" IS NOT NULL AND "+plaintext.column+" <> ''").
+			Pluck(plaintext.column, &keys).Error; err != nil {
+			log.WithError(err).Error(MsgHashApiKeysFailed)
+			return 0, fmt.Errorf("%s. Reason: %v", MsgHashApiKeysFailed, ErrInternal)
+		}
+		for _, key := range keys {
+			hash, err := util.HashKey(key)
+			if err != nil {
+				log.WithError(err).Error(MsgHashApiKeysFailed)
+				return 0, fmt.Errorf("%s. Reason: %v", MsgHashApiKeysFailed, ErrInternal)
+			} else if err := tx.Model(plaintext.model).Where(plaintext.column+" = ?", key).
+				UpdateColumns(map[string]interface{}{
+					plaintext.column: nil,
+					plaintext.prefix: util.KeyPrefix(key),
+					plaintext.hash:   hash,
+				}).Error; err != nil {
+				log.WithError(err).Error(MsgHashApiKeysFailed)
+				return 0, fmt.Errorf("%s. Reason: %v", MsgHashApiKeysFailed, ErrInternal)
+			}
+		}
+		if err := tx.Migrator().DropColumn(plaintext.model, plaintext.column); err != nil {
+			log.WithError(err).Error(MsgHashApiKeysFailed)
+			return 0, fmt.Errorf("%s. Reason: %v", MsgHashApiKeysFailed, ErrInternal)
+		}
+		total += len(keys)
+	}
+	return total, nil
+}
+
+// hasColumn returns true if the table of model has the column. Unlike Migrator().HasColumn, it does not match columns
+// whose names only appear in the table's constraints.
+func hasColumn(tx *gorm.DB, model interface{}, column string) (bool, error) {
+	columns, err := tx.Migrator().ColumnTypes(model)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range columns {
+		if c.Name() == column {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -66,3 +66,14 @@ func (r *repository) GetAuth(tx *gorm.DB, k1 string) (*Auth, error) {
 	}
 	return auth, res.Error
 }
+
+func (r *repository) DeleteAuth(tx *gorm.DB, k1 string) error {
+	if res := tx.Where("k1 = ?", k1).Delete(&Auth{}); res.Error != nil {
+		log.WithError(res.Error).WithField("k1", k1).Error(MsgDeleteAuthFailed)
+		return ErrInternal
+	} else if res.RowsAffected == 0 {
+		return ErrAuthNotFound
+	} else {
+		return nil
+	}
+}
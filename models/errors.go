@@ -8,16 +8,20 @@ var (
 	MsgGetAuthFailed    = "failed to get ln auth record"
 	MsgCreateAuthFailed = "failed to create ln auth record"
 	MsgAuthFailed       = "failed to authenticate"
+	MsgDeleteAuthFailed = "failed to delete ln auth record"
 
 	// invoice
-	MsgInvoiceNotFound                = "could not find invoice"
-	MsgListInvoicesFailed             = "failed to list invoices"
-	MsgGetInvoiceFailed               = "failed to get invoice"
-	MsgCreateInvoiceFailed            = "failed to create invoice"
-	MsgUpdateInvoiceSettledDateFailed = "failed to update invoice settlement date"
-	MsgNullifyInvoiceSenderFailed     = "failed to set invoice sender to nil"
-	MsgNullifyInvoiceRecipientFailed  = "failed to set invoice recipient to nil"
-	MsgUpdateInvoiceSenderFailed      = "failed to update invoice sender"
+	MsgInvoiceNotFound                  = "could not find invoice"
+	MsgListInvoicesFailed               = "failed to list invoices"
+	MsgGetInvoiceFailed                 = "failed to get invoice"
+	MsgCreateInvoiceFailed              = "failed to create invoice"
+	MsgUpdateInvoiceSettledDateFailed   = "failed to update invoice settlement date"
+	MsgUpdateInvoiceCancelledDateFailed = "failed to update invoice cancellation date"
+	MsgNullifyInvoiceSenderFailed       = "failed to set invoice sender to nil"
+	MsgNullifyInvoiceRecipientFailed    = "failed to set invoice recipient to nil"
+	MsgUpdateInvoiceSenderFailed        = "failed to update invoice sender"
+	MsgGetLastSettleIndexFailed         = "failed to get last settle index of invoices"
+	MsgSetLastSettleIndexFailed         = "failed to set last settle index of invoices"
 
 	// pending invoice
 	MsgPendingInvoiceNotFound          = "could not find pending invoice"
@@ -26,6 +30,7 @@ var (
 	MsgListPendingInvoicesFailed       = "failed to list pending invoices"
 	MsgCreatePendingInvoiceFailed      = "failed to create pending invoice"
 	MsgDeletePendingInvoiceFailed      = "failed to delete pending invoice"
+	MsgAcceptPendingInvoiceFailed      = "failed to accept pending invoice"
 
 	// pending payment
 	MsgPendingPaymentNotFound          = "could not find pending payment"
@@ -44,6 +49,7 @@ var (
 	MsgListUserTransactionsFailed        = "failed to list user's transactions"
 	MsgNullifyTransactionSenderFailed    = "failed to set transaction sender to nil"
 	MsgNullifyTranscationRecipientFailed = "failed to set transaction recipient to nil"
+	MsgListInvoiceTransactionsFailed     = "failed to list invoice transactions"
 
 	// user
 	MsgUserNotFound            = "could not find user"
@@ -77,6 +83,13 @@ var (
 	MsgLinkedWalletNotFound               = "could not find record of wallet linked to a ln wallet"
 	MsgGetLinkedWalletFailed              = "failed to get wallet that is linked to ln wallet"
 	MsgGetConfirmedBalanceFailed          = "failed to confirm the current wallet balance"
+	MsgAddressAliasAlreadyExists          = "wallet with that address alias already exists"
+	MsgGetTotalWalletBalanceFailed        = "failed to get total balance of wallets"
+	MsgAddressAliasNotFound               = "could not find wallet with address alias"
+	MsgGetWalletWithAddressAliasFailed    = "failed to get wallet with address alias"
+	MsgRoutingTagNotFound                 = "could not find wallet with routing tag"
+	MsgGetWalletWithRoutingTagFailed      = "failed to get wallet with routing tag"
+	MsgAssignRoutingTagsFailed            = "failed to assign routing tags to wallets"
 
 	// Withdraw
 	MsgCreateWithdrawFailed             = "failed to create withdraw"
@@ -84,6 +97,80 @@ var (
 	MsgWithdrawNotFound                 = "could not find withdraw"
 	MsgListWalletPendingWithdrawsFailed = "failed to list wallet's pending withdraws"
 
+	// Pay
+	MsgCreatePayFailed = "failed to create pay link"
+	MsgGetPayFailed    = "failed to get pay link"
+	MsgPayNotFound     = "could not find pay link"
+
+	// Webhook
+	MsgCreateWebhookFailed           = "failed to create webhook"
+	MsgListWebhooksFailed            = "failed to list webhooks"
+	MsgDeleteWebhookFailed           = "failed to delete webhook"
+	MsgWebhookNotFound               = "could not find webhook"
+	MsgCreateWebhookDeliveriesFailed = "failed to queue webhook deliveries"
+	MsgListWebhookDeliveriesFailed   = "failed to list webhook deliveries"
+	MsgDeleteWebhookDeliveryFailed   = "failed to delete webhook delivery"
+	MsgUpdateWebhookDeliveryFailed   = "failed to update webhook delivery"
+
+	// schedule
+	MsgCreateScheduleFailed    = "failed to create schedule"
+	MsgListSchedulesFailed     = "failed to list schedules"
+	MsgUpdateScheduleFailed    = "failed to update schedule"
+	MsgScheduleNotFound        = "could not find active schedule"
+	MsgCreateScheduleRunFailed = "failed to record schedule run"
+
+	// ledger
+	MsgPostJournalFailed          = "failed to record ledger entries"
+	MsgUnbalancedJournal          = "ledger entries do not balance"
+	MsgGetLedgerBalanceFailed     = "failed to get ledger balance of wallet"
+	MsgSeedLedgerFailed           = "failed to record opening balances in ledger"
+	MsgListLedgerMismatchesFailed = "failed to list wallets that do not match the ledger"
+
+	// spending policy
+	MsgGetSpendingPoliciesFailed = "failed to get spending policies"
+	MsgSetSpendingPolicyFailed   = "failed to set spending policy"
+	MsgGetOutflowFailed          = "failed to get the outflow of wallet"
+
+	// payment defaults
+	MsgGetPaymentDefaultsFailed = "failed to get payment defaults"
+	MsgSetPaymentDefaultsFailed = "failed to set payment defaults"
+
+	// fee schedule
+	MsgGetFeeScheduleFailed = "failed to get fee schedule"
+	MsgSetFeeScheduleFailed = "failed to set fee schedule"
+	MsgFeeScheduleNotFound  = "could not find fee schedule"
+
+	// idempotency
+	MsgCreateIdempotencyKeyFailed   = "failed to claim idempotency key"
+	MsgGetIdempotencyKeyFailed      = "failed to get idempotency key"
+	MsgCompleteIdempotencyKeyFailed = "failed to store response of idempotent request"
+	MsgDeleteIdempotencyKeyFailed   = "failed to release idempotency key"
+	MsgIdempotencyKeyNotFound       = "could not find idempotency key"
+
+	// api key
+	MsgCreateApiKeyFailed = "failed to create api key"
+	MsgGetApiKeyFailed    = "failed to get api key"
+	MsgListApiKeysFailed  = "failed to list api keys"
+	MsgUpdateApiKeyFailed = "failed to update api key"
+	MsgDeleteApiKeyFailed = "failed to delete api key"
+	MsgApiKeyNotFound     = "could not find api key"
+	MsgRotateApiKeyFailed = "failed to rotate api key"
+	MsgHashApiKeysFailed  = "failed to hash plaintext api keys"
+
+	// macaroon
+	MsgCreateMacaroonRootKeyFailed = "failed to create macaroon root key"
+	MsgGetMacaroonRootKeyFailed    = "failed to get macaroon root key"
+	MsgMacaroonRootKeyNotFound     = "could not find macaroon root key"
+
+	// admin
+	MsgCreateAdminFailed  = "failed to create admin"
+	MsgGetAdminFailed     = "failed to get admin"
+	MsgListAdminsFailed   = "failed to list admins"
+	MsgUpdateAdminFailed  = "failed to update admin"
+	MsgDeleteAdminFailed  = "failed to delete admin"
+	MsgAdminNotFound      = "could not find admin"
+	MsgDuplicateAdminName = "admin with that name already exists"
+
 	// misc
 	MsgReceivedNil                      = "expected to receive record but received nil instead"
 	MsgCannotHaveLabelForNilValue       = "cannot assign a label to a nil value"
@@ -100,6 +187,7 @@ var (
 	ErrMaxNumResultsExceeded          = errors.New("number of results exceed the maximum allowed to be returned")
 	ErrInsufficientBalance            = errors.New("wallet has insufficient balance")
 	ErrNonZeroBalance                 = errors.New("wallet has non-zero balance")
+	ErrCannotDeleteRevenueWallet      = errors.New("cannot delete the wallet that service fees are credited to")
 	ErrInvoiceNotFound                = errors.New(MsgInvoiceNotFound)
 	ErrUserNotFound                   = errors.New(MsgUserNotFound)
 	ErrWalletNotFound                 = errors.New(MsgWalletNotFound)
@@ -110,4 +198,23 @@ var (
 	ErrLinkedUserNotFound             = errors.New(MsgLinkedUserNotFound)
 	ErrLinkedWalletNotFound           = errors.New(MsgLinkedWalletNotFound)
 	ErrWithdrawNotFound               = errors.New(MsgWithdrawNotFound)
+	ErrPayNotFound                    = errors.New(MsgPayNotFound)
+	ErrAddressAliasNotFound           = errors.New(MsgAddressAliasNotFound)
+	ErrRoutingTagNotFound             = errors.New(MsgRoutingTagNotFound)
+	ErrNotHoldInvoice                 = errors.New("invoice is not a hold invoice")
+	ErrHoldInvoiceNotAccepted         = errors.New("hold invoice has not been paid")
+	ErrInvoiceExpired                 = errors.New("invoice expired")
+	ErrWebhookNotFound                = errors.New(MsgWebhookNotFound)
+	ErrScheduleNotFound               = errors.New(MsgScheduleNotFound)
+	ErrUnbalancedJournal              = errors.New(MsgUnbalancedJournal)
+	ErrSpendingPolicyViolated         = errors.New("spending policy violated")
+	ErrTransfersNotAccepted           = errors.New("recipient wallet does not exist or does not accept transfers")
+	ErrFeeScheduleNotFound            = errors.New(MsgFeeScheduleNotFound)
+	ErrIdempotencyKeyNotFound         = errors.New(MsgIdempotencyKeyNotFound)
+	ErrIdempotencyKeyInUse            = errors.New("idempotency key is already in use")
+	ErrIdempotencyKeyInProgress       = errors.New("a request with the idempotency key is still in progress")
+	ErrIdempotencyKeyReused           = errors.New("idempotency key was already used with a different request")
+	ErrApiKeyNotFound                 = errors.New(MsgApiKeyNotFound)
+	ErrMacaroonRootKeyNotFound        = errors.New(MsgMacaroonRootKeyNotFound)
+	ErrAdminNotFound                  = errors.New(MsgAdminNotFound)
 )
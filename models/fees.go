@@ -0,0 +1,86 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// Kinds of payments that are charged service fees
+const (
+	// transfers between wallets on this node, including transfers to the wallets of other users
+	FeeKindTransfer = "transfer"
+	// payments of invoices of wallets on this node
+	FeeKindInternal = "internal"
+	// payments sent over lightning
+	FeeKindExternal = "external"
+)
+
+// FeeRate is a flat fee plus a proportion of the amount of a payment.
+type FeeRate struct {
+	BaseMsat uint64
+	// parts per million of the amount
+	Ppm uint64
+}
+
+// Fee returns the fee of a payment of amount.
+func (r FeeRate) Fee(amount uint64) uint64 {
+	return r.BaseMsat + amount*r.Ppm/1000000
+}
+
+// FeeSchedule is the service fees that wallets are charged for payments, on top of any routing fees. The fee
+// schedule of a user replaces that of the node for the user's wallets.
+type FeeSchedule struct {
+	Username string `gorm:"primaryKey"`
+	User     User   `gorm:"foreignKey:Username;references:Username;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Transfer FeeRate `gorm:"embedded;embeddedPrefix:transfer_"`
+	Internal FeeRate `gorm:"embedded;embeddedPrefix:internal_"`
+	External FeeRate `gorm:"embedded;embeddedPrefix:external_"`
+}
+
+// Fee returns the fee of a payment of amount of the kind, which is one of the FeeKind constants.
+func (s *FeeSchedule) Fee(kind string, amount uint64) uint64 {
+	switch kind {
+	case FeeKindTransfer:
+		return s.Transfer.Fee(amount)
+	case FeeKindInternal:
+		return s.Internal.Fee(amount)
+	case FeeKindExternal:
+		return s.External.Fee(amount)
+	default:
+		return 0
+	}
+}
+
+func (r *repository) GetFeeSchedule(tx *gorm.DB, username string) (*FeeSchedule, error) {
+	schedule := FeeSchedule{}
+	if err := tx.Take(&schedule, "username = ?", username).Error; err == gorm.ErrRecordNotFound {
+		return nil, ErrFeeScheduleNotFound
+	} else if err != nil {
+		log.WithError(err).WithField("user", username).Error(MsgGetFeeScheduleFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetFeeScheduleFailed, ErrInternal)
+	} else {
+		return &schedule, nil
+	}
+}
+
+func (r *repository) SetFeeSchedule(tx *gorm.DB, username string, schedule *FeeSchedule) error {
+	if err := tx.Where("username = ?", username).Delete(&FeeSchedule{}).Error; err != nil {
+		log.WithError(err).WithField("user", username).Error(MsgSetFeeScheduleFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgSetFeeScheduleFailed, ErrInternal)
+	} else if schedule == nil {
+		return nil
+	}
+	schedule.Username = username
+	if err := tx.Create(schedule).Error; err != nil {
+		log.WithError(err).WithField("user", username).Error(MsgSetFeeScheduleFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgSetFeeScheduleFailed, ErrInternal)
+	}
+	return nil
+}
@@ -17,13 +17,15 @@ func (user *User) BeforeCreate(tx *gorm.DB) error {
 		}
 		tx.Statement.SetColumn("Username", id)
 	}
-	key, err := util.GenKey()
+	key, prefix, hash, err := genHashedKey()
 	if err != nil {
 		tx.Logger.Error(tx.Statement.Context,
 			"Failed to create user because key could not be generated")
 		return err
 	}
-	tx.Statement.SetColumn("ApiKey", key)
+	user.ApiKey = key
+	tx.Statement.SetColumn("ApiKeyPrefix", prefix)
+	tx.Statement.SetColumn("ApiKeyHash", hash)
 	return nil
 }
 
@@ -45,12 +47,30 @@ func (wallet *Wallet) BeforeCreate(tx *gorm.DB) error {
 	if wallet.Name == nil {
 		tx.Statement.SetColumn("Name", id)
 	}
-	key, err := util.GenKey()
+	key, prefix, hash, err := genHashedKey()
 	if err != nil {
 		tx.Logger.Error(tx.Statement.Context, "Failed to create wallet because key could not be generated")
 		return err
 	}
-	tx.Statement.SetColumn("ApiKey", key)
+	wallet.ApiKey = key
+	tx.Statement.SetColumn("ApiKeyPrefix", prefix)
+	tx.Statement.SetColumn("ApiKeyHash", hash)
+	if wallet.WebhookSecret == "" {
+		secret, err := util.GenKey()
+		if err != nil {
+			tx.Logger.Error(tx.Statement.Context, "Failed to create wallet because webhook secret could not be generated")
+			return err
+		}
+		tx.Statement.SetColumn("WebhookSecret", secret)
+	}
+	if wallet.RoutingTag == nil {
+		tag, err := util.GenRoutingTag()
+		if err != nil {
+			tx.Logger.Error(tx.Statement.Context, "Failed to create wallet because routing tag could not be generated")
+			return err
+		}
+		tx.Statement.SetColumn("RoutingTag", tag)
+	}
 	return nil
 }
 
@@ -84,6 +104,87 @@ func (user *Withdraw) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+func (pay *Pay) BeforeCreate(tx *gorm.DB) error {
+	k1, err := util.GenURLRandStr(32)
+	if err != nil {
+		tx.Logger.Error(tx.Statement.Context,
+			"Failed to create pay because rand id could not be generated")
+		return err
+	}
+	tx.Statement.SetColumn("k1", k1)
+	return nil
+}
+
+func (webhook *Webhook) BeforeCreate(tx *gorm.DB) error {
+	id, err := createUUID()
+	if err != nil {
+		tx.Logger.Error(tx.Statement.Context, "Failed to create webhook because UUID could not be generated")
+		return err
+	}
+	tx.Statement.SetColumn("ID", id)
+	return nil
+}
+
+func (schedule *Schedule) BeforeCreate(tx *gorm.DB) error {
+	id, err := createUUID()
+	if err != nil {
+		tx.Logger.Error(tx.Statement.Context, "Failed to create schedule because UUID could not be generated")
+		return err
+	}
+	tx.Statement.SetColumn("ID", id)
+	return nil
+}
+
+func (key *ApiKey) BeforeCreate(tx *gorm.DB) error {
+	id, err := createUUID()
+	if err != nil {
+		tx.Logger.Error(tx.Statement.Context, "Failed to create api key because UUID could not be generated")
+		return err
+	}
+	tx.Statement.SetColumn("ID", id)
+	apiKey, prefix, hash, err := genHashedKey()
+	if err != nil {
+		tx.Logger.Error(tx.Statement.Context, "Failed to create api key because key could not be generated")
+		return err
+	}
+	key.Key = apiKey
+	tx.Statement.SetColumn("KeyPrefix", prefix)
+	tx.Statement.SetColumn("KeyHash", hash)
+	return nil
+}
+
+func (admin *Admin) BeforeCreate(tx *gorm.DB) error {
+	key, prefix, hash, err := genHashedKey()
+	if err != nil {
+		tx.Logger.Error(tx.Statement.Context, "Failed to create admin because key could not be generated")
+		return err
+	}
+	admin.Key = key
+	tx.Statement.SetColumn("KeyPrefix", prefix)
+	tx.Statement.SetColumn("KeyHash", hash)
+	return nil
+}
+
+func (delivery *WebhookDelivery) BeforeCreate(tx *gorm.DB) error {
+	id, err := createUUID()
+	if err != nil {
+		tx.Logger.Error(tx.Statement.Context, "Failed to create webhook delivery because UUID could not be generated")
+		return err
+	}
+	tx.Statement.SetColumn("ID", id)
+	return nil
+}
+
+// genHashedKey generates an api key, and returns it with the prefix that it is looked up by and its salted hash
+func genHashedKey() (key, prefix, hash string, err error) {
+	if key, err = util.GenKey(); err != nil {
+		return "", "", "", err
+	} else if hash, err = util.HashKey(key); err != nil {
+		return "", "", "", err
+	}
+	return key, util.KeyPrefix(key), hash, nil
+}
+
 func createUUID() (string, error) {
 	id, err := uuid.NewV4()
 	if err != nil {
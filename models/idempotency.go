@@ -0,0 +1,141 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// IdempotencyKey is a client-supplied key that identifies a request to a wallet, so that retries of the
+// request return the response of the original instead of executing again.
+// The key is claimed before the request executes, and the response is stored once it completes.
+// Claims that never complete, and keys that completed long ago, may be claimed again.
+type IdempotencyKey struct {
+	Username string `gorm:"primaryKey"`
+	WalletID string `gorm:"primaryKey"`
+	Key      string `gorm:"primaryKey;column:idempotency_key"`
+	Wallet   Wallet `gorm:"foreignKey:username,wallet_id;references:username,id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// method that the key was used with
+	Method string
+	// hash of the request that the key was used with
+	RequestHash string
+
+	// time that the key was last claimed. Keys from before claims were timed have none, and were claimed when
+	// they were created
+	ClaimedAt time.Time `gorm:"index"`
+	Completed bool
+	Response  []byte
+}
+
+// ClaimTime returns the time that the key was last claimed.
+func (k *IdempotencyKey) ClaimTime() time.Time {
+	if k.ClaimedAt.IsZero() {
+		return k.CreatedAt
+	}
+	return k.ClaimedAt
+}
+
+func (r *repository) CreateIdempotencyKey(tx *gorm.DB, key *IdempotencyKey) error {
+	if key == nil {
+		return fmt.Errorf("%s. Reason: %v", MsgCreateIdempotencyKeyFailed, MsgReceivedNil)
+	} else if err := tx.Create(key).Error; err != nil {
+		if strings.Contains(err.Error(), gormMsgSubstrUniqueConstraintFailed) ||
+			strings.Contains(err.Error(), gormMsgSubstrDuplicateKey) {
+			return ErrIdempotencyKeyInUse
+		}
+		log.WithError(err).WithFields(log.Fields{
+			"user":   key.Username,
+			"wallet": key.WalletID,
+			"method": key.Method,
+		}).Error(MsgCreateIdempotencyKeyFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgCreateIdempotencyKeyFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) GetIdempotencyKey(tx *gorm.DB, username, walletId, key string) (*IdempotencyKey, error) {
+	var idempotencyKey IdempotencyKey
+	if err := tx.Take(&idempotencyKey, "username = ? AND wallet_id = ? AND idempotency_key = ?",
+		username, walletId, key).Error; err == gorm.ErrRecordNotFound {
+		return nil, ErrIdempotencyKeyNotFound
+	} else if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"user":   username,
+			"wallet": walletId,
+		}).Error(MsgGetIdempotencyKeyFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetIdempotencyKeyFailed, ErrInternal)
+	} else {
+		return &idempotencyKey, nil
+	}
+}
+
+func (r *repository) CompleteIdempotencyKey(tx *gorm.DB, username, walletId, key string, response []byte) error {
+	res := tx.Model(&IdempotencyKey{}).
+		Where("username = ? AND wallet_id = ? AND idempotency_key = ?", username, walletId, key).
+		Updates(map[string]interface{}{"completed": true, "response": response})
+	if res.Error != nil {
+		log.WithError(res.Error).WithFields(log.Fields{
+			"user":   username,
+			"wallet": walletId,
+		}).Error(MsgCompleteIdempotencyKeyFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgCompleteIdempotencyKeyFailed, ErrInternal)
+	} else if res.RowsAffected == 0 {
+		return ErrIdempotencyKeyNotFound
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) DeleteIdempotencyKey(tx *gorm.DB, username, walletId, key string) error {
+	if err := tx.Where("username = ? AND wallet_id = ? AND idempotency_key = ? AND completed = ?",
+		username, walletId, key, false).Delete(&IdempotencyKey{}).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"user":   username,
+			"wallet": walletId,
+		}).Error(MsgDeleteIdempotencyKeyFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgDeleteIdempotencyKeyFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) ReclaimIdempotencyKey(tx *gorm.DB, key *IdempotencyKey, previous *IdempotencyKey) (bool, error) {
+	res := tx.Model(&IdempotencyKey{}).
+		Where("username = ? AND wallet_id = ? AND idempotency_key = ? AND claimed_at = ? AND completed = ?",
+			key.Username, key.WalletID, key.Key, previous.ClaimedAt, previous.Completed).
+		Updates(map[string]interface{}{
+			"method":       key.Method,
+			"request_hash": key.RequestHash,
+			"claimed_at":   key.ClaimedAt,
+			"completed":    false,
+			"response":     nil,
+		})
+	if res.Error != nil {
+		log.WithError(res.Error).WithFields(log.Fields{
+			"user":   key.Username,
+			"wallet": key.WalletID,
+			"method": key.Method,
+		}).Error(MsgCreateIdempotencyKeyFailed)
+		return false, fmt.Errorf("%s. Reason: %v", MsgCreateIdempotencyKeyFailed, ErrInternal)
+	} else {
+		return res.RowsAffected > 0, nil
+	}
+}
+
+func (r *repository) DeleteExpiredIdempotencyKeys(tx *gorm.DB, claimedBefore time.Time) (int64, error) {
+	res := tx.Where("claimed_at < ? AND created_at < ?", claimedBefore, claimedBefore).Delete(&IdempotencyKey{})
+	if res.Error != nil {
+		log.WithError(res.Error).Error(MsgDeleteIdempotencyKeyFailed)
+		return 0, fmt.Errorf("%s. Reason: %v", MsgDeleteIdempotencyKeyFailed, ErrInternal)
+	} else {
+		return res.RowsAffected, nil
+	}
+}
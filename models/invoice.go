@@ -2,6 +2,8 @@ package models
 
 import (
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -33,6 +35,125 @@ type Invoice struct {
 	SenderID       *string `gorm:"index"`
 	SenderUsername *string `gorm:"index"`
 	Sender         *Wallet `gorm:"foreignKey:sender_id,sender_username;references:id,username;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+
+	// LND settle index of a spontaneous payment to a wallet. Is 0 for all other invoices
+	SettleIndex uint64
+
+	// time after which an invoice created by XLN can no longer be paid. Is nil for all other invoices
+	ExpiresAt *time.Time `gorm:"index"`
+	// time that LND reported an invoice created by XLN as cancelled, which it is once it expires unpaid
+	CancelledAt *time.Time
+}
+
+// SettleCursor is the highest LND settle index of the invoices that XLN has processed. Invoices that settle after
+// it, including those that settle while XLN is offline, are replayed when XLN starts. There is a single cursor.
+type SettleCursor struct {
+	ID          uint `gorm:"primaryKey"`
+	SettleIndex uint64
+}
+
+// ID of the only settle cursor
+const settleCursorID = 1
+
+// Statuses of invoices
+const (
+	InvoiceStatusPending   = "pending"
+	InvoiceStatusSettled   = "settled"
+	InvoiceStatusExpired   = "expired"
+	InvoiceStatusCancelled = "cancelled"
+)
+
+// Directions of invoices relative to a wallet
+const (
+	// invoices that pay the wallet
+	InvoiceDirectionIncoming = "incoming"
+	// invoices that the wallet paid
+	InvoiceDirectionOutgoing = "outgoing"
+)
+
+// Status returns the status of the invoice at time now, which is one of the InvoiceStatus constants.
+// Invoices that were cancelled once they expired are expired rather than cancelled.
+func (i *Invoice) Status(now time.Time) string {
+	if !i.Settled.IsZero() {
+		return InvoiceStatusSettled
+	} else if i.CancelledAt != nil && (i.ExpiresAt == nil || i.CancelledAt.Before(*i.ExpiresAt)) {
+		return InvoiceStatusCancelled
+	} else if i.ExpiresAt != nil && (i.CancelledAt != nil || i.ExpiresAt.Before(now)) {
+		return InvoiceStatusExpired
+	} else {
+		return InvoiceStatusPending
+	}
+}
+
+// Direction returns the direction of the invoice relative to the wallet, which is one of the InvoiceDirection
+// constants.
+func (i *Invoice) Direction(username, walletId string) string {
+	if i.RecipientUsername != nil && *i.RecipientUsername == username && i.RecipientID != nil &&
+		*i.RecipientID == walletId {
+		return InvoiceDirectionIncoming
+	}
+	return InvoiceDirectionOutgoing
+}
+
+// InvoiceFilter selects the invoices of a wallet. Fields that are zero do not filter invoices.
+type InvoiceFilter struct {
+	// one of the InvoiceStatus constants
+	Status string
+	// one of the InvoiceDirection constants
+	Direction string
+	// invoices created at or after StartTime and before EndTime
+	StartTime time.Time
+	EndTime   time.Time
+	MinAmount uint64
+	MaxAmount uint64
+	// invoices whose memo contains Memo, ignoring case
+	Memo string
+}
+
+// where returns the conditions of the filter on the invoices of the wallet at time now.
+func (f *InvoiceFilter) where(tx *gorm.DB, username, walletId string, now time.Time) *gorm.DB {
+	// conditions are grouped on a new session, since tx may already be a chain of conditions
+	group := tx.Session(&gorm.Session{NewDB: true})
+	incoming := group.Where("recipient_username = ? AND recipient_id = ?", username, walletId)
+	outgoing := group.Where("sender_username = ? AND sender_id = ?", username, walletId)
+	switch f.Direction {
+	case InvoiceDirectionIncoming:
+		tx = tx.Where(incoming)
+	case InvoiceDirectionOutgoing:
+		tx = tx.Where(outgoing)
+	default:
+		tx = tx.Where(incoming.Or(outgoing))
+	}
+	var unset time.Time
+	switch f.Status {
+	case InvoiceStatusPending:
+		tx = tx.Where("settled <= ? AND cancelled_at IS NULL AND (expires_at IS NULL OR expires_at >= ?)", unset, now)
+	case InvoiceStatusSettled:
+		tx = tx.Where("settled > ?", unset)
+	case InvoiceStatusExpired:
+		tx = tx.Where("settled <= ? AND expires_at IS NOT NULL", unset).
+			Where("(cancelled_at IS NULL AND expires_at < ?) OR cancelled_at >= expires_at", now)
+	case InvoiceStatusCancelled:
+		tx = tx.Where("settled <= ? AND cancelled_at IS NOT NULL", unset).
+			Where("expires_at IS NULL OR cancelled_at < expires_at")
+	}
+	if !f.StartTime.IsZero() {
+		tx = tx.Where("created_at >= ?", f.StartTime)
+	}
+	if !f.EndTime.IsZero() {
+		tx = tx.Where("created_at < ?", f.EndTime)
+	}
+	if f.MinAmount > 0 {
+		tx = tx.Where("amount >= ?", f.MinAmount)
+	}
+	if f.MaxAmount > 0 {
+		tx = tx.Where("amount <= ?", f.MaxAmount)
+	}
+	if f.Memo != "" {
+		pattern := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(strings.ToLower(f.Memo))
+		tx = tx.Where(`LOWER(memo) LIKE ? ESCAPE '\'`, "%"+pattern+"%")
+	}
+	return tx
 }
 
 func (r *repository) CreateInvoice(tx *gorm.DB, invoice *Invoice) error {
@@ -48,17 +169,51 @@ func (r *repository) CreateInvoice(tx *gorm.DB, invoice *Invoice) error {
 	}
 }
 
-func (r *repository) ListWalletInvoices(tx *gorm.DB, username, walletId string) ([]*Invoice, error) {
-	var invoices []*Invoice
-	if err := tx.Where("recipient_username = ? AND recipient_id = ?", username, walletId).
-		Or("sender_username = ? AND sender_id = ?", username, walletId).Find(&invoices).Error; err != nil {
+func (r *repository) ListWalletInvoices(tx *gorm.DB, username, walletId string, filter *InvoiceFilter, offset,
+	limit uint, descending bool) (invoices []*Invoice, nextOffset int, total uint64, err error) {
+	if filter == nil {
+		filter = &InvoiceFilter{}
+	}
+	if offset+limit >= math.MaxUint32 {
+		return nil, 0, 0, ErrMaxNumResultsExceeded
+	}
+	var dbLimit int
+	if limit == 0 { // cancel the gorm limit
+		dbLimit = -1
+	} else {
+		dbLimit = int(limit)
+	}
+	order := ""
+	if descending {
+		order = " desc"
+	}
+	now := time.Now().UTC()
+
+	if err = filter.where(tx, username, walletId, now).Order("created_at" + order).
+		Offset(int(offset)).Limit(dbLimit).Find(&invoices).Error; err != nil {
 		log.WithError(err).WithFields(log.Fields{
 			"user":   username,
 			"wallet": walletId,
+			"filter": filter,
 		}).Error(MsgListInvoicesFailed)
-		return nil, fmt.Errorf("%s. Reason: %v", MsgListInvoicesFailed, ErrInternal)
-	} else {
-		return invoices, nil
+		return nil, 0, 0, fmt.Errorf("%s. Reason: %v", MsgListInvoicesFailed, ErrInternal)
+	}
+	var dbTotal int64
+	if err = filter.where(tx.Model(&Invoice{}), username, walletId, now).Count(&dbTotal).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"user":   username,
+			"wallet": walletId,
+			"filter": filter,
+		}).Error(MsgListInvoicesFailed)
+		return nil, 0, 0, fmt.Errorf("%s. Reason: %v", MsgListInvoicesFailed, ErrInternal)
+	}
+	total = uint64(dbTotal)
+	if limit == 0 { // return results as is. There is no nextOffset/ there is no sequence.
+		return invoices, 0, total, nil
+	} else if uint64(offset+limit) >= total { // end of sequence
+		return invoices, -1, total, nil
+	} else { // set index of next record in sequence
+		return invoices, int(offset + limit), total, nil
 	}
 }
 
@@ -104,6 +259,16 @@ func (r *repository) UpdateInvoiceSettleTime(tx *gorm.DB, paymentHash string, ti
 	}
 }
 
+func (r *repository) UpdateInvoicesCancelTime(tx *gorm.DB, paymentHashes []string, time time.Time) error {
+	if err := tx.Model(&Invoice{}).Where("payment_hash IN ?", paymentHashes).
+		UpdateColumn("cancelled_at", time).Error; err != nil {
+		log.WithError(err).WithField("total", len(paymentHashes)).Error(MsgUpdateInvoiceCancelledDateFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgUpdateInvoiceCancelledDateFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
+
 func (r *repository) NullifyInvoiceRecipient(tx *gorm.DB, username, id string) error {
 	var err error
 	if id == "" {
@@ -163,3 +328,47 @@ func (r *repository) SetInvoiceSenderAmount(tx *gorm.DB, paymentHash, username,
 		return nil
 	}
 }
+
+func (r *repository) ListInvoices(tx *gorm.DB) ([]*Invoice, error) {
+	var invoices []*Invoice
+	if err := tx.Find(&invoices).Error; err != nil {
+		log.WithError(err).Error(MsgListInvoicesFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgListInvoicesFailed, ErrInternal)
+	} else {
+		return invoices, nil
+	}
+}
+
+func (r *repository) GetLastSettleIndex(tx *gorm.DB) (uint64, error) {
+	var cursor SettleCursor
+	if err := tx.Take(&cursor, settleCursorID).Error; err == nil {
+		return cursor.SettleIndex, nil
+	} else if err != gorm.ErrRecordNotFound {
+		log.WithError(err).Error(MsgGetLastSettleIndexFailed)
+		return 0, fmt.Errorf("%s. Reason: %v", MsgGetLastSettleIndexFailed, ErrInternal)
+	}
+	// databases from before the cursor only recorded the settle indexes of spontaneous payments
+	var settleIndex uint64
+	if err := tx.Model(&Invoice{}).Unscoped().Select("COALESCE(MAX(settle_index), 0)").
+		Scan(&settleIndex).Error; err != nil {
+		log.WithError(err).Error(MsgGetLastSettleIndexFailed)
+		return 0, fmt.Errorf("%s. Reason: %v", MsgGetLastSettleIndexFailed, ErrInternal)
+	}
+	return settleIndex, nil
+}
+
+func (r *repository) SetLastSettleIndex(tx *gorm.DB, settleIndex uint64) error {
+	cursor := SettleCursor{ID: settleCursorID}
+	if err := tx.Attrs(SettleCursor{SettleIndex: settleIndex}).FirstOrCreate(&cursor).Error; err != nil {
+		log.WithError(err).WithField("settleIndex", settleIndex).Error(MsgSetLastSettleIndexFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgSetLastSettleIndexFailed, ErrInternal)
+	} else if cursor.SettleIndex >= settleIndex {
+		return nil
+	}
+	if err := tx.Model(&SettleCursor{}).Where("id = ? AND settle_index < ?", settleCursorID, settleIndex).
+		Update("settle_index", settleIndex).Error; err != nil {
+		log.WithError(err).WithField("settleIndex", settleIndex).Error(MsgSetLastSettleIndexFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgSetLastSettleIndexFailed, ErrInternal)
+	}
+	return nil
+}
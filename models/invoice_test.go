@@ -0,0 +1,42 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvoiceStatus(t *testing.T) {
+	now := time.Now().UTC()
+	expiresAt := now.Add(time.Hour)
+	invoice := &Invoice{ExpiresAt: &expiresAt}
+	require.Equal(t, InvoiceStatusPending, invoice.Status(now))
+	require.Equal(t, InvoiceStatusExpired, invoice.Status(now.Add(2*time.Hour)))
+
+	invoice.Settled = now
+	require.Equal(t, InvoiceStatusSettled, invoice.Status(now.Add(2*time.Hour)))
+
+	require.Equal(t, InvoiceStatusPending, (&Invoice{}).Status(now), "invoices without an expiry do not expire")
+}
+
+func TestInvoiceStatusCancelled(t *testing.T) {
+	now := time.Now().UTC()
+	expiresAt := now.Add(time.Hour)
+	cancelledAt := now
+	invoice := &Invoice{ExpiresAt: &expiresAt, CancelledAt: &cancelledAt}
+	require.Equal(t, InvoiceStatusCancelled, invoice.Status(now.Add(2*time.Hour)))
+
+	cancelledAt = expiresAt.Add(time.Minute)
+	require.Equal(t, InvoiceStatusExpired, invoice.Status(now), "invoices cancelled once they expire are expired")
+
+	require.Equal(t, InvoiceStatusCancelled, (&Invoice{CancelledAt: &cancelledAt}).Status(now))
+}
+
+func TestInvoiceDirection(t *testing.T) {
+	username, walletId := "user", "wallet"
+	invoice := &Invoice{RecipientUsername: &username, RecipientID: &walletId}
+	require.Equal(t, InvoiceDirectionIncoming, invoice.Direction(username, walletId))
+	require.Equal(t, InvoiceDirectionOutgoing, (&Invoice{SenderUsername: &username, SenderID: &walletId}).
+		Direction(username, walletId))
+}
@@ -0,0 +1,202 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// Node-level ledger accounts. Together with the wallet accounts, the balances of all accounts sum to zero.
+// Wallet accounts are credited when funds are added to the wallet, and debited when funds leave it.
+const (
+	// debited with funds received over lightning
+	AccountLightningInbound = "lightning_inbound"
+	// credited with funds sent over lightning, excluding routing fees
+	AccountLightningOutbound = "lightning_outbound"
+	// credited with the routing fees of funds sent over lightning
+	AccountRoutingFees = "routing_fees"
+	// credited with the service fees charged to wallets while there is no revenue wallet
+	AccountServiceFees = "service_fees"
+	// balances that were set directly, such as opening balances and balances set by an admin
+	AccountAdjustments = "adjustments"
+)
+
+// LedgerEntry is an append-only record of a debit or credit to an account.
+// Entries that are recorded together share a journal id, and their debits and credits sum to the same amount.
+// Entries of wallet accounts reference the wallet, and entries of node accounts name the account.
+type LedgerEntry struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"`
+	CreatedAt time.Time
+
+	JournalID     string  `gorm:"index"`
+	TransactionID *string `gorm:"index"`
+
+	Account  string  `gorm:"index"`
+	WalletID *string `gorm:"index:idx_ledger_entries_wallet"`
+	Username *string `gorm:"index:idx_ledger_entries_wallet"`
+
+	Debit  uint64
+	Credit uint64
+}
+
+// WalletDebit returns an entry that removes amount from the wallet.
+func WalletDebit(username, walletId string, amount uint64) *LedgerEntry {
+	return &LedgerEntry{Username: &username, WalletID: &walletId, Debit: amount}
+}
+
+// WalletCredit returns an entry that adds amount to the wallet.
+func WalletCredit(username, walletId string, amount uint64) *LedgerEntry {
+	return &LedgerEntry{Username: &username, WalletID: &walletId, Credit: amount}
+}
+
+// AccountDebit returns an entry that debits a node-level account.
+func AccountDebit(account string, amount uint64) *LedgerEntry {
+	return &LedgerEntry{Account: account, Debit: amount}
+}
+
+// AccountCredit returns an entry that credits a node-level account.
+func AccountCredit(account string, amount uint64) *LedgerEntry {
+	return &LedgerEntry{Account: account, Credit: amount}
+}
+
+func (r *repository) PostJournal(tx *gorm.DB, transactionId *string, entries ...*LedgerEntry) error {
+	journal, err := balancedJournal(transactionId, entries)
+	if err != nil {
+		return err
+	}
+	for _, entry := range journal {
+		if entry.WalletID == nil || entry.Username == nil {
+			continue
+		}
+		if entry.Debit > 0 {
+			if err := r.DecrementWalletBalance(tx, *entry.Username, *entry.WalletID, entry.Debit); err != nil {
+				return err
+			}
+		}
+		if entry.Credit > 0 {
+			if err := r.IncrementWalletBalance(tx, *entry.Username, *entry.WalletID, entry.Credit); err != nil {
+				return err
+			}
+		}
+	}
+	return r.createJournal(tx, transactionId, journal)
+}
+
+// createJournal records the entries under a new journal id without touching wallet balances.
+func (r *repository) createJournal(tx *gorm.DB, transactionId *string, entries []*LedgerEntry) error {
+	journal, err := balancedJournal(transactionId, entries)
+	if err != nil {
+		return err
+	} else if len(journal) == 0 {
+		return nil
+	}
+	journalId, err := createUUID()
+	if err != nil {
+		log.WithError(err).Error(MsgPostJournalFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgPostJournalFailed, ErrInternal)
+	}
+	for _, entry := range journal {
+		entry.JournalID = journalId
+		entry.TransactionID = transactionId
+	}
+	if err := tx.Create(&journal).Error; err != nil {
+		log.WithError(err).WithField("transaction", transactionId).Error(MsgPostJournalFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgPostJournalFailed, ErrInternal)
+	}
+	return nil
+}
+
+// balancedJournal drops empty entries, and errors if the debits and credits of the remaining entries differ.
+func balancedJournal(transactionId *string, entries []*LedgerEntry) ([]*LedgerEntry, error) {
+	var debits, credits uint64
+	journal := make([]*LedgerEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Debit == 0 && entry.Credit == 0 {
+			continue
+		}
+		debits += entry.Debit
+		credits += entry.Credit
+		journal = append(journal, entry)
+	}
+	if debits != credits {
+		log.WithFields(log.Fields{
+			"transaction": transactionId,
+			"debits":      debits,
+			"credits":     credits,
+		}).Error(MsgUnbalancedJournal)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgPostJournalFailed, ErrUnbalancedJournal)
+	}
+	return journal, nil
+}
+
+// writeOffWalletBalance removes amount from the wallet against the adjustments account, regardless of the
+// wallet's pending payments.
+func (r *repository) writeOffWalletBalance(tx *gorm.DB, username, walletId string, amount uint64) error {
+	if amount == 0 {
+		return nil
+	}
+	res := tx.Model(&Wallet{}).Where("username = ? AND id = ? AND balance >= ?", username, walletId, amount).
+		UpdateColumn("balance", gorm.Expr("balance - ?", amount))
+	if res.Error != nil {
+		log.WithError(res.Error).WithFields(log.Fields{
+			"wallet":       walletId,
+			"user":         username,
+			"deltaBalance": amount,
+		}).Error(MsgDecrementWalletFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgDecrementWalletFailed, ErrInternal)
+	} else if res.RowsAffected == 0 {
+		return ErrInsufficientBalance
+	}
+	return r.createJournal(tx, nil, []*LedgerEntry{
+		WalletDebit(username, walletId, amount),
+		AccountCredit(AccountAdjustments, amount),
+	})
+}
+
+func (r *repository) GetWalletLedgerBalance(tx *gorm.DB, username, walletId string) (int64, error) {
+	var balance int64
+	if err := tx.Model(&LedgerEntry{}).Select("COALESCE(SUM(credit - debit), 0)").
+		Where("username = ? AND wallet_id = ?", username, walletId).Scan(&balance).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"wallet": walletId,
+			"user":   username,
+		}).Error(MsgGetLedgerBalanceFailed)
+		return 0, fmt.Errorf("%s. Reason: %v", MsgGetLedgerBalanceFailed, ErrInternal)
+	}
+	return balance, nil
+}
+
+func (r *repository) SeedLedgerOpeningBalances(tx *gorm.DB) (int, error) {
+	var wallets []Wallet
+	if err := tx.Where("balance > 0 AND NOT EXISTS (?)",
+		tx.Model(&LedgerEntry{}).Select("1").
+			Where("ledger_entries.username = wallets.username AND ledger_entries.wallet_id = wallets.id")).
+		Find(&wallets).Error; err != nil {
+		log.WithError(err).Error(MsgSeedLedgerFailed)
+		return 0, fmt.Errorf("%s. Reason: %v", MsgSeedLedgerFailed, ErrInternal)
+	}
+	for _, wallet := range wallets {
+		err := r.createJournal(tx, nil, []*LedgerEntry{
+			AccountDebit(AccountAdjustments, wallet.Balance),
+			WalletCredit(wallet.Username, wallet.ID, wallet.Balance),
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(wallets), nil
+}
+
+func (r *repository) ListLedgerMismatchedWallets(tx *gorm.DB) ([]*Wallet, error) {
+	var wallets []*Wallet
+	if err := tx.Where("balance <> (?)",
+		tx.Model(&LedgerEntry{}).Select("COALESCE(SUM(credit - debit), 0)").
+			Where("ledger_entries.username = wallets.username AND ledger_entries.wallet_id = wallets.id")).
+		Find(&wallets).Error; err != nil {
+		log.WithError(err).Error(MsgListLedgerMismatchesFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgListLedgerMismatchesFailed, ErrInternal)
+	}
+	return wallets, nil
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type LedgerRepositorySuite struct {
+	suite.Suite
+	DB   *gorm.DB
+	mock sqlmock.Sqlmock
+
+	repository Repository
+}
+
+func (s *LedgerRepositorySuite) BeforeTest(_, _ string) {
+	log.SetLevel(log.DebugLevel)
+	var (
+		sqlDB *sql.DB
+		err   error
+	)
+
+	sqlDB, s.mock, err = sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+	s.DB, err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{}) // open gorm db
+	s.Require().NoError(err)
+	s.repository = NewRepository()
+}
+
+func (s *LedgerRepositorySuite) AfterTest(_, _ string) {
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+func TestLedgerRepository(t *testing.T) {
+	suite.Run(t, new(LedgerRepositorySuite))
+}
+
+func (s *LedgerRepositorySuite) TestPostJournalRejectsUnbalancedEntries() {
+	// no queries are expected, so wallet balances are left untouched
+	err := s.repository.PostJournal(s.DB, nil,
+		WalletDebit("testusername", "wallet-id", 10),
+		WalletCredit("testusername", "to-wallet-id", 9))
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), ErrUnbalancedJournal.Error())
+}
+
+func (s *LedgerRepositorySuite) TestPostJournalSkipsEmptyEntries() {
+	err := s.repository.PostJournal(s.DB, nil,
+		AccountCredit(AccountRoutingFees, 0),
+		WalletDebit("testusername", "wallet-id", 0))
+	s.Require().NoError(err)
+}
+
+func (s *LedgerRepositorySuite) TestPostJournalRecordsNodeAccountEntries() {
+	transactionId := "transaction-id"
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("INSERT INTO `ledger_entries` "+
+		"(`created_at`,`journal_id`,`transaction_id`,`account`,`wallet_id`,`username`,`debit`,`credit`) "+
+		"VALUES (?,?,?,?,?,?,?,?),(?,?,?,?,?,?,?,?)").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), transactionId, AccountLightningInbound, nil, nil, 10, 0,
+			sqlmock.AnyArg(), sqlmock.AnyArg(), transactionId, AccountAdjustments, nil, nil, 0, 10).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	s.mock.ExpectCommit()
+
+	err := s.repository.PostJournal(s.DB, &transactionId,
+		AccountDebit(AccountLightningInbound, 10),
+		AccountCredit(AccountAdjustments, 10))
+	s.Require().NoError(err)
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// MacaroonRootKey is a secret that XLN signs the macaroons that it bakes with. Macaroons reference the root key
+// that they were signed with by its ID.
+type MacaroonRootKey struct {
+	ID string `gorm:"primaryKey"`
+
+	CreatedAt time.Time
+
+	// base64 encoded secret
+	RootKey string
+}
+
+func (r *repository) CreateMacaroonRootKey(tx *gorm.DB, key *MacaroonRootKey) error {
+	if key == nil {
+		return fmt.Errorf("%s. Reason: %v", MsgCreateMacaroonRootKeyFailed, MsgReceivedNil)
+	} else if err := tx.Create(key).Error; err != nil {
+		if strings.Contains(err.Error(), gormMsgSubstrUniqueConstraintFailed) ||
+			strings.Contains(err.Error(), gormMsgSubstrDuplicateKey) {
+			return fmt.Errorf("%s. Reason: %s", MsgCreateMacaroonRootKeyFailed, MsgIDAlreadyInUse)
+		}
+		log.WithError(err).WithField("id", key.ID).Error(MsgCreateMacaroonRootKeyFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgCreateMacaroonRootKeyFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) GetMacaroonRootKey(tx *gorm.DB, id string) (*MacaroonRootKey, error) {
+	var key MacaroonRootKey
+	if err := tx.Take(&key, "id = ?", id).Error; err == gorm.ErrRecordNotFound {
+		return nil, ErrMacaroonRootKeyNotFound
+	} else if err != nil {
+		log.WithError(err).WithField("id", id).Error(MsgGetMacaroonRootKeyFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetMacaroonRootKeyFailed, ErrInternal)
+	} else {
+		return &key, nil
+	}
+}
@@ -17,11 +17,12 @@ type Repository interface {
 	// Errors if the database action fails
 	DeleteWalletZeroBalance(tx *gorm.DB, username, walletId string) error
 
-	// DeleteWallet removes wallet record from database
+	// DeleteWallet removes wallet record from database, writing off any remaining balance in the ledger
 	// Errors if the database action fails
 	DeleteWallet(tx *gorm.DB, username, walletId string) error
 
-	// DeleteUserWallets removes all wallet record from database belonging to user
+	// DeleteUserWallets removes all wallet record from database belonging to user, writing off any remaining
+	// balances in the ledger
 	// Errors if the database action fails
 	DeleteUserWallets(tx *gorm.DB, username string) error
 
@@ -37,7 +38,8 @@ type Repository interface {
 	// Errors if the database action fails
 	ListUserWallets(tx *gorm.DB, username string) ([]*Wallet, error)
 
-	// UpdateWalletWithBalance updates the wallet with the balance
+	// UpdateWalletWithBalance sets the balance of the wallet, recording the difference against the adjustments account
+	// of the ledger
 	// Errors if the database action fails
 	UpdateWalletWithBalance(tx *gorm.DB, username, walletId string, newBalance uint64) (*Wallet, error)
 
@@ -68,6 +70,10 @@ type Repository interface {
 	// errors if there is no such wallet, or if there is an internal db error
 	GetWalletWithApiKey(tx *gorm.DB, apiKey string) (*Wallet, error)
 
+	// RotateWalletApiKey replaces the api key of a wallet with a new key, and returns the new key
+	// errors if there is no such wallet, or if there is an internal db error
+	RotateWalletApiKey(tx *gorm.DB, username, walletId string) (string, error)
+
 	// UpdateWalletLink records that a wallet has been linked successfully using a key if the key is not nil.
 	// If key is nil then the wallet is unlinked.
 	// errors if there is no such wallet, or if there is an internal db error
@@ -80,6 +86,38 @@ type Repository interface {
 	// GetConfirmedBalance returns the wallet balance, less any pending payments
 	GetConfirmedBalance(tx *gorm.DB, username, walletId string) (uint64, error)
 
+	// GetTotalWalletBalance returns the sum of the balances of all wallets
+	GetTotalWalletBalance(tx *gorm.DB) (uint64, error)
+
+	// GetWalletWithAddressAlias retrieves the wallet that a lightning address alias resolves to
+	// errors if there is no such wallet, or if there is an internal db error
+	GetWalletWithAddressAlias(tx *gorm.DB, alias string) (*Wallet, error)
+
+	// GetWalletWithRoutingTag retrieves the wallet that incoming spontaneous payments with the routing tag are
+	// credited to. Errors if there is no such wallet, or if there is an internal db error
+	GetWalletWithRoutingTag(tx *gorm.DB, tag string) (*Wallet, error)
+
+	// AssignWalletRoutingTags generates a routing tag for every wallet that does not have one, and returns the
+	// number of wallets assigned
+	AssignWalletRoutingTags(tx *gorm.DB) (int, error)
+
+	// Ledger methods
+
+	// PostJournal records the ledger entries as a single journal, and applies the entries of wallet accounts to the
+	// wallet balances. Every change to a wallet balance must go through the ledger.
+	// Errors if the entries do not balance, if a debited wallet has insufficient funds, or if the database action fails
+	PostJournal(tx *gorm.DB, transactionId *string, entries ...*LedgerEntry) error
+
+	// GetWalletLedgerBalance returns the balance of the wallet derived from its ledger entries
+	GetWalletLedgerBalance(tx *gorm.DB, username, walletId string) (int64, error)
+
+	// SeedLedgerOpeningBalances records the balance of every wallet that has no ledger entries as an opening balance
+	// against the adjustments account, and returns the number of wallets seeded
+	SeedLedgerOpeningBalances(tx *gorm.DB) (int, error)
+
+	// ListLedgerMismatchedWallets returns the wallets whose balance differs from the balance derived from the ledger
+	ListLedgerMismatchedWallets(tx *gorm.DB) ([]*Wallet, error)
+
 	// User methods
 
 	// CreateUser adds the user to the database
@@ -99,6 +137,10 @@ type Repository interface {
 	// errors if there is no such user, or if there is an internal db error
 	GetUserWithApiKey(tx *gorm.DB, apiKey string) (*User, error)
 
+	// RotateUserApiKey replaces the api key of a user with a new key, and returns the new key
+	// errors if there is no such user, or if there is an internal db error
+	RotateUserApiKey(tx *gorm.DB, username string) (string, error)
+
 	GetLinkedUser(tx *gorm.DB, key string) (*User, error)
 
 	UpdateUserLink(tx *gorm.DB, username string, k1, label *string) error
@@ -113,10 +155,19 @@ type Repository interface {
 	// Errors if the database action fails
 	DeletePendingInvoice(tx *gorm.DB, paymentHash string) error
 
+	// DeletePendingInvoices removes the PendingInvoice records of the payment hashes from the database
+	// Errors if the database action fails
+	DeletePendingInvoices(tx *gorm.DB, paymentHashes []string) error
+
 	// ListWalletPendingInvoices gets pending invoice records for a wallet
 	// Errors if the database action fails
 	ListWalletPendingInvoices(tx *gorm.DB, username, walletId string) ([]*PendingInvoice, error)
 
+	// ListExpiredPendingInvoices gets at most limit pending invoice records that expired before time before and
+	// have not been paid, starting with those that expired first
+	// Errors if the database action fails
+	ListExpiredPendingInvoices(tx *gorm.DB, before time.Time, limit int) ([]*PendingInvoice, error)
+
 	// ListPendingInvoices gets all pending invoice records
 	// Errors if the database action fails
 	ListPendingInvoices(tx *gorm.DB) ([]*PendingInvoice, error)
@@ -125,6 +176,10 @@ type Repository interface {
 	// Errors if the database action fails
 	GetPendingInvoice(tx *gorm.DB, paymentHash string) (*PendingInvoice, error)
 
+	// AcceptPendingInvoice marks a pending hold invoice as paid but not yet settled
+	// Errors if there is no such hold invoice or if the db action fails
+	AcceptPendingInvoice(tx *gorm.DB, paymentHash string) error
+
 	// Pending payments methods
 
 	// CreatePendingPayment adds the PendingPayment to the database.
@@ -161,6 +216,10 @@ type Repository interface {
 	// Errors if the database action fails
 	CreateTransactions(tx *gorm.DB, txs *[]Transaction) error
 
+	// ListInvoiceTransactions returns all transactions that settled an invoice
+	// Errors if the database action fails
+	ListInvoiceTransactions(tx *gorm.DB) ([]*Transaction, error)
+
 	// GetTransaction returns the transaction matching the ID
 	// Errors if the database action fails or if record not found
 	GetTransaction(tx *gorm.DB, transactionId string) (*Transaction, error)
@@ -199,19 +258,30 @@ type Repository interface {
 	// Errors if the database action fails.
 	CreateInvoice(tx *gorm.DB, invoice *Invoice) error
 
-	// ListWalletInvoices lists all the invoices associated with the wallet
-	ListWalletInvoices(tx *gorm.DB, username, walletId string) ([]*Invoice, error)
+	// ListWalletInvoices lists the invoices associated with the wallet that match the filter, in the same way that
+	// ListWalletTransactions lists transactions. A nil filter matches all of the wallet's invoices.
+	// Returns: the corresponding list of invoices; the next index of the search result sequence;
+	// the total number of invoices that match the filter.
+	ListWalletInvoices(tx *gorm.DB, username, walletId string, filter *InvoiceFilter, offset, limit uint,
+		descending bool) (invoices []*Invoice, nextOffset int, total uint64, err error)
 
 	// GetInvoice retrieves an invoice from the DB that matches the paymentHash.
 	// Errors if there does not exist an invoice with the paymentHash or if the db action fails.
 	GetInvoice(tx *gorm.DB, paymentHash string) (*Invoice, error)
 
+	// ListInvoices retrieves all invoices that were paid or created by wallets
+	// Errors if the db action fails.
+	ListInvoices(tx *gorm.DB) ([]*Invoice, error)
+
 	// GetWalletInvoice gets an invoice if it is associated with the wallet
 	GetWalletInvoice(tx *gorm.DB, username, walletId, paymentHash string) (*Invoice, error)
 
 	// UpdateInvoiceSettleTime updates the settled timestamp of record with given paymentHash
 	UpdateInvoiceSettleTime(tx *gorm.DB, paymentHash string, time *time.Time) error
 
+	// UpdateInvoicesCancelTime records the time that the invoices with paymentHashes were cancelled
+	UpdateInvoicesCancelTime(tx *gorm.DB, paymentHashes []string, time time.Time) error
+
 	// NullifyInvoiceRecipient removes all references to user or wallet as a recipient.
 	NullifyInvoiceRecipient(tx *gorm.DB, username, id string) error
 
@@ -221,6 +291,14 @@ type Repository interface {
 	// SetInvoiceSenderAmount sets the sender and amount on an existing invoice
 	SetInvoiceSenderAmount(tx *gorm.DB, paymentHash, username, id string, amount int64) error
 
+	// GetLastSettleIndex returns the highest LND settle index of the invoices that were processed, or 0 if there
+	// are none. Databases without a settle cursor return the highest settle index of the invoices of spontaneous
+	// payments
+	GetLastSettleIndex(tx *gorm.DB) (uint64, error)
+
+	// SetLastSettleIndex advances the settle cursor to settleIndex, unless it is already past it
+	SetLastSettleIndex(tx *gorm.DB, settleIndex uint64) error
+
 	// Auth methods
 
 	// CreateAuth
@@ -232,6 +310,10 @@ type Repository interface {
 	// GetAuth
 	GetAuth(tx *gorm.DB, k1 string) (*Auth, error)
 
+	// DeleteAuth removes the ln auth record of k1
+	// Errors if there is no such record, or if there is an internal db error
+	DeleteAuth(tx *gorm.DB, k1 string) error
+
 	// Withdraw methods
 
 	// CreateWithdraw creates a record of a reusable withdraw template
@@ -245,6 +327,222 @@ type Repository interface {
 
 	// IncrementWithdrawCount
 	IncrementWithdrawCount(tx *gorm.DB, k1 string) error
+
+	// Pay methods
+
+	// CreatePay creates a record of a reusable pay template
+	CreatePay(tx *gorm.DB, pay *Pay) (*Pay, error)
+
+	// GetWalletPay retrieves a pay record belonging to a wallet
+	GetWalletPay(tx *gorm.DB, username, walletId, k1 string) (*Pay, error)
+
+	// GetPay retrieves a pay record
+	GetPay(tx *gorm.DB, k1 string) (*Pay, error)
+
+	// Webhook methods
+
+	// CreateWebhook registers a webhook for a user or one of its wallets
+	// Errors if the database action fails
+	CreateWebhook(tx *gorm.DB, webhook *Webhook) error
+
+	// ListWebhooks lists the webhooks of a user. If walletId is not nil, then only the webhooks
+	// registered for that wallet are listed.
+	// Errors if the database action fails
+	ListWebhooks(tx *gorm.DB, username string, walletId *string) ([]*Webhook, error)
+
+	// ListWalletEventWebhooks lists the webhooks that are notified of the events of a wallet,
+	// i.e. the webhooks of the wallet and those of its user that are not bound to a wallet.
+	// Errors if the database action fails
+	ListWalletEventWebhooks(tx *gorm.DB, username, walletId string) ([]*Webhook, error)
+
+	// DeleteWebhook removes a webhook. If walletId is not nil, then the webhook must belong to that wallet.
+	// Errors if the database action fails or if record not found
+	DeleteWebhook(tx *gorm.DB, username string, walletId *string, id string) error
+
+	// CreateWebhookDeliveries queues deliveries to webhooks
+	// Errors if the database action fails
+	CreateWebhookDeliveries(tx *gorm.DB, deliveries []*WebhookDelivery) error
+
+	// ListDueWebhookDeliveries lists at most limit deliveries that are due to be attempted at time now
+	// Errors if the database action fails
+	ListDueWebhookDeliveries(tx *gorm.DB, now time.Time, limit int) ([]*WebhookDelivery, error)
+
+	// DeleteWebhookDelivery removes a delivery from the queue
+	// Errors if the database action fails
+	DeleteWebhookDelivery(tx *gorm.DB, id string) error
+
+	// UpdateWebhookDeliveryAttempt records the outcome of a failed delivery attempt
+	// Errors if the database action fails
+	UpdateWebhookDeliveryAttempt(tx *gorm.DB, delivery *WebhookDelivery) error
+
+	// Schedule methods
+
+	// CreateSchedule adds a schedule to the database
+	// Errors if the database action fails
+	CreateSchedule(tx *gorm.DB, schedule *Schedule) error
+
+	// ListWalletSchedules lists the schedules of a wallet, including those that are no longer active
+	// Errors if the database action fails
+	ListWalletSchedules(tx *gorm.DB, username, walletId string) ([]*Schedule, error)
+
+	// ListDueSchedules lists at most limit active schedules whose next payment is due at time now
+	// Errors if the database action fails
+	ListDueSchedules(tx *gorm.DB, now time.Time, limit int) ([]*Schedule, error)
+
+	// AdvanceSchedule claims the payment of an active schedule that is due at time due by moving its next run to
+	// next and setting its status. Returns false if the payment was already claimed.
+	// Errors if the database action fails
+	AdvanceSchedule(tx *gorm.DB, id string, due, next time.Time, status string) (bool, error)
+
+	// CreateScheduleRun records an attempt at a payment of a schedule, and counts it on the schedule
+	// Errors if the database action fails
+	CreateScheduleRun(tx *gorm.DB, run *ScheduleRun) error
+
+	// CancelSchedule cancels an active schedule of a wallet
+	// Errors if the database action fails or if record not found
+	CancelSchedule(tx *gorm.DB, username, walletId, id string) error
+
+	// Spending policy methods
+
+	// GetWalletSpendingPolicies retrieves the policies that apply to a wallet, i.e. the policy of the wallet and
+	// the policy of its user. The policies are locked for update until the end of the transaction.
+	// Errors if the database action fails
+	GetWalletSpendingPolicies(tx *gorm.DB, username, walletId string) ([]*SpendingPolicy, error)
+
+	// SetSpendingPolicy replaces the policy of a wallet, or of a user if the policy's WalletID is nil.
+	// The policy is removed if it has no limits.
+	// Errors if the database action fails
+	SetSpendingPolicy(tx *gorm.DB, policy *SpendingPolicy) error
+
+	// GetOutflow totals the payments and transfers made since the given time by a wallet, or by all the wallets
+	// of the user, excluding transfers between them, if walletId is nil. Pending payments are included.
+	// Errors if the database action fails
+	GetOutflow(tx *gorm.DB, username string, walletId *string, since time.Time) (*Outflow, error)
+
+	// Payment defaults methods
+
+	// GetPaymentDefaults retrieves the payment defaults of a user, which are empty if the user has not set any
+	// Errors if the database action fails
+	GetPaymentDefaults(tx *gorm.DB, username string) (*PaymentDefaults, error)
+
+	// SetPaymentDefaults replaces the payment defaults of a user. The defaults are removed if they are empty.
+	// Errors if the database action fails
+	SetPaymentDefaults(tx *gorm.DB, defaults *PaymentDefaults) error
+
+	// Fee schedule methods
+
+	// GetFeeSchedule retrieves the fee schedule of a user
+	// Errors with ErrFeeScheduleNotFound if the user does not have one, or if the database action fails
+	GetFeeSchedule(tx *gorm.DB, username string) (*FeeSchedule, error)
+
+	// SetFeeSchedule replaces the fee schedule of a user. The fee schedule is removed if schedule is nil.
+	// Errors if the database action fails
+	SetFeeSchedule(tx *gorm.DB, username string, schedule *FeeSchedule) error
+
+	// Idempotency methods
+
+	// CreateIdempotencyKey claims an idempotency key of a wallet
+	// Errors with ErrIdempotencyKeyInUse if the wallet already has the key, or if the database action fails
+	CreateIdempotencyKey(tx *gorm.DB, key *IdempotencyKey) error
+
+	// GetIdempotencyKey retrieves an idempotency key of a wallet
+	// Errors if the database action fails or if record not found
+	GetIdempotencyKey(tx *gorm.DB, username, walletId, key string) (*IdempotencyKey, error)
+
+	// CompleteIdempotencyKey stores the response of the request that claimed the key
+	// Errors if the database action fails or if record not found
+	CompleteIdempotencyKey(tx *gorm.DB, username, walletId, key string, response []byte) error
+
+	// DeleteIdempotencyKey releases a key that has not been completed, so that the request may be retried
+	// Errors if the database action fails
+	DeleteIdempotencyKey(tx *gorm.DB, username, walletId, key string) error
+
+	// ReclaimIdempotencyKey claims a key again for the request of key, unless it changed since it was read as
+	// previous. Returns false if it changed
+	// Errors if the database action fails
+	ReclaimIdempotencyKey(tx *gorm.DB, key *IdempotencyKey, previous *IdempotencyKey) (bool, error)
+
+	// DeleteExpiredIdempotencyKeys removes the keys that were last claimed before claimedBefore, and returns the
+	// number of keys removed
+	// Errors if the database action fails
+	DeleteExpiredIdempotencyKeys(tx *gorm.DB, claimedBefore time.Time) (int64, error)
+
+	// Api key methods
+
+	// CreateApiKey adds an api key of a user or wallet to the database
+	// Errors if the database action fails
+	CreateApiKey(tx *gorm.DB, key *ApiKey) error
+
+	// GetApiKey retrieves the api key with the key
+	// Errors if the database action fails or if record not found
+	GetApiKey(tx *gorm.DB, key string) (*ApiKey, error)
+
+	// GetUserApiKey retrieves an api key of a user or of one of the user's wallets
+	// Errors if the database action fails or if record not found
+	GetUserApiKey(tx *gorm.DB, username, id string) (*ApiKey, error)
+
+	// ListUserApiKeys lists the api keys of a user and of the user's wallets
+	// Errors if the database action fails
+	ListUserApiKeys(tx *gorm.DB, username string) ([]*ApiKey, error)
+
+	// UpdateApiKeyLastUsed records the time that an api key was last used
+	// Errors if the database action fails
+	UpdateApiKeyLastUsed(tx *gorm.DB, id string, time time.Time) error
+
+	// DeleteApiKey removes an api key of a user or of one of the user's wallets
+	// Errors if the database action fails or if record not found
+	DeleteApiKey(tx *gorm.DB, username, id string) error
+
+	// HashPlaintextApiKeys replaces the plaintext api keys of users, wallets and scoped api keys that predate the
+	// hashing of keys with their hashes, and returns the number of keys hashed. Wallets without webhook secrets,
+	// which signed webhook deliveries with their api keys, are given new random secrets that receivers must fetch
+	// again.
+	// Errors if the database action fails
+	HashPlaintextApiKeys(tx *gorm.DB) (int, error)
+
+	// Macaroon methods
+
+	// CreateMacaroonRootKey adds a root key that macaroons are signed with to the database
+	// Errors if the database action fails or if a root key with the ID already exists
+	CreateMacaroonRootKey(tx *gorm.DB, key *MacaroonRootKey) error
+
+	// GetMacaroonRootKey retrieves the root key with the ID
+	// Errors if the database action fails or if record not found
+	GetMacaroonRootKey(tx *gorm.DB, id string) (*MacaroonRootKey, error)
+
+	// Admin methods
+
+	// CreateAdmin adds an admin account to the database, and generates its key
+	// Errors if the database action fails or if an admin with the name already exists
+	CreateAdmin(tx *gorm.DB, admin *Admin) error
+
+	// GetAdmin retrieves the admin with the name
+	// Errors if the database action fails or if record not found
+	GetAdmin(tx *gorm.DB, name string) (*Admin, error)
+
+	// GetAdminWithKey retrieves the admin whose key is key
+	// Errors if the database action fails or if record not found
+	GetAdminWithKey(tx *gorm.DB, key string) (*Admin, error)
+
+	// ListAdmins lists every admin account, oldest first
+	// Errors if the database action fails
+	ListAdmins(tx *gorm.DB) ([]*Admin, error)
+
+	// CountAdminsWithRole returns the number of admin accounts with the role
+	// Errors if the database action fails
+	CountAdminsWithRole(tx *gorm.DB, role string) (int64, error)
+
+	// UpdateAdminRole changes the role of an admin
+	// Errors if the database action fails or if record not found
+	UpdateAdminRole(tx *gorm.DB, name, role string) error
+
+	// RotateAdminKey replaces the key of an admin with a new key, and returns the new key
+	// Errors if the database action fails or if record not found
+	RotateAdminKey(tx *gorm.DB, name string) (string, error)
+
+	// DeleteAdmin removes an admin account, after which its key can no longer be used
+	// Errors if the database action fails or if record not found
+	DeleteAdmin(tx *gorm.DB, name string) error
 }
 type repository struct {
 }
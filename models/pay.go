@@ -0,0 +1,69 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// Pay is a reusable LNURL-pay (LUD-06) link that lets anyone pay an arbitrary amount into a wallet.
+type Pay struct {
+	K1 string `gorm:"primaryKey" sql:"type:uuid"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	WalletID string `gorm:"index,priority:1"`
+	Username string `gorm:"index,priority:2"`
+	Wallet   Wallet `gorm:"foreignKey:username,wallet_id;references:username,id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+
+	MinMsat     uint64
+	MaxMsat     uint64
+	Description string
+}
+
+func (r *repository) CreatePay(tx *gorm.DB, pay *Pay) (*Pay, error) {
+	if pay == nil {
+		return nil, fmt.Errorf("%s. Reason: %v", MsgCreatePayFailed, MsgReceivedNil)
+	} else if err := tx.Create(pay).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"description": pay.Description,
+			"user":        pay.Username,
+			"wallet":      pay.WalletID,
+			"pay":         pay.K1,
+		}).Error(MsgCreatePayFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgCreatePayFailed, ErrInternal)
+	} else {
+		return pay, nil
+	}
+}
+
+func (r *repository) GetWalletPay(tx *gorm.DB, username, walletId, k1 string) (*Pay, error) {
+	var pay Pay
+	if err := tx.Take(&pay, "k1 = ? AND username = ? AND wallet_id = ?", k1, username, walletId).Error; err == gorm.ErrRecordNotFound {
+		return nil, ErrPayNotFound
+	} else if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"k1": k1,
+		}).Error(MsgGetPayFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetPayFailed, ErrInternal)
+	} else {
+		return &pay, nil
+	}
+}
+
+func (r *repository) GetPay(tx *gorm.DB, k1 string) (*Pay, error) {
+	var pay Pay
+	if err := tx.Take(&pay, "k1 = ?", k1).Error; err == gorm.ErrRecordNotFound {
+		return nil, ErrPayNotFound
+	} else if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"k1": k1,
+		}).Error(MsgGetPayFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetPayFailed, ErrInternal)
+	} else {
+		return &pay, nil
+	}
+}
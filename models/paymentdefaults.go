@@ -0,0 +1,60 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// PaymentDefaults are the routing options of the payments of a user's wallets that do not set them.
+// Options that are zero are not set.
+type PaymentDefaults struct {
+	Username string `gorm:"primaryKey"`
+	User     User   `gorm:"foreignKey:Username;references:Username;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// maximum routing fee of a payment, in msat
+	MaxFeeMsat uint64
+	// maximum routing fee of a payment, in parts per million of the amount
+	MaxFeePpm      uint64
+	TimeoutSeconds uint32
+	// maximum number of parts that a payment may be split into
+	MaxParts uint32
+}
+
+// IsEmpty returns whether none of the options are set.
+func (d *PaymentDefaults) IsEmpty() bool {
+	return d.MaxFeeMsat == 0 && d.MaxFeePpm == 0 && d.TimeoutSeconds == 0 && d.MaxParts == 0
+}
+
+func (r *repository) GetPaymentDefaults(tx *gorm.DB, username string) (*PaymentDefaults, error) {
+	defaults := PaymentDefaults{}
+	if err := tx.Take(&defaults, "username = ?", username).Error; err == gorm.ErrRecordNotFound {
+		return &PaymentDefaults{Username: username}, nil
+	} else if err != nil {
+		log.WithError(err).WithField("user", username).Error(MsgGetPaymentDefaultsFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetPaymentDefaultsFailed, ErrInternal)
+	} else {
+		return &defaults, nil
+	}
+}
+
+func (r *repository) SetPaymentDefaults(tx *gorm.DB, defaults *PaymentDefaults) error {
+	if defaults == nil {
+		return fmt.Errorf("%s. Reason: %v", MsgSetPaymentDefaultsFailed, MsgReceivedNil)
+	} else if err := tx.Where("username = ?", defaults.Username).Delete(&PaymentDefaults{}).Error; err != nil {
+		log.WithError(err).WithField("user", defaults.Username).Error(MsgSetPaymentDefaultsFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgSetPaymentDefaultsFailed, ErrInternal)
+	} else if defaults.IsEmpty() {
+		return nil
+	} else if err := tx.Create(defaults).Error; err != nil {
+		log.WithError(err).WithField("user", defaults.Username).Error(MsgSetPaymentDefaultsFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgSetPaymentDefaultsFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
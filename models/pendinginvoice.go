@@ -18,6 +18,15 @@ type PendingInvoice struct {
 	Wallet         Wallet `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 
 	Amount uint64
+
+	// Hold is true if the invoice is only settled once the wallet releases the payment, rather than when it is paid
+	Hold bool
+	// Accepted is true if the payment of a hold invoice has been received, but not yet settled or cancelled
+	Accepted bool
+	// hex encoded preimage of a hold invoice, which settles the invoice once revealed to LND
+	Preimage *string
+	// time after which the invoice can no longer be paid. Is nil for invoices created before expiry was recorded
+	ExpiresAt *time.Time `gorm:"index"`
 }
 
 func (r *repository) CreatePendingInvoice(tx *gorm.DB, pendingInv *PendingInvoice) error {
@@ -49,6 +58,15 @@ func (r *repository) DeletePendingInvoice(tx *gorm.DB, paymentHash string) error
 	}
 }
 
+func (r *repository) DeletePendingInvoices(tx *gorm.DB, paymentHashes []string) error {
+	if err := tx.Where("payment_hash IN ?", paymentHashes).Delete(&PendingInvoice{}).Error; err != nil {
+		log.WithError(err).WithField("total", len(paymentHashes)).Error(MsgDeletePendingInvoiceFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgDeletePendingInvoiceFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
+
 func (r *repository) ListWalletPendingInvoices(tx *gorm.DB, username, walletId string) ([]*PendingInvoice, error) {
 	var pendingInvoices []*PendingInvoice
 	if err := tx.Find(&pendingInvoices, "wallet_username = ? AND wallet_id = ?", username, walletId).Error; err != nil {
@@ -69,6 +87,17 @@ func (r *repository) ListPendingInvoices(tx *gorm.DB) ([]*PendingInvoice, error)
 	}
 }
 
+func (r *repository) ListExpiredPendingInvoices(tx *gorm.DB, before time.Time, limit int) ([]*PendingInvoice, error) {
+	var pendingInvoices []*PendingInvoice
+	if err := tx.Order("expires_at").Limit(limit).
+		Find(&pendingInvoices, "expires_at < ? AND accepted = ?", before, false).Error; err != nil {
+		log.WithError(err).Error(MsgListPendingInvoicesFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgListPendingInvoicesFailed, ErrInternal)
+	} else {
+		return pendingInvoices, nil
+	}
+}
+
 func (r *repository) GetPendingInvoice(tx *gorm.DB, paymentHash string) (*PendingInvoice, error) {
 	pendingInvoice := PendingInvoice{}
 	if err := tx.Take(&pendingInvoice, "payment_hash = ?", paymentHash).Error; err == gorm.ErrRecordNotFound {
@@ -80,3 +109,17 @@ func (r *repository) GetPendingInvoice(tx *gorm.DB, paymentHash string) (*Pendin
 		return &pendingInvoice, nil
 	}
 }
+
+func (r *repository) AcceptPendingInvoice(tx *gorm.DB, paymentHash string) error {
+	if res := tx.Model(&PendingInvoice{}).Where("payment_hash = ? AND hold = ?", paymentHash, true).
+		Update("accepted", true); res.Error != nil {
+		log.WithError(res.Error).WithFields(log.Fields{
+			"pendingInvoice": paymentHash,
+		}).Error(MsgAcceptPendingInvoiceFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgAcceptPendingInvoiceFailed, ErrInternal)
+	} else if res.RowsAffected == 0 {
+		return ErrPendingInvoiceNotFound
+	} else {
+		return nil
+	}
+}
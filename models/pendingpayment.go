@@ -19,6 +19,11 @@ type PendingPayment struct {
 
 	PaymentHash string `gorm:"index,priority:3"`
 	Amount      uint64
+	// service fee that is charged if the payment succeeds
+	ServiceFee uint64
+	// routing fees that are reserved for the payment, which the confirmed balance of the wallet excludes until the
+	// payment completes
+	FeeReserve uint64
 
 	WithdrawK1 *string
 	Withdraw   *Withdraw
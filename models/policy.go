@@ -0,0 +1,140 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SpendingPolicy limits the funds that leave a wallet. If WalletID is nil, then the policy applies to all the
+// wallets of the user combined, excluding transfers between them. Limits that are zero are not enforced.
+type SpendingPolicy struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Username string  `gorm:"index:idx_spending_policies_wallet"`
+	User     User    `gorm:"foreignKey:Username;references:Username;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	WalletID *string `gorm:"index:idx_spending_policies_wallet"`
+	Wallet   *Wallet `gorm:"foreignKey:username,wallet_id;references:username,id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+
+	// maximum amount of a single payment or transfer, in msat
+	MaxPayment uint64
+	// maximum amount, including fees, that may leave in the last 24 hours and the last 7 days, in msat
+	MaxDailyOutflow  uint64
+	MaxWeeklyOutflow uint64
+	// maximum number of payments and transfers in the last hour
+	MaxHourlyPayments uint64
+	// comma separated pubkeys of the only nodes that may be paid. Any node may be paid if empty
+	AllowedDestinations string
+	// comma separated usernames of the only other users of this node that funds may be transferred to. Funds may be
+	// transferred to any user if empty
+	AllowedRecipients string
+}
+
+// Outflow is the total of the funds that left a wallet, or a user's wallets, over a period.
+type Outflow struct {
+	Amount uint64
+	Count  uint64
+}
+
+// HasLimits returns whether any of the policy's limits are enforced.
+func (p *SpendingPolicy) HasLimits() bool {
+	return p.MaxPayment > 0 || p.MaxDailyOutflow > 0 || p.MaxWeeklyOutflow > 0 || p.MaxHourlyPayments > 0 ||
+		p.AllowedDestinations != "" || p.AllowedRecipients != ""
+}
+
+// Destinations returns the pubkeys of the nodes that may be paid, or nil if any node may be paid.
+func (p *SpendingPolicy) Destinations() []string {
+	if p.AllowedDestinations == "" {
+		return nil
+	}
+	return strings.Split(p.AllowedDestinations, ",")
+}
+
+// Recipients returns the usernames of the users that funds may be transferred to, or nil if funds may be transferred
+// to any user.
+func (p *SpendingPolicy) Recipients() []string {
+	if p.AllowedRecipients == "" {
+		return nil
+	}
+	return strings.Split(p.AllowedRecipients, ",")
+}
+
+func (r *repository) GetWalletSpendingPolicies(tx *gorm.DB, username, walletId string) ([]*SpendingPolicy, error) {
+	var policies []*SpendingPolicy
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("username = ? AND (wallet_id = ? OR wallet_id IS NULL)", username, walletId).
+		Find(&policies).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"wallet": walletId,
+			"user":   username,
+		}).Error(MsgGetSpendingPoliciesFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetSpendingPoliciesFailed, ErrInternal)
+	}
+	return policies, nil
+}
+
+func (r *repository) SetSpendingPolicy(tx *gorm.DB, policy *SpendingPolicy) error {
+	if policy == nil {
+		return fmt.Errorf("%s. Reason: %v", MsgSetSpendingPolicyFailed, MsgReceivedNil)
+	}
+	query := tx.Where("username = ?", policy.Username)
+	if policy.WalletID == nil {
+		query = query.Where("wallet_id IS NULL")
+	} else {
+		query = query.Where("wallet_id = ?", *policy.WalletID)
+	}
+	if err := query.Delete(&SpendingPolicy{}).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"wallet": policy.WalletID,
+			"user":   policy.Username,
+		}).Error(MsgSetSpendingPolicyFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgSetSpendingPolicyFailed, ErrInternal)
+	} else if !policy.HasLimits() {
+		return nil
+	} else if err := tx.Create(policy).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"wallet": policy.WalletID,
+			"user":   policy.Username,
+		}).Error(MsgSetSpendingPolicyFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgSetSpendingPolicyFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) GetOutflow(tx *gorm.DB, username string, walletId *string, since time.Time) (*Outflow, error) {
+	var settled, pending Outflow
+	transactions := tx.Model(&Transaction{}).Select("COALESCE(SUM(amount + fees_paid + service_fee), 0) AS amount, COUNT(*) AS count").
+		Where("from_username = ? AND created_at >= ?", username, since)
+	pendingPayments := tx.Model(&PendingPayment{}).Select("COALESCE(SUM(amount + service_fee), 0) AS amount, COUNT(*) AS count").
+		Where("wallet_username = ? AND created_at >= ?", username, since)
+	if walletId == nil {
+		transactions = transactions.Where("to_username IS NULL OR to_username <> from_username")
+	} else {
+		transactions = transactions.Where("from_id = ?", *walletId)
+		pendingPayments = pendingPayments.Where("wallet_id = ?", *walletId)
+	}
+	if err := transactions.Scan(&settled).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"wallet": walletId,
+			"user":   username,
+		}).Error(MsgGetOutflowFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetOutflowFailed, ErrInternal)
+	} else if err := pendingPayments.Scan(&pending).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"wallet": walletId,
+			"user":   username,
+		}).Error(MsgGetOutflowFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetOutflowFailed, ErrInternal)
+	}
+	return &Outflow{
+		Amount: settled.Amount + pending.Amount,
+		Count:  settled.Count + pending.Count,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// Kinds of targets of scheduled payments
+const (
+	// a wallet on this node, which is paid an invoice that is created for it
+	ScheduleTargetWallet = "wallet"
+	// a node, which is paid by keysend
+	ScheduleTargetKeysend = "keysend"
+	// an LNURL-pay link or lightning address, which is paid an invoice that it is asked for
+	ScheduleTargetLNURL = "lnurl"
+)
+
+// States of schedules
+const (
+	ScheduleActive    = "active"
+	ScheduleCancelled = "cancelled"
+	// no payments are left before the schedule's end time
+	ScheduleCompleted = "completed"
+)
+
+// Schedule is a recurring payment from a wallet, which is made every Interval until EndsAt.
+type Schedule struct {
+	ID string `gorm:"primaryKey" sql:"type:uuid"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Username string `gorm:"index:idx_schedules_wallet"`
+	WalletID string `gorm:"index:idx_schedules_wallet"`
+	Wallet   Wallet `gorm:"foreignKey:username,wallet_id;references:username,id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+
+	// one of the ScheduleTarget constants, which determines which of the targets below is set
+	TargetKind     string
+	TargetUsername *string
+	TargetWalletID *string
+	TargetPubkey   *string
+	TargetLNURL    *string
+
+	Amount uint64
+	Memo   string
+	// specification of the interval between payments, e.g. @daily
+	Interval string
+	// time of the first payment, which the times of all later payments are counted from
+	StartsAt time.Time
+	// time that the next payment is due
+	NextRun time.Time `gorm:"index"`
+	// no payments are made after EndsAt if it is set
+	EndsAt *time.Time
+	Status string `gorm:"index"`
+
+	// scoped API key that created the schedule, if one did. No payments are made once it is revoked or expires
+	ApiKeyID *string
+	// time that the credentials that created the schedule expire, if they do. No payments are made after it
+	CredentialsExpireAt *time.Time
+
+	// number of payments attempted, and of those that failed
+	Runs              uint64
+	Failures          uint64
+	LastFailureReason string
+}
+
+// ScheduleRun is an attempt at a payment of a schedule.
+type ScheduleRun struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"`
+	CreatedAt time.Time
+
+	ScheduleID string   `gorm:"index"`
+	Schedule   Schedule `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+
+	// time that the payment was due
+	DueAt         time.Time
+	Success       bool
+	Amount        uint64
+	FeesPaid      uint64
+	PaymentHash   string
+	FailureReason string
+}
+
+func (r *repository) CreateSchedule(tx *gorm.DB, schedule *Schedule) error {
+	if schedule == nil {
+		return fmt.Errorf("%s. Reason: %v", MsgCreateScheduleFailed, MsgReceivedNil)
+	} else if err := tx.Create(schedule).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"user":   schedule.Username,
+			"wallet": schedule.WalletID,
+		}).Error(MsgCreateScheduleFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgCreateScheduleFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) ListWalletSchedules(tx *gorm.DB, username, walletId string) ([]*Schedule, error) {
+	var schedules []*Schedule
+	if err := tx.Order("created_at").Find(&schedules, "username = ? AND wallet_id = ?", username, walletId).
+		Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"user":   username,
+			"wallet": walletId,
+		}).Error(MsgListSchedulesFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgListSchedulesFailed, ErrInternal)
+	} else {
+		return schedules, nil
+	}
+}
+
+func (r *repository) ListDueSchedules(tx *gorm.DB, now time.Time, limit int) ([]*Schedule, error) {
+	var schedules []*Schedule
+	if err := tx.Order("next_run").Limit(limit).
+		Find(&schedules, "status = ? AND next_run <= ?", ScheduleActive, now).Error; err != nil {
+		log.WithError(err).Error(MsgListSchedulesFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgListSchedulesFailed, ErrInternal)
+	} else {
+		return schedules, nil
+	}
+}
+
+func (r *repository) AdvanceSchedule(tx *gorm.DB, id string, due, next time.Time, status string) (bool, error) {
+	res := tx.Model(&Schedule{}).Where("id = ? AND status = ? AND next_run = ?", id, ScheduleActive, due).
+		Updates(map[string]interface{}{
+			"next_run": next,
+			"status":   status,
+		})
+	if res.Error != nil {
+		log.WithError(res.Error).WithField("schedule", id).Error(MsgUpdateScheduleFailed)
+		return false, fmt.Errorf("%s. Reason: %v", MsgUpdateScheduleFailed, ErrInternal)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (r *repository) CreateScheduleRun(tx *gorm.DB, run *ScheduleRun) error {
+	updates := map[string]interface{}{"runs": gorm.Expr("runs + 1")}
+	if !run.Success {
+		updates["failures"] = gorm.Expr("failures + 1")
+		updates["last_failure_reason"] = run.FailureReason
+	}
+	if err := tx.Create(run).Error; err != nil {
+		log.WithError(err).WithField("schedule", run.ScheduleID).Error(MsgCreateScheduleRunFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgCreateScheduleRunFailed, ErrInternal)
+	} else if err := tx.Model(&Schedule{}).Where("id = ?", run.ScheduleID).Updates(updates).Error; err != nil {
+		log.WithError(err).WithField("schedule", run.ScheduleID).Error(MsgCreateScheduleRunFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgCreateScheduleRunFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) CancelSchedule(tx *gorm.DB, username, walletId, id string) error {
+	res := tx.Model(&Schedule{}).Where("username = ? AND wallet_id = ? AND id = ? AND status = ?",
+		username, walletId, id, ScheduleActive).Update("status", ScheduleCancelled)
+	if res.Error != nil {
+		log.WithError(res.Error).WithFields(log.Fields{
+			"user":     username,
+			"wallet":   walletId,
+			"schedule": id,
+		}).Error(MsgUpdateScheduleFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgUpdateScheduleFailed, ErrInternal)
+	} else if res.RowsAffected == 0 {
+		return ErrScheduleNotFound
+	} else {
+		return nil
+	}
+}
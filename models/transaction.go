@@ -27,9 +27,14 @@ type Transaction struct {
 	Label    *string
 	Amount   uint64
 	FeesPaid uint64
+	// fee charged by the node, which is credited to its revenue wallet
+	ServiceFee uint64
 
 	InvoiceID *string
 	Invoice   *Invoice // if Nil then it is an internal transfer
+
+	// set on each of the transfers that were made together by a batch transfer
+	BatchID *string `gorm:"index"`
 }
 
 func (r *repository) CreateTransaction(tx *gorm.DB, transaction *Transaction) error {
@@ -277,3 +282,13 @@ func (r *repository) NullifyTransactionSender(tx *gorm.DB, username, id string)
 		return nil
 	}
 }
+
+func (r *repository) ListInvoiceTransactions(tx *gorm.DB) ([]*Transaction, error) {
+	var transactions []*Transaction
+	if err := tx.Find(&transactions, "invoice_id IS NOT NULL").Error; err != nil {
+		log.WithError(err).Error(MsgListInvoiceTransactionsFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgListInvoiceTransactionsFailed, ErrInternal)
+	} else {
+		return transactions, nil
+	}
+}
@@ -56,9 +56,9 @@ func (s *WalletRepositorySuite) TestCreateTransactions() {
 	s.mock.ExpectBegin()
 	s.mock.ExpectExec("INSERT INTO `transactions` "+
 		"(`id`,`created_at`,`updated_at`,`deleted_at`,`from_id`,`from_username`,"+
-		"`to_id`,`to_username`,`label`,`amount`,`fees_paid`,`invoice_id`) "+
-		"VALUES (?,?,?,?,?,?,?,?,?,?,?,?)").
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), nil, walletId, username, toWalletId, toUsername, nil, amt, 0, nil).
+		"`to_id`,`to_username`,`label`,`amount`,`fees_paid`,`service_fee`,`invoice_id`,`batch_id`) "+
+		"VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?)").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), nil, walletId, username, toWalletId, toUsername, nil, amt, 0, 0, nil, nil).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
 
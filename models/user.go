@@ -6,6 +6,7 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/util"
 	"gorm.io/gorm"
 )
 
@@ -14,7 +15,12 @@ type User struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt gorm.DeletedAt `gorm:"index"`
-	ApiKey    string         `gorm:"size:44;unique;<-:create"`
+
+	// only a salted hash of the api key is stored, which is looked up by the key's prefix.
+	// ApiKey is only set when the key is generated, which is when the user is created or the key is rotated
+	ApiKey       string `gorm:"-"`
+	ApiKeyPrefix string `gorm:"size:8;index"`
+	ApiKeyHash   string
 
 	Wallets []Wallet `gorm:"foreignKey:Username;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
 
@@ -43,6 +49,9 @@ func (r *repository) DeleteUser(tx *gorm.DB, username string) error {
 		return fmt.Errorf("%s. Reason: %v", MsgDeleteUserFailed, ErrInternal)
 	} else if res.RowsAffected == 0 {
 		return ErrUserNotFound
+	} else if err := tx.Where("username = ?", username).Delete(&ApiKey{}).Error; err != nil {
+		log.WithError(err).WithField("user", username).Error(MsgDeleteUserFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgDeleteUserFailed, ErrInternal)
 	} else {
 		log.WithFields(log.Fields{
 			"user": username,
@@ -75,14 +84,33 @@ func (r *repository) UserExists(tx *gorm.DB, username string) (bool, error) {
 }
 
 func (r *repository) GetUserWithApiKey(tx *gorm.DB, apiKey string) (*User, error) {
-	user := &User{}
-	if err := tx.First(user, "api_key = ?", apiKey).Error; err == gorm.ErrRecordNotFound {
-		return nil, ErrUserNotFound
-	} else if err != nil {
+	var users []*User
+	if err := tx.Find(&users, "api_key_prefix = ?", util.KeyPrefix(apiKey)).Error; err != nil {
 		log.WithError(err).Error(MsgGetUserWithApiKeyFailed)
 		return nil, fmt.Errorf("%s. Reason: %v", MsgGetUserWithApiKeyFailed, ErrInternal)
+	}
+	for _, user := range users {
+		if util.KeyMatchesHash(apiKey, user.ApiKeyHash) {
+			return user, nil
+		}
+	}
+	return nil, ErrUserNotFound
+}
+
+func (r *repository) RotateUserApiKey(tx *gorm.DB, username string) (string, error) {
+	key, prefix, hash, err := genHashedKey()
+	if err != nil {
+		log.WithError(err).WithField("user", username).Error(MsgRotateApiKeyFailed)
+		return "", fmt.Errorf("%s. Reason: %v", MsgRotateApiKeyFailed, ErrInternal)
+	}
+	if res := tx.Model(&User{}).Where("username = ?", username).
+		Updates(User{ApiKeyPrefix: prefix, ApiKeyHash: hash}); res.Error != nil {
+		log.WithError(res.Error).WithField("user", username).Error(MsgRotateApiKeyFailed)
+		return "", fmt.Errorf("%s. Reason: %v", MsgRotateApiKeyFailed, ErrInternal)
+	} else if res.RowsAffected == 0 {
+		return "", ErrUserNotFound
 	} else {
-		return user, nil
+		return key, nil
 	}
 }
 
@@ -47,13 +47,14 @@ func (s *UserRespositorySuite) TestCreateUser() {
 	s.Run("creates new user", func() {
 		username := "testusername"
 		s.mock.ExpectBegin()
-		s.mock.ExpectExec("INSERT INTO `users` (`username`,`created_at`,`updated_at`,`deleted_at`,`api_key`,`link_key`,`link_label`) VALUES (?,?,?,?,?,?,?)").
-			WithArgs(username, sqlmock.AnyArg(), sqlmock.AnyArg(), nil, "Uv38ByGCZU8WP18PmmIdcpVmx00QA3xNe7sEB9Hixkk=", nil, nil).
+		s.mock.ExpectExec("INSERT INTO `users` (`username`,`created_at`,`updated_at`,`deleted_at`,`api_key_prefix`,`api_key_hash`,`link_key`,`link_label`) VALUES (?,?,?,?,?,?,?,?)").
+			WithArgs(username, sqlmock.AnyArg(), sqlmock.AnyArg(), nil, sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		s.mock.ExpectCommit()
 
 		user := &User{Username: username}
 		err := s.repository.CreateUser(s.DB, user)
 		s.Require().NoError(err)
+		s.Require().Len(user.ApiKey, 44, "the generated key is returned once")
 	})
 }
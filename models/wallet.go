@@ -6,6 +6,7 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/util"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -17,8 +18,15 @@ type Wallet struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
-	Name   *string
-	ApiKey string `gorm:"size:44;unique"`
+	Name *string
+
+	// only a salted hash of the api key is stored, which is looked up by the key's prefix.
+	// ApiKey is only set when the key is generated, which is when the wallet is created or the key is rotated
+	ApiKey       string `gorm:"-"`
+	ApiKeyPrefix string `gorm:"size:8;index"`
+	ApiKeyHash   string
+	// key that webhook deliveries of the wallet's events are signed with
+	WebhookSecret string
 
 	Balance      uint64
 	Transactions []Transaction `gorm:"many2many:wallet_transactions;constraint:OnDelete:CASCADE"`
@@ -28,12 +36,29 @@ type Wallet struct {
 	// ln auth
 	LinkKey   *string `gorm:"index;unique"`
 	LinkLabel *string
+
+	// lightning address
+	AddressAlias *string `gorm:"index;unique"`
+
+	// incoming keysend and AMP payments that carry the routing tag in a custom record are credited to the wallet
+	RoutingTag *string `gorm:"index;unique"`
+
+	// whether other users may transfer funds to the wallet
+	AcceptsTransfers bool
 }
 
 type WalletOptions struct {
 	Name    *string
 	Locked  *bool
 	Balance *uint64
+	// AddressAlias removes the wallet's lightning address alias if it points to an empty string
+	AddressAlias *string
+	// AcceptsTransfers sets whether other users may transfer funds to the wallet
+	AcceptsTransfers *bool
+	// SpendingPolicy replaces the policy of the wallet
+	SpendingPolicy *SpendingPolicy
+	// UserSpendingPolicy replaces the policy of the wallet's user
+	UserSpendingPolicy *SpendingPolicy
 }
 
 func (r *repository) CreateWallet(tx *gorm.DB, wallet *Wallet) error {
@@ -80,6 +105,18 @@ func (r *repository) DeleteWalletZeroBalance(tx *gorm.DB, username, walletId str
 }
 
 func (r *repository) DeleteWallet(tx *gorm.DB, username, walletId string) error {
+	wallet := Wallet{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Take(&wallet, "username = ? AND id = ?", username, walletId).Error; err == gorm.ErrRecordNotFound {
+		return ErrWalletNotFound
+	} else if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"wallet": walletId,
+			"user":   username,
+		}).Error(MsgWalletDeleteFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgWalletDeleteFailed, ErrInternal)
+	} else if err := r.writeOffWalletBalance(tx, username, walletId, wallet.Balance); err != nil {
+		return err
+	}
 	if res := tx.Where("username = ? AND id = ?", username, walletId).Delete(&Wallet{}); res.Error != nil {
 		log.WithError(res.Error).WithFields(log.Fields{
 			"wallet": walletId,
@@ -95,6 +132,17 @@ func (r *repository) DeleteWallet(tx *gorm.DB, username, walletId string) error
 
 func (r *repository) DeleteUserWallets(tx *gorm.DB, username string) error {
 	wallets := []Wallet{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Find(&wallets, "username = ? AND balance > 0", username).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"user": username,
+		}).Error(MsgMultipleWalletDeleteFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgMultipleWalletDeleteFailed, ErrInternal)
+	}
+	for _, wallet := range wallets {
+		if err := r.writeOffWalletBalance(tx, username, wallet.ID, wallet.Balance); err != nil {
+			return err
+		}
+	}
 	if res := tx.Where("username = ?", username).Delete(&wallets); res.Error != nil {
 		log.WithError(res.Error).WithFields(log.Fields{
 			"user": username,
@@ -145,10 +193,51 @@ func (r *repository) UpdateWalletOptions(tx *gorm.DB, username, walletId string,
 	if walletOptions.Locked != nil {
 		updateAttributes["locked"] = *walletOptions.Locked
 	}
+	if walletOptions.AcceptsTransfers != nil {
+		updateAttributes["accepts_transfers"] = *walletOptions.AcceptsTransfers
+	}
+	if walletOptions.AddressAlias != nil {
+		if *walletOptions.AddressAlias == "" {
+			updateAttributes["address_alias"] = nil
+		} else {
+			updateAttributes["address_alias"] = *walletOptions.AddressAlias
+		}
+	}
+	if walletOptions.SpendingPolicy != nil {
+		policy := *walletOptions.SpendingPolicy
+		policy.Username = username
+		policy.WalletID = &walletId
+		if err := r.SetSpendingPolicy(tx, &policy); err != nil {
+			return err
+		}
+	}
+	if walletOptions.UserSpendingPolicy != nil {
+		policy := *walletOptions.UserSpendingPolicy
+		policy.Username = username
+		policy.WalletID = nil
+		if err := r.SetSpendingPolicy(tx, &policy); err != nil {
+			return err
+		}
+	}
+	// balances are changed through the ledger
 	if walletOptions.Balance != nil {
-		updateAttributes["balance"] = *walletOptions.Balance
+		if _, err := r.UpdateWalletWithBalance(tx, username, walletId, *walletOptions.Balance); err != nil {
+			return err
+		}
+	}
+	if len(updateAttributes) == 0 && (walletOptions.Balance != nil || walletOptions.SpendingPolicy != nil ||
+		walletOptions.UserSpendingPolicy != nil) {
+		return nil
 	}
 	if res := tx.Model(wallet).Where("username = ? AND id = ?", username, walletId).Updates(updateAttributes); res.Error != nil {
+		if strings.Contains(res.Error.Error(), gormMsgSubstrUniqueConstraintFailed) ||
+			strings.Contains(res.Error.Error(), gormMsgSubstrDuplicateKey) {
+			log.WithError(res.Error).WithFields(log.Fields{
+				"wallet": walletId,
+				"user":   username,
+			}).Info(MsgUpdateWalletFailed)
+			return fmt.Errorf("%s. Reason: %v", MsgUpdateWalletFailed, MsgAddressAliasAlreadyExists)
+		}
 		log.WithError(res.Error).WithFields(log.Fields{
 			"wallet": walletId,
 			"user":   username,
@@ -163,18 +252,29 @@ func (r *repository) UpdateWalletOptions(tx *gorm.DB, username, walletId string,
 
 func (r *repository) UpdateWalletWithBalance(tx *gorm.DB, username string, walletId string, newBalance uint64) (*Wallet, error) {
 	wallet := Wallet{}
-	if res := tx.Model(&wallet).Where("username = ? AND id = ?", username, walletId).UpdateColumn("balance", newBalance); res.Error != nil {
-		log.WithError(res.Error).WithFields(log.Fields{
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Take(&wallet, "username = ? AND id = ?", username, walletId).Error; err == gorm.ErrRecordNotFound {
+		return nil, ErrWalletNotFound
+	} else if err != nil {
+		log.WithError(err).WithFields(log.Fields{
 			"wallet":     walletId,
 			"user":       username,
 			"newBalance": newBalance,
 		}).Error(MsgUpdateWalletBalanceFailed)
 		return nil, fmt.Errorf("%s. Reason: %v", MsgUpdateWalletBalanceFailed, ErrInternal)
-	} else if res.RowsAffected == 0 {
-		return nil, ErrWalletNotFound
-	} else {
-		return &wallet, nil
 	}
+	var err error
+	if newBalance > wallet.Balance {
+		err = r.PostJournal(tx, nil,
+			AccountDebit(AccountAdjustments, newBalance-wallet.Balance),
+			WalletCredit(username, walletId, newBalance-wallet.Balance))
+	} else if newBalance < wallet.Balance {
+		err = r.writeOffWalletBalance(tx, username, walletId, wallet.Balance-newBalance)
+	}
+	if err != nil {
+		return nil, err
+	}
+	wallet.Balance = newBalance
+	return &wallet, nil
 }
 
 func (r *repository) IncrementWalletBalance(tx *gorm.DB, username, walletId string, deltaBalance uint64) error {
@@ -199,7 +299,7 @@ func (r *repository) DecrementWalletBalance(tx *gorm.DB, username string, wallet
 		pendingPaymentTotal uint64
 	)
 	// get pending payments while locking rows
-	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("amount").Where("wallet_id = ?", walletId).Find(&pendingPayments).Error
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("amount", "service_fee", "fee_reserve").Where("wallet_id = ?", walletId).Find(&pendingPayments).Error
 	if err != nil {
 		log.WithError(err).WithFields(log.Fields{
 			"wallet":       walletId,
@@ -209,7 +309,7 @@ func (r *repository) DecrementWalletBalance(tx *gorm.DB, username string, wallet
 		return fmt.Errorf("%s. Reason: %v", MsgDecrementWalletFailed, ErrInternal)
 	}
 	for _, pendingPayment := range pendingPayments {
-		pendingPaymentTotal += pendingPayment.Amount
+		pendingPaymentTotal += pendingPayment.Amount + pendingPayment.ServiceFee + pendingPayment.FeeReserve
 	}
 	minBalance := deltaBalance + pendingPaymentTotal
 	// update wallet
@@ -266,14 +366,39 @@ func (r *repository) LockWalletRecordForUpdate(tx *gorm.DB, username string, wal
 }
 
 func (r *repository) GetWalletWithApiKey(tx *gorm.DB, apiKey string) (*Wallet, error) {
-	wallet := &Wallet{}
-	if err := tx.First(wallet, "api_key = ?", apiKey).Error; err == gorm.ErrRecordNotFound {
-		return nil, ErrWalletNotFound
-	} else if err != nil {
-		log.WithError(err).Error(MsgGetUserWithApiKeyFailed)
-		return nil, fmt.Errorf("%s. Reason: %v", MsgGetUserWithApiKeyFailed, ErrInternal)
+	var wallets []*Wallet
+	if err := tx.Find(&wallets, "api_key_prefix = ?", util.KeyPrefix(apiKey)).Error; err != nil {
+		log.WithError(err).Error(MsgGetWalletWithApiKeyFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetWalletWithApiKeyFailed, ErrInternal)
+	}
+	for _, wallet := range wallets {
+		if util.KeyMatchesHash(apiKey, wallet.ApiKeyHash) {
+			return wallet, nil
+		}
+	}
+	return nil, ErrWalletNotFound
+}
+
+func (r *repository) RotateWalletApiKey(tx *gorm.DB, username, walletId string) (string, error) {
+	key, prefix, hash, err := genHashedKey()
+	if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"user":   username,
+			"wallet": walletId,
+		}).Error(MsgRotateApiKeyFailed)
+		return "", fmt.Errorf("%s. Reason: %v", MsgRotateApiKeyFailed, ErrInternal)
+	}
+	if res := tx.Model(&Wallet{}).Where("username = ? AND id = ?", username, walletId).
+		Updates(Wallet{ApiKeyPrefix: prefix, ApiKeyHash: hash}); res.Error != nil {
+		log.WithError(res.Error).WithFields(log.Fields{
+			"user":   username,
+			"wallet": walletId,
+		}).Error(MsgRotateApiKeyFailed)
+		return "", fmt.Errorf("%s. Reason: %v", MsgRotateApiKeyFailed, ErrInternal)
+	} else if res.RowsAffected == 0 {
+		return "", ErrWalletNotFound
 	} else {
-		return wallet, nil
+		return key, nil
 	}
 }
 
@@ -315,6 +440,57 @@ func (r *repository) GetLinkedWallet(tx *gorm.DB, key string) (*Wallet, error) {
 	}
 }
 
+func (r *repository) GetWalletWithAddressAlias(tx *gorm.DB, alias string) (*Wallet, error) {
+	wallet := Wallet{}
+	if err := tx.Take(&wallet, "address_alias = ?", alias).Error; err == gorm.ErrRecordNotFound {
+		return nil, ErrAddressAliasNotFound
+	} else if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"alias": alias,
+		}).Error(MsgGetWalletWithAddressAliasFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetWalletWithAddressAliasFailed, ErrInternal)
+	} else {
+		return &wallet, nil
+	}
+}
+
+func (r *repository) GetWalletWithRoutingTag(tx *gorm.DB, tag string) (*Wallet, error) {
+	wallet := Wallet{}
+	if err := tx.Take(&wallet, "routing_tag = ?", tag).Error; err == gorm.ErrRecordNotFound {
+		return nil, ErrRoutingTagNotFound
+	} else if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"tag": tag,
+		}).Error(MsgGetWalletWithRoutingTagFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgGetWalletWithRoutingTagFailed, ErrInternal)
+	} else {
+		return &wallet, nil
+	}
+}
+
+func (r *repository) AssignWalletRoutingTags(tx *gorm.DB) (int, error) {
+	var wallets []Wallet
+	if err := tx.Where("routing_tag IS NULL").Find(&wallets).Error; err != nil {
+		log.WithError(err).Error(MsgAssignRoutingTagsFailed)
+		return 0, fmt.Errorf("%s. Reason: %v", MsgAssignRoutingTagsFailed, ErrInternal)
+	}
+	for _, wallet := range wallets {
+		tag, err := util.GenRoutingTag()
+		if err != nil {
+			log.WithError(err).Error(MsgAssignRoutingTagsFailed)
+			return 0, fmt.Errorf("%s. Reason: %v", MsgAssignRoutingTagsFailed, ErrInternal)
+		} else if err := tx.Model(&Wallet{}).Where("username = ? AND id = ?", wallet.Username, wallet.ID).
+			Update("routing_tag", tag).Error; err != nil {
+			log.WithError(err).WithFields(log.Fields{
+				"user":   wallet.Username,
+				"wallet": wallet.ID,
+			}).Error(MsgAssignRoutingTagsFailed)
+			return 0, fmt.Errorf("%s. Reason: %v", MsgAssignRoutingTagsFailed, ErrInternal)
+		}
+	}
+	return len(wallets), nil
+}
+
 func (r *repository) ListUserLinkedWallets(tx *gorm.DB, username string) ([]*Wallet, error) {
 	var wallet []*Wallet
 	if err := tx.Find(&wallet, "username = ? AND link_key IS NOT NULL", username).Error; err != nil {
@@ -346,8 +522,17 @@ func (r *repository) GetConfirmedBalance(tx *gorm.DB, username, walletId string)
 		return 0, fmt.Errorf("%s. Reason: %v", MsgGetConfirmedBalanceFailed, err)
 	}
 	for _, pp := range pendingPayments {
-		confirmedBalance -= pp.Amount
+		confirmedBalance -= pp.Amount + pp.ServiceFee + pp.FeeReserve
 	}
 
 	return confirmedBalance, err
 }
+
+func (r *repository) GetTotalWalletBalance(tx *gorm.DB) (uint64, error) {
+	var total uint64
+	if err := tx.Model(&Wallet{}).Select("COALESCE(SUM(balance), 0)").Scan(&total).Error; err != nil {
+		log.WithError(err).Error(MsgGetTotalWalletBalanceFailed)
+		return 0, fmt.Errorf("%s. Reason: %v", MsgGetTotalWalletBalanceFailed, ErrInternal)
+	}
+	return total, nil
+}
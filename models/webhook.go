@@ -0,0 +1,165 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// Webhook is an endpoint that is notified of a user's wallet events.
+// If WalletID is nil, then the webhook is notified of the events of all the user's wallets.
+type Webhook struct {
+	ID string `gorm:"primaryKey" sql:"type:uuid"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Username string  `gorm:"index"`
+	User     User    `gorm:"foreignKey:Username;references:Username;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	WalletID *string `gorm:"index"`
+
+	URL string
+}
+
+// WebhookDelivery is a queued notification of a wallet event to a webhook.
+// Deliveries are removed once they succeed, and are marked dead once they run out of attempts.
+type WebhookDelivery struct {
+	ID string `gorm:"primaryKey" sql:"type:uuid"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	WebhookID string  `gorm:"index"`
+	Webhook   Webhook `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+
+	// wallet that the event belongs to
+	WalletID string
+	Username string
+
+	Event   string
+	Payload string
+
+	Attempts    uint
+	NextAttempt time.Time `gorm:"index"`
+	LastError   string
+	Dead        bool
+}
+
+func (r *repository) CreateWebhook(tx *gorm.DB, webhook *Webhook) error {
+	if webhook == nil {
+		return fmt.Errorf("%s. Reason: %v", MsgCreateWebhookFailed, MsgReceivedNil)
+	} else if err := tx.Create(webhook).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"user":   webhook.Username,
+			"wallet": webhook.WalletID,
+			"url":    webhook.URL,
+		}).Error(MsgCreateWebhookFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgCreateWebhookFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) ListWebhooks(tx *gorm.DB, username string, walletId *string) ([]*Webhook, error) {
+	var (
+		webhooks []*Webhook
+		err      error
+	)
+	if walletId == nil {
+		err = tx.Order("created_at").Find(&webhooks, "username = ?", username).Error
+	} else {
+		err = tx.Order("created_at").Find(&webhooks, "username = ? AND wallet_id = ?", username, *walletId).Error
+	}
+	if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"user":   username,
+			"wallet": walletId,
+		}).Error(MsgListWebhooksFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgListWebhooksFailed, ErrInternal)
+	} else {
+		return webhooks, nil
+	}
+}
+
+func (r *repository) ListWalletEventWebhooks(tx *gorm.DB, username, walletId string) ([]*Webhook, error) {
+	var webhooks []*Webhook
+	if err := tx.Find(&webhooks, "username = ? AND (wallet_id IS NULL OR wallet_id = ?)", username, walletId).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"user":   username,
+			"wallet": walletId,
+		}).Error(MsgListWebhooksFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgListWebhooksFailed, ErrInternal)
+	} else {
+		return webhooks, nil
+	}
+}
+
+func (r *repository) DeleteWebhook(tx *gorm.DB, username string, walletId *string, id string) error {
+	var res *gorm.DB
+	if walletId == nil {
+		res = tx.Where("username = ? AND id = ?", username, id).Delete(&Webhook{})
+	} else {
+		res = tx.Where("username = ? AND wallet_id = ? AND id = ?", username, *walletId, id).Delete(&Webhook{})
+	}
+	if res.Error != nil {
+		log.WithError(res.Error).WithFields(log.Fields{
+			"user":    username,
+			"wallet":  walletId,
+			"webhook": id,
+		}).Error(MsgDeleteWebhookFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgDeleteWebhookFailed, ErrInternal)
+	} else if res.RowsAffected == 0 {
+		return ErrWebhookNotFound
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) CreateWebhookDeliveries(tx *gorm.DB, deliveries []*WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	} else if err := tx.Create(&deliveries).Error; err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"total": len(deliveries),
+		}).Error(MsgCreateWebhookDeliveriesFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgCreateWebhookDeliveriesFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) ListDueWebhookDeliveries(tx *gorm.DB, now time.Time, limit int) ([]*WebhookDelivery, error) {
+	var deliveries []*WebhookDelivery
+	if err := tx.Preload("Webhook").Order("next_attempt").Limit(limit).
+		Find(&deliveries, "dead = ? AND next_attempt <= ?", false, now).Error; err != nil {
+		log.WithError(err).Error(MsgListWebhookDeliveriesFailed)
+		return nil, fmt.Errorf("%s. Reason: %v", MsgListWebhookDeliveriesFailed, ErrInternal)
+	} else {
+		return deliveries, nil
+	}
+}
+
+func (r *repository) DeleteWebhookDelivery(tx *gorm.DB, id string) error {
+	if err := tx.Where("id = ?", id).Delete(&WebhookDelivery{}).Error; err != nil {
+		log.WithError(err).WithField("delivery", id).Error(MsgDeleteWebhookDeliveryFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgDeleteWebhookDeliveryFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
+
+func (r *repository) UpdateWebhookDeliveryAttempt(tx *gorm.DB, delivery *WebhookDelivery) error {
+	if err := tx.Model(&WebhookDelivery{}).Where("id = ?", delivery.ID).Updates(map[string]interface{}{
+		"attempts":     delivery.Attempts,
+		"next_attempt": delivery.NextAttempt,
+		"last_error":   delivery.LastError,
+		"dead":         delivery.Dead,
+	}).Error; err != nil {
+		log.WithError(err).WithField("delivery", delivery.ID).Error(MsgUpdateWebhookDeliveryFailed)
+		return fmt.Errorf("%s. Reason: %v", MsgUpdateWebhookDeliveryFailed, ErrInternal)
+	} else {
+		return nil
+	}
+}
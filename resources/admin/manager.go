@@ -0,0 +1,186 @@
+package admin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/util"
+)
+
+// time that admins are cached for once they authenticate
+var cacheDuration = time.Minute
+
+// ErrLastAdmin is returned when a change would leave no admins with models.AdminRoleAdmin while the bootstrap key is
+// disabled, after which nobody could manage admins.
+var ErrLastAdmin = errors.New("cannot remove the last admin with the admin role while the bootstrap key is disabled")
+
+type Manager interface {
+	// CreateAdmin validates and adds an admin account with the role. The key of the admin is only set on the
+	// returned admin.
+	CreateAdmin(name, role string) (*models.Admin, error)
+
+	// ListAdmins lists every admin account.
+	ListAdmins() ([]*models.Admin, error)
+
+	// CountAdmins returns the number of admin accounts with the role.
+	CountAdmins(role string) (int64, error)
+
+	// SetRole validates and changes the role of an admin, and returns the updated admin.
+	// Errors with ErrLastAdmin if the admin is the last one with models.AdminRoleAdmin and the bootstrap key is
+	// disabled.
+	SetRole(name, role string) (*models.Admin, error)
+
+	// DeleteAdmin removes an admin account, after which its key can no longer be used.
+	// Errors with ErrLastAdmin if the admin is the last one with models.AdminRoleAdmin and the bootstrap key is
+	// disabled.
+	DeleteAdmin(name string) error
+
+	// RotateKey replaces the key of an admin, and returns the new key. The old key can no longer be used.
+	RotateKey(name string) (string, error)
+
+	// Authenticate returns the admin whose key is key.
+	// Errors with models.ErrAdminNotFound if there is no such admin.
+	Authenticate(key string) (*models.Admin, error)
+}
+
+type manager struct {
+	db *db.DB
+	// whether the admin API key of the config is disabled, in which case admin accounts are the only way to manage
+	// XLN
+	bootstrapKeyDisabled bool
+	// admins by the digest of their key
+	admins *cache.Cache
+}
+
+func NewManager(db *db.DB, bootstrapKeyDisabled bool) Manager {
+	return &manager{
+		db:                   db,
+		bootstrapKeyDisabled: bootstrapKeyDisabled,
+		admins:               cache.New(cacheDuration, 2*cacheDuration),
+	}
+}
+
+func (m *manager) CreateAdmin(name, role string) (*models.Admin, error) {
+	if err := util.ValidateAdminName(name); err != nil {
+		return nil, err
+	} else if err := validateRole(role); err != nil {
+		return nil, err
+	}
+	admin := &models.Admin{Name: name, Role: role}
+	if err := m.db.Repo.CreateAdmin(m.db.DB, admin); err != nil {
+		return nil, err
+	}
+	log.WithFields(log.Fields{
+		"admin": name,
+		"role":  role,
+	}).Info("admin created")
+	return admin, nil
+}
+
+func (m *manager) ListAdmins() ([]*models.Admin, error) {
+	return m.db.Repo.ListAdmins(m.db.DB)
+}
+
+func (m *manager) CountAdmins(role string) (int64, error) {
+	return m.db.Repo.CountAdminsWithRole(m.db.DB, role)
+}
+
+func (m *manager) SetRole(name, role string) (*models.Admin, error) {
+	if err := validateRole(role); err != nil {
+		return nil, err
+	}
+	admin, err := m.db.Repo.GetAdmin(m.db.DB, name)
+	if err != nil {
+		return nil, err
+	} else if admin.Role == role {
+		return admin, nil
+	} else if err := m.checkNotLastAdmin(admin); err != nil {
+		return nil, err
+	} else if err := m.db.Repo.UpdateAdminRole(m.db.DB, name, role); err != nil {
+		return nil, err
+	}
+	m.forget(name)
+	log.WithFields(log.Fields{
+		"admin": name,
+		"from":  admin.Role,
+		"to":    role,
+	}).Info("admin role changed")
+	admin.Role = role
+	return admin, nil
+}
+
+func (m *manager) DeleteAdmin(name string) error {
+	admin, err := m.db.Repo.GetAdmin(m.db.DB, name)
+	if err != nil {
+		return err
+	} else if err := m.checkNotLastAdmin(admin); err != nil {
+		return err
+	} else if err := m.db.Repo.DeleteAdmin(m.db.DB, name); err != nil {
+		return err
+	}
+	m.forget(name)
+	log.WithField("admin", name).Info("admin deleted")
+	return nil
+}
+
+func (m *manager) RotateKey(name string) (string, error) {
+	key, err := m.db.Repo.RotateAdminKey(m.db.DB, name)
+	if err != nil {
+		return "", err
+	}
+	m.forget(name)
+	log.WithField("admin", name).Info("admin key rotated")
+	return key, nil
+}
+
+func (m *manager) Authenticate(key string) (*models.Admin, error) {
+	digest := sha256.Sum256([]byte(key))
+	cacheKey := hex.EncodeToString(digest[:])
+	if cached, contains := m.admins.Get(cacheKey); contains {
+		return cached.(*models.Admin), nil
+	}
+	admin, err := m.db.Repo.GetAdminWithKey(m.db.DB, key)
+	if err != nil {
+		return nil, err
+	}
+	m.admins.SetDefault(cacheKey, admin)
+	return admin, nil
+}
+
+// checkNotLastAdmin errors with ErrLastAdmin if the bootstrap key is disabled and admin is the only admin with
+// models.AdminRoleAdmin.
+func (m *manager) checkNotLastAdmin(admin *models.Admin) error {
+	if !m.bootstrapKeyDisabled || admin.Role != models.AdminRoleAdmin {
+		return nil
+	} else if count, err := m.db.Repo.CountAdminsWithRole(m.db.DB, models.AdminRoleAdmin); err != nil {
+		return err
+	} else if count <= 1 {
+		return ErrLastAdmin
+	}
+	return nil
+}
+
+// forget removes the admin from the cache, so that changes to it apply to the next request.
+func (m *manager) forget(name string) {
+	for digest, cached := range m.admins.Items() {
+		if cached.Object.(*models.Admin).Name == name {
+			m.admins.Delete(digest)
+		}
+	}
+}
+
+func validateRole(role string) error {
+	for _, known := range models.AdminRoles {
+		if known == role {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown admin role: %s", role)
+}
@@ -0,0 +1,166 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"gorm.io/gorm"
+)
+
+func TestAdminManager(t *testing.T) {
+	suite.Run(t, new(adminManagerSuite))
+}
+
+type adminManagerSuite struct {
+	suite.Suite
+	mgr      Manager
+	mockRepo mockRepo
+}
+
+const adminName = "test-admin"
+
+func (s *adminManagerSuite) SetupTest() {
+	s.mockRepo = mockRepo{admins: make(map[string]*models.Admin)}
+	s.mgr = NewManager(&db.DB{Repo: &s.mockRepo}, true)
+}
+
+func (s *adminManagerSuite) TestCreateRejectsInvalidAdmins() {
+	_, err := s.mgr.CreateAdmin("", models.AdminRoleAdmin)
+	s.Require().Error(err)
+	_, err = s.mgr.CreateAdmin("-not-valid", models.AdminRoleAdmin)
+	s.Require().Error(err)
+	_, err = s.mgr.CreateAdmin(adminName, "root")
+	s.Require().Error(err)
+	s.Require().Empty(s.mockRepo.admins)
+}
+
+func (s *adminManagerSuite) TestAuthenticateFollowsChanges() {
+	admin, err := s.mgr.CreateAdmin(adminName, models.AdminRoleAuditor)
+	s.Require().NoError(err)
+	key := admin.Key
+
+	authenticated, err := s.mgr.Authenticate(key)
+	s.Require().NoError(err)
+	s.Require().Equal(models.AdminRoleAuditor, authenticated.Role)
+
+	_, err = s.mgr.SetRole(adminName, models.AdminRoleSupport)
+	s.Require().NoError(err)
+	authenticated, err = s.mgr.Authenticate(key)
+	s.Require().NoError(err)
+	s.Require().Equal(models.AdminRoleSupport, authenticated.Role, "cached admins are forgotten when they change")
+
+	rotated, err := s.mgr.RotateKey(adminName)
+	s.Require().NoError(err)
+	_, err = s.mgr.Authenticate(key)
+	s.Require().Equal(models.ErrAdminNotFound, err)
+	_, err = s.mgr.Authenticate(rotated)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.mgr.DeleteAdmin(adminName))
+	_, err = s.mgr.Authenticate(rotated)
+	s.Require().Equal(models.ErrAdminNotFound, err)
+}
+
+func (s *adminManagerSuite) TestLastAdminIsKept() {
+	_, err := s.mgr.CreateAdmin(adminName, models.AdminRoleAdmin)
+	s.Require().NoError(err)
+	_, err = s.mgr.SetRole(adminName, models.AdminRoleAuditor)
+	s.Require().Equal(ErrLastAdmin, err)
+	s.Require().Equal(ErrLastAdmin, s.mgr.DeleteAdmin(adminName))
+
+	_, err = s.mgr.CreateAdmin("other-admin", models.AdminRoleAdmin)
+	s.Require().NoError(err)
+	_, err = s.mgr.SetRole(adminName, models.AdminRoleAuditor)
+	s.Require().NoError(err)
+	s.Require().Equal(ErrLastAdmin, s.mgr.DeleteAdmin("other-admin"))
+
+	// the bootstrap key can still manage admins if it is enabled
+	s.mgr = NewManager(&db.DB{Repo: &s.mockRepo}, false)
+	s.Require().NoError(s.mgr.DeleteAdmin("other-admin"))
+}
+
+type mockRepo struct {
+	models.Repository
+
+	admins map[string]*models.Admin
+	// names of admins by their keys
+	keys map[string]string
+}
+
+func (m *mockRepo) CreateAdmin(_ *gorm.DB, admin *models.Admin) error {
+	if _, ok := m.admins[admin.Name]; ok {
+		return models.ErrInternal
+	}
+	admin.Key = admin.Name + "-key"
+	m.setKey(admin.Name, admin.Key)
+	stored := *admin
+	m.admins[admin.Name] = &stored
+	return nil
+}
+
+func (m *mockRepo) GetAdmin(_ *gorm.DB, name string) (*models.Admin, error) {
+	if admin, ok := m.admins[name]; ok {
+		stored := *admin
+		return &stored, nil
+	}
+	return nil, models.ErrAdminNotFound
+}
+
+func (m *mockRepo) GetAdminWithKey(tx *gorm.DB, key string) (*models.Admin, error) {
+	if name, ok := m.keys[key]; ok {
+		return m.GetAdmin(tx, name)
+	}
+	return nil, models.ErrAdminNotFound
+}
+
+func (m *mockRepo) CountAdminsWithRole(_ *gorm.DB, role string) (int64, error) {
+	var count int64
+	for _, admin := range m.admins {
+		if admin.Role == role {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockRepo) UpdateAdminRole(_ *gorm.DB, name, role string) error {
+	if admin, ok := m.admins[name]; ok {
+		admin.Role = role
+		return nil
+	}
+	return models.ErrAdminNotFound
+}
+
+func (m *mockRepo) RotateAdminKey(_ *gorm.DB, name string) (string, error) {
+	if _, ok := m.admins[name]; !ok {
+		return "", models.ErrAdminNotFound
+	}
+	key := name + "-rotated-key"
+	m.setKey(name, key)
+	return key, nil
+}
+
+func (m *mockRepo) DeleteAdmin(_ *gorm.DB, name string) error {
+	if _, ok := m.admins[name]; !ok {
+		return models.ErrAdminNotFound
+	}
+	delete(m.admins, name)
+	m.setKey(name, "")
+	return nil
+}
+
+func (m *mockRepo) setKey(name, key string) {
+	if m.keys == nil {
+		m.keys = make(map[string]string)
+	}
+	for k, n := range m.keys {
+		if n == name {
+			delete(m.keys, k)
+		}
+	}
+	if key != "" {
+		m.keys[key] = name
+	}
+}
@@ -0,0 +1,159 @@
+package apikey
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/util"
+)
+
+// time that keys are cached for once they are used. The last used time of a key is recorded when it is cached, so
+// this is also the precision of last used times.
+var cacheDuration = time.Minute
+
+type Manager interface {
+	// CreateApiKey validates and adds a key of the user, or of the user's wallet if walletId is set, with the scopes.
+	// The key expires at expiresAt if it is set.
+	CreateApiKey(username string, walletId *string, name string, scopes []string,
+		expiresAt *time.Time) (*models.ApiKey, error)
+
+	// ListApiKeys lists the keys of the user and of the user's wallets.
+	ListApiKeys(username string) ([]*models.ApiKey, error)
+
+	// RevokeApiKey removes a key of the user or of one of the user's wallets, after which it can no longer be used.
+	RevokeApiKey(username, id string) error
+
+	// Authenticate returns the key whose secret is key, and records that the key was used.
+	// Errors with models.ErrApiKeyNotFound if there is no such key or if it expired.
+	Authenticate(key string) (*models.ApiKey, error)
+}
+
+type manager struct {
+	db *db.DB
+	// keys by the digest of their secret
+	keys *cache.Cache
+}
+
+func NewManager(db *db.DB) Manager {
+	return &manager{
+		db:   db,
+		keys: cache.New(cacheDuration, 2*cacheDuration),
+	}
+}
+
+func (m *manager) CreateApiKey(username string, walletId *string, name string, scopes []string,
+	expiresAt *time.Time) (*models.ApiKey, error) {
+	if err := util.ValidateApiKeyName(name); err != nil {
+		return nil, err
+	} else if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, errors.New("expiry must be in the future")
+	}
+	scopes, err := normalizeScopes(scopes)
+	if err != nil {
+		return nil, err
+	}
+	if walletId != nil {
+		if _, err := m.db.Repo.GetWallet(m.db.DB, username, *walletId); err != nil {
+			return nil, err
+		}
+	}
+
+	key := &models.ApiKey{
+		Username:  username,
+		WalletID:  walletId,
+		Name:      name,
+		Scopes:    strings.Join(scopes, ","),
+		ExpiresAt: expiresAt,
+	}
+	if err := m.db.Repo.CreateApiKey(m.db.DB, key); err != nil {
+		return nil, err
+	}
+	log.WithFields(log.Fields{
+		"user":   username,
+		"wallet": walletId,
+		"key":    key.ID,
+		"scopes": key.Scopes,
+	}).Info("api key created")
+	return key, nil
+}
+
+// normalizeScopes returns the scopes sorted and without duplicates, and errors if any of them are unknown.
+func normalizeScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+	set := make(map[string]struct{}, len(scopes))
+	for _, scope := range scopes {
+		known := false
+		for _, s := range models.Scopes {
+			known = known || s == scope
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown scope: %s", scope)
+		}
+		set[scope] = struct{}{}
+	}
+	normalized := make([]string, 0, len(set))
+	for scope := range set {
+		normalized = append(normalized, scope)
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+func (m *manager) ListApiKeys(username string) ([]*models.ApiKey, error) {
+	return m.db.Repo.ListUserApiKeys(m.db.DB, username)
+}
+
+func (m *manager) RevokeApiKey(username, id string) error {
+	key, err := m.db.Repo.GetUserApiKey(m.db.DB, username, id)
+	if err != nil {
+		return err
+	} else if err := m.db.Repo.DeleteApiKey(m.db.DB, username, id); err != nil {
+		return err
+	}
+	for digest, cached := range m.keys.Items() {
+		if cached.Object.(*models.ApiKey).ID == key.ID {
+			m.keys.Delete(digest)
+		}
+	}
+	log.WithFields(log.Fields{
+		"user": username,
+		"key":  id,
+	}).Info("api key revoked")
+	return nil
+}
+
+func (m *manager) Authenticate(key string) (*models.ApiKey, error) {
+	now := time.Now().UTC()
+	digest := sha256.Sum256([]byte(key))
+	cacheKey := hex.EncodeToString(digest[:])
+	if cached, contains := m.keys.Get(cacheKey); contains {
+		apiKey := cached.(*models.ApiKey)
+		if apiKey.Expired(now) {
+			return nil, models.ErrApiKeyNotFound
+		}
+		return apiKey, nil
+	}
+
+	apiKey, err := m.db.Repo.GetApiKey(m.db.DB, key)
+	if err != nil {
+		return nil, err
+	} else if apiKey.Expired(now) {
+		return nil, models.ErrApiKeyNotFound
+	} else if err := m.db.Repo.UpdateApiKeyLastUsed(m.db.DB, apiKey.ID, now); err != nil {
+		return nil, err
+	}
+	apiKey.LastUsedAt = &now
+	m.keys.SetDefault(cacheKey, apiKey)
+	return apiKey, nil
+}
@@ -0,0 +1,168 @@
+package apikey
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"gorm.io/gorm"
+)
+
+func TestApiKeyManager(t *testing.T) {
+	suite.Run(t, new(apiKeyManagerSuite))
+}
+
+type apiKeyManagerSuite struct {
+	suite.Suite
+	mgr      Manager
+	mockRepo mockRepo
+}
+
+const (
+	username = "test-username"
+	walletId = "test-walletid"
+)
+
+func (s *apiKeyManagerSuite) SetupTest() {
+	s.mockRepo = mockRepo{keys: make(map[string]*models.ApiKey)}
+	s.mgr = NewManager(&db.DB{Repo: &s.mockRepo})
+}
+
+func (s *apiKeyManagerSuite) TestCreateNormalizesScopes() {
+	key, err := s.mgr.CreateApiKey(username, nil, "pos",
+		[]string{models.ScopeInvoice, models.ScopeRead, models.ScopeInvoice}, nil)
+	s.Require().NoError(err)
+	s.Require().Equal("invoice,read", key.Scopes)
+	s.Require().True(key.HasScope(models.ScopeRead))
+	s.Require().False(key.HasScope(models.ScopePay))
+}
+
+func (s *apiKeyManagerSuite) TestCreateRejectsInvalidKeys() {
+	past := time.Now().Add(-time.Minute)
+	for _, invalid := range []struct {
+		walletId  *string
+		name      string
+		scopes    []string
+		expiresAt *time.Time
+	}{
+		{name: "", scopes: []string{models.ScopeRead}},
+		{name: "pos"},
+		{name: "pos", scopes: []string{"everything"}},
+		{name: "pos", scopes: []string{models.ScopeRead}, expiresAt: &past},
+	} {
+		_, err := s.mgr.CreateApiKey(username, invalid.walletId, invalid.name, invalid.scopes, invalid.expiresAt)
+		s.Require().Error(err)
+	}
+	s.Require().Empty(s.mockRepo.keys)
+
+	other := "other-walletid"
+	_, err := s.mgr.CreateApiKey(username, &other, "pos", []string{models.ScopeRead}, nil)
+	s.Require().Equal(models.ErrWalletNotFound, err)
+}
+
+func (s *apiKeyManagerSuite) TestKeysOfWalletsMayHaveAdminScope() {
+	wallet := walletId
+	key, err := s.mgr.CreateApiKey(username, &wallet, "login", []string{models.ScopeAdmin}, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(walletId, *key.WalletID)
+	s.Require().True(key.HasScope(models.ScopeTransfer))
+}
+
+func (s *apiKeyManagerSuite) TestAuthenticateRecordsUse() {
+	key, err := s.mgr.CreateApiKey(username, nil, "pos", []string{models.ScopeRead}, nil)
+	s.Require().NoError(err)
+	s.Require().Nil(key.LastUsedAt)
+
+	authenticated, err := s.mgr.Authenticate(key.Key)
+	s.Require().NoError(err)
+	s.Require().Equal(key.ID, authenticated.ID)
+	s.Require().NotNil(s.mockRepo.keys[key.Key].LastUsedAt)
+
+	_, err = s.mgr.Authenticate("unknown")
+	s.Require().Equal(models.ErrApiKeyNotFound, err)
+}
+
+func (s *apiKeyManagerSuite) TestAuthenticateRejectsExpiredKeys() {
+	expiresAt := time.Now().Add(time.Minute)
+	key, err := s.mgr.CreateApiKey(username, nil, "pos", []string{models.ScopeRead}, &expiresAt)
+	s.Require().NoError(err)
+	cached, err := s.mgr.Authenticate(key.Key)
+	s.Require().NoError(err)
+
+	expired := time.Now().Add(-time.Second)
+	cached.ExpiresAt = &expired
+	_, err = s.mgr.Authenticate(key.Key)
+	s.Require().Equal(models.ErrApiKeyNotFound, err, "cached keys are rejected once they expire")
+}
+
+func (s *apiKeyManagerSuite) TestRevokedKeysCannotBeUsed() {
+	key, err := s.mgr.CreateApiKey(username, nil, "pos", []string{models.ScopeRead}, nil)
+	s.Require().NoError(err)
+	_, err = s.mgr.Authenticate(key.Key)
+	s.Require().NoError(err)
+
+	s.Require().Equal(models.ErrApiKeyNotFound, s.mgr.RevokeApiKey("other-username", key.ID))
+	s.Require().NoError(s.mgr.RevokeApiKey(username, key.ID))
+	_, err = s.mgr.Authenticate(key.Key)
+	s.Require().Equal(models.ErrApiKeyNotFound, err, "revoked keys are removed from the cache")
+}
+
+type mockRepo struct {
+	models.Repository
+
+	// keys by secret
+	keys map[string]*models.ApiKey
+}
+
+func (m *mockRepo) GetWallet(_ *gorm.DB, user, id string) (*models.Wallet, error) {
+	if user != username || id != walletId {
+		return nil, models.ErrWalletNotFound
+	}
+	return &models.Wallet{Username: user, ID: id}, nil
+}
+
+func (m *mockRepo) CreateApiKey(_ *gorm.DB, key *models.ApiKey) error {
+	key.ID = strconv.Itoa(len(m.keys))
+	key.Key = "secret-" + key.ID
+	m.keys[key.Key] = key
+	return nil
+}
+
+func (m *mockRepo) GetApiKey(_ *gorm.DB, key string) (*models.ApiKey, error) {
+	if k, ok := m.keys[key]; ok {
+		copied := *k
+		return &copied, nil
+	}
+	return nil, models.ErrApiKeyNotFound
+}
+
+func (m *mockRepo) GetUserApiKey(_ *gorm.DB, user, id string) (*models.ApiKey, error) {
+	for _, k := range m.keys {
+		if k.Username == user && k.ID == id {
+			return k, nil
+		}
+	}
+	return nil, models.ErrApiKeyNotFound
+}
+
+func (m *mockRepo) UpdateApiKeyLastUsed(_ *gorm.DB, id string, time time.Time) error {
+	for _, k := range m.keys {
+		if k.ID == id {
+			k.LastUsedAt = &time
+		}
+	}
+	return nil
+}
+
+func (m *mockRepo) DeleteApiKey(_ *gorm.DB, user, id string) error {
+	for secret, k := range m.keys {
+		if k.Username == user && k.ID == id {
+			delete(m.keys, secret)
+			return nil
+		}
+	}
+	return models.ErrApiKeyNotFound
+}
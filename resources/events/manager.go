@@ -0,0 +1,118 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/models"
+)
+
+const (
+	EventInvoiceSettled   = "invoice.settled"
+	EventInvoiceAccepted  = "invoice.accepted"
+	EventInvoiceCancelled = "invoice.cancelled"
+	EventPaymentSucceeded = "payment.succeeded"
+	EventPaymentFailed    = "payment.failed"
+	EventTransferSent     = "transfer.sent"
+	EventTransferReceived = "transfer.received"
+)
+
+// number of events buffered for each subscriber before it is considered to have fallen behind
+var subscriberBuffer = 64
+
+// Event is a change to a wallet that is pushed to the wallet's subscribers.
+type Event struct {
+	Type     string
+	Username string
+	WalletID string
+	Time     time.Time
+
+	// Transaction is set for events that moved funds into or out of the wallet.
+	Transaction *models.Transaction
+
+	PaymentHash   string
+	AmountMsat    uint64
+	FeeMsat       uint64
+	FailureReason string
+}
+
+type Manager interface {
+	// Subscribe returns a channel on which the events of the wallet are received, and a function that ends
+	// the subscription. The channel is closed when the subscription ends, including when the subscriber
+	// falls too far behind the published events.
+	Subscribe(username, walletId string) (<-chan *Event, func())
+
+	// Publish notifies the subscribers of the event's wallet. It must only be called once the change
+	// that the event describes has been committed. It never blocks.
+	Publish(event *Event)
+}
+
+type walletKey struct {
+	username string
+	walletId string
+}
+
+type manager struct {
+	mu          sync.Mutex
+	subscribers map[walletKey]map[chan *Event]struct{}
+}
+
+func NewManager() Manager {
+	return &manager{subscribers: make(map[walletKey]map[chan *Event]struct{})}
+}
+
+func (m *manager) Subscribe(username, walletId string) (<-chan *Event, func()) {
+	key := walletKey{username: username, walletId: walletId}
+	ch := make(chan *Event, subscriberBuffer)
+
+	m.mu.Lock()
+	if m.subscribers[key] == nil {
+		m.subscribers[key] = make(map[chan *Event]struct{})
+	}
+	m.subscribers[key][ch] = struct{}{}
+	m.mu.Unlock()
+
+	return ch, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.unsubscribe(key, ch)
+	}
+}
+
+func (m *manager) Publish(event *Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+	key := walletKey{username: event.Username, walletId: event.WalletID}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for ch := range m.subscribers[key] {
+		select {
+		case ch <- event:
+		default:
+			log.WithFields(log.Fields{
+				"user":   event.Username,
+				"wallet": event.WalletID,
+			}).Warn("Dropping wallet event subscriber that fell behind")
+			m.unsubscribe(key, ch)
+		}
+	}
+}
+
+// unsubscribe removes and closes the subscriber's channel. The caller must hold the lock.
+func (m *manager) unsubscribe(key walletKey, ch chan *Event) {
+	subs, ok := m.subscribers[key]
+	if !ok {
+		return
+	}
+	if _, ok := subs[ch]; !ok {
+		return
+	}
+	delete(subs, ch)
+	close(ch)
+	if len(subs) == 0 {
+		delete(m.subscribers, key)
+	}
+}
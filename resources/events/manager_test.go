@@ -0,0 +1,51 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishNotifiesWalletSubscribers(t *testing.T) {
+	mgr := NewManager()
+	sub, cancel := mgr.Subscribe("test-username", "test-walletid")
+	defer cancel()
+	other, cancelOther := mgr.Subscribe("test-username", "other-walletid")
+	defer cancelOther()
+
+	mgr.Publish(&Event{Type: EventInvoiceSettled, Username: "test-username", WalletID: "test-walletid"})
+
+	require.Len(t, sub, 1)
+	event := <-sub
+	require.Equal(t, EventInvoiceSettled, event.Type)
+	require.False(t, event.Time.IsZero(), "publish should timestamp events")
+	require.Len(t, other, 0, "subscribers of other wallets should not be notified")
+}
+
+func TestCancelClosesSubscription(t *testing.T) {
+	mgr := NewManager()
+	sub, cancel := mgr.Subscribe("test-username", "test-walletid")
+	cancel()
+	// cancelling again is a no-op
+	cancel()
+
+	_, open := <-sub
+	require.False(t, open)
+	mgr.Publish(&Event{Type: EventInvoiceSettled, Username: "test-username", WalletID: "test-walletid"})
+}
+
+func TestSlowSubscriberIsDropped(t *testing.T) {
+	mgr := NewManager()
+	sub, cancel := mgr.Subscribe("test-username", "test-walletid")
+	defer cancel()
+
+	for i := 0; i <= subscriberBuffer; i++ {
+		mgr.Publish(&Event{Type: EventPaymentFailed, Username: "test-username", WalletID: "test-walletid"})
+	}
+
+	received := 0
+	for range sub {
+		received++
+	}
+	require.Equal(t, subscriberBuffer, received, "buffered events should be received before the subscription closes")
+}
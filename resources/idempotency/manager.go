@@ -0,0 +1,130 @@
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+)
+
+var (
+	// time after which a request that claimed a key but never completed, such as because XLN stopped while it
+	// executed, is treated as failed, so that its key may be claimed again
+	claimTimeout = time.Hour
+	// time that the responses of completed requests are kept for, after which their keys may be claimed again
+	retention = 24 * time.Hour
+	// interval at which expired keys are removed
+	sweepInterval = time.Hour
+)
+
+type Manager interface {
+	// Begin claims the idempotency key of the wallet for a request to method. If the key was already used for an
+	// identical request that completed, then completed is true and the stored response of that request is returned,
+	// in which case the request must not be executed again.
+	// Keys whose request has not completed within claimTimeout, or completed more than retention ago, are claimed
+	// again as if they were unused.
+	// Errors with models.ErrIdempotencyKeyInProgress if the request that claimed the key has not completed, and with
+	// models.ErrIdempotencyKeyReused if the key was claimed by a different request.
+	Begin(username, walletId, key, method string, request []byte) (response []byte, completed bool, err error)
+
+	// Complete stores the response of the request that claimed the key.
+	Complete(username, walletId, key string, response []byte) error
+
+	// Release frees the key of a request that failed, so that the request may be retried with the same key.
+	Release(username, walletId, key string) error
+}
+
+type manager struct {
+	db *db.DB
+}
+
+func NewManager(db *db.DB) Manager {
+	m := &manager{db: db}
+	go m.sweepExpiredKeys()
+	return m
+}
+
+func (m *manager) Begin(username, walletId, key, method string, request []byte) ([]byte, bool, error) {
+	hash := sha256.Sum256(request)
+	now := time.Now().UTC()
+	claim := &models.IdempotencyKey{
+		Username:    username,
+		WalletID:    walletId,
+		Key:         key,
+		Method:      method,
+		RequestHash: hex.EncodeToString(hash[:]),
+		ClaimedAt:   now,
+	}
+	err := m.db.Repo.CreateIdempotencyKey(m.db.DB, claim)
+	if err == nil {
+		return nil, false, nil
+	} else if err != models.ErrIdempotencyKeyInUse {
+		return nil, false, err
+	}
+
+	existing, err := m.db.Repo.GetIdempotencyKey(m.db.DB, username, walletId, key)
+	if err == models.ErrIdempotencyKeyNotFound {
+		// the request that claimed the key failed and released it while we were looking it up
+		return nil, false, models.ErrIdempotencyKeyInProgress
+	} else if err != nil {
+		return nil, false, err
+	} else if expired(existing, now) {
+		if claimed, err := m.db.Repo.ReclaimIdempotencyKey(m.db.DB, claim, existing); err != nil {
+			return nil, false, err
+		} else if !claimed {
+			// another request claimed the key first
+			return nil, false, models.ErrIdempotencyKeyInProgress
+		}
+		log.WithFields(log.Fields{
+			"user":      username,
+			"wallet":    walletId,
+			"method":    method,
+			"completed": existing.Completed,
+		}).Info("Claimed expired idempotency key again")
+		return nil, false, nil
+	} else if existing.Method != method || existing.RequestHash != claim.RequestHash {
+		log.WithFields(log.Fields{
+			"user":   username,
+			"wallet": walletId,
+			"method": method,
+		}).Info(models.ErrIdempotencyKeyReused)
+		return nil, false, models.ErrIdempotencyKeyReused
+	} else if !existing.Completed {
+		return nil, false, models.ErrIdempotencyKeyInProgress
+	} else {
+		return existing.Response, true, nil
+	}
+}
+
+func (m *manager) Complete(username, walletId, key string, response []byte) error {
+	return m.db.Repo.CompleteIdempotencyKey(m.db.DB, username, walletId, key, response)
+}
+
+func (m *manager) Release(username, walletId, key string) error {
+	return m.db.Repo.DeleteIdempotencyKey(m.db.DB, username, walletId, key)
+}
+
+// expired returns true if the key may be claimed again at time now, because its request has not completed within
+// claimTimeout, or completed more than retention ago.
+func expired(key *models.IdempotencyKey, now time.Time) bool {
+	if key.Completed {
+		return now.Sub(key.ClaimTime()) >= retention
+	}
+	return now.Sub(key.ClaimTime()) >= claimTimeout
+}
+
+// sweepExpiredKeys removes the keys that were claimed more than retention ago every sweepInterval. Keys that have
+// not completed by then have long been claimable again.
+func (m *manager) sweepExpiredKeys() {
+	for {
+		time.Sleep(sweepInterval)
+		if removed, err := m.db.Repo.DeleteExpiredIdempotencyKeys(m.db.DB, time.Now().UTC().Add(-retention)); err != nil {
+			log.WithError(err).Warn("Failed to remove expired idempotency keys")
+		} else if removed > 0 {
+			log.WithField("total", removed).Debug("Removed expired idempotency keys")
+		}
+	}
+}
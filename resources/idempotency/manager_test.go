@@ -0,0 +1,194 @@
+package idempotency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"gorm.io/gorm"
+)
+
+func TestIdempotencyManager(t *testing.T) {
+	suite.Run(t, new(idempotencyManagerSuite))
+}
+
+type idempotencyManagerSuite struct {
+	suite.Suite
+	mgr      Manager
+	mockRepo mockRepo
+}
+
+const (
+	username = "test-username"
+	walletId = "test-walletid"
+	key      = "test-key"
+	method   = "Xln.Transfer"
+)
+
+func (s *idempotencyManagerSuite) SetupTest() {
+	s.mockRepo = mockRepo{keys: make(map[string]*models.IdempotencyKey)}
+	s.mgr = NewManager(&db.DB{Repo: &s.mockRepo})
+}
+
+func (s *idempotencyManagerSuite) TestBeginClaimsUnusedKey() {
+	_, completed, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+	s.Require().False(completed)
+	s.Require().Len(s.mockRepo.keys, 1)
+}
+
+func (s *idempotencyManagerSuite) TestBeginReturnsStoredResponse() {
+	_, _, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+	s.Require().NoError(s.mgr.Complete(username, walletId, key, []byte("response")))
+
+	response, completed, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+	s.Require().True(completed)
+	s.Require().Equal([]byte("response"), response)
+}
+
+func (s *idempotencyManagerSuite) TestBeginReturnsEmptyStoredResponse() {
+	_, _, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+	s.Require().NoError(s.mgr.Complete(username, walletId, key, nil))
+
+	response, completed, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+	s.Require().True(completed, "responses with only default fields marshal to nothing, yet are still stored")
+	s.Require().Empty(response)
+}
+
+func (s *idempotencyManagerSuite) TestBeginRejectsRequestInProgress() {
+	_, _, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+
+	_, _, err = s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().Equal(models.ErrIdempotencyKeyInProgress, err)
+}
+
+func (s *idempotencyManagerSuite) TestBeginRejectsReusedKey() {
+	_, _, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+	s.Require().NoError(s.mgr.Complete(username, walletId, key, []byte("response")))
+
+	_, _, err = s.mgr.Begin(username, walletId, key, method, []byte("other request"))
+	s.Require().Equal(models.ErrIdempotencyKeyReused, err)
+	_, _, err = s.mgr.Begin(username, walletId, key, "Xln.CreateInvoice", []byte("request"))
+	s.Require().Equal(models.ErrIdempotencyKeyReused, err)
+}
+
+func (s *idempotencyManagerSuite) TestKeysAreScopedPerWallet() {
+	_, _, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+
+	_, completed, err := s.mgr.Begin(username, "other-walletid", key, method, []byte("request"))
+	s.Require().NoError(err)
+	s.Require().False(completed)
+}
+
+func (s *idempotencyManagerSuite) TestReleaseAllowsRetry() {
+	_, _, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+	s.Require().NoError(s.mgr.Release(username, walletId, key))
+
+	_, completed, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+	s.Require().False(completed)
+}
+
+func (s *idempotencyManagerSuite) TestBeginReclaimsAbandonedKey() {
+	_, _, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+	s.mockRepo.keys[mapKey(username, walletId, key)].ClaimedAt = time.Now().UTC().Add(-claimTimeout)
+
+	_, completed, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+	s.Require().False(completed, "requests that never completed are executed again")
+	_, _, err = s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().Equal(models.ErrIdempotencyKeyInProgress, err, "the key is claimed by the retry")
+}
+
+func (s *idempotencyManagerSuite) TestBeginReclaimsExpiredKey() {
+	_, _, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+	s.Require().NoError(s.mgr.Complete(username, walletId, key, []byte("response")))
+	stored := s.mockRepo.keys[mapKey(username, walletId, key)]
+
+	stored.ClaimedAt = time.Now().UTC().Add(-claimTimeout)
+	_, completed, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+	s.Require().True(completed, "responses are kept for longer than claims")
+
+	stored.ClaimedAt = time.Now().UTC().Add(-retention)
+	_, completed, err = s.mgr.Begin(username, walletId, key, method, []byte("other request"))
+	s.Require().NoError(err)
+	s.Require().False(completed, "expired keys may be used for any request")
+	s.Require().Nil(stored.Response)
+}
+
+func (s *idempotencyManagerSuite) TestKeysWithoutClaimTimeWereClaimedWhenCreated() {
+	_, _, err := s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().NoError(err)
+	stored := s.mockRepo.keys[mapKey(username, walletId, key)]
+	stored.ClaimedAt, stored.CreatedAt = time.Time{}, time.Now().UTC()
+
+	_, _, err = s.mgr.Begin(username, walletId, key, method, []byte("request"))
+	s.Require().Equal(models.ErrIdempotencyKeyInProgress, err)
+}
+
+type mockRepo struct {
+	models.Repository
+
+	keys map[string]*models.IdempotencyKey
+}
+
+func mapKey(username, walletId, key string) string {
+	return username + "/" + walletId + "/" + key
+}
+
+func (m *mockRepo) CreateIdempotencyKey(_ *gorm.DB, key *models.IdempotencyKey) error {
+	k := mapKey(key.Username, key.WalletID, key.Key)
+	if _, ok := m.keys[k]; ok {
+		return models.ErrIdempotencyKeyInUse
+	}
+	m.keys[k] = key
+	return nil
+}
+
+func (m *mockRepo) GetIdempotencyKey(_ *gorm.DB, username, walletId, key string) (*models.IdempotencyKey, error) {
+	if k, ok := m.keys[mapKey(username, walletId, key)]; ok {
+		return k, nil
+	}
+	return nil, models.ErrIdempotencyKeyNotFound
+}
+
+func (m *mockRepo) CompleteIdempotencyKey(_ *gorm.DB, username, walletId, key string, response []byte) error {
+	k, ok := m.keys[mapKey(username, walletId, key)]
+	if !ok {
+		return models.ErrIdempotencyKeyNotFound
+	}
+	k.Completed = true
+	k.Response = response
+	return nil
+}
+
+func (m *mockRepo) DeleteIdempotencyKey(_ *gorm.DB, username, walletId, key string) error {
+	if k, ok := m.keys[mapKey(username, walletId, key)]; ok && !k.Completed {
+		delete(m.keys, mapKey(username, walletId, key))
+	}
+	return nil
+}
+
+func (m *mockRepo) ReclaimIdempotencyKey(_ *gorm.DB, key *models.IdempotencyKey,
+	previous *models.IdempotencyKey) (bool, error) {
+	k, ok := m.keys[mapKey(key.Username, key.WalletID, key.Key)]
+	if !ok || !k.ClaimedAt.Equal(previous.ClaimedAt) || k.Completed != previous.Completed {
+		return false, nil
+	}
+	k.Method, k.RequestHash, k.ClaimedAt = key.Method, key.RequestHash, key.ClaimedAt
+	k.Completed, k.Response = false, nil
+	return true, nil
+}
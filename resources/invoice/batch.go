@@ -0,0 +1,249 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/models"
+	"gorm.io/gorm"
+)
+
+// maximum number of invoices that may be paid in a batch
+const maxBatchPayments = 100
+
+// maximum number of the payments of a batch that are in flight at once
+var batchPaymentConcurrency = 8
+
+// BatchPaymentResult is the outcome of paying one of the invoices of a batch.
+type BatchPaymentResult struct {
+	// position of the invoice in the batch
+	Index          int
+	PaymentRequest string
+	// nil if the payment could not be made
+	Payment *Payment
+	Err     error
+}
+
+// batchItem is an invoice of a batch. Payments of invoices of wallets on this node are settled when the batch is
+// reserved, and the funds and routing fees of the others are reserved by a pending payment until they complete.
+type batchItem struct {
+	index    int
+	pr       string
+	payreq   *lnrpc.PayReq
+	internal *models.PendingInvoice
+	// transaction of the payment of an internal invoice
+	settled *models.Transaction
+	pending *models.PendingPayment
+}
+
+func (m *manager) BatchPayInvoices(username, walletId string, prs []string,
+	options *PaymentOptions) (<-chan *BatchPaymentResult, error) {
+	if len(prs) == 0 {
+		return nil, errors.New("at least one payment request is required")
+	} else if len(prs) > maxBatchPayments {
+		return nil, fmt.Errorf("at most %d invoices may be paid in a batch", maxBatchPayments)
+	}
+	wallet, err := m.getAndValidateWallet(username, walletId)
+	if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"username": username,
+			"walletId": walletId,
+		}).Warn("BatchPayInvoices failed")
+		return nil, err
+	}
+	items, err := m.decodeBatch(prs)
+	if err != nil {
+		return nil, err
+	}
+	defaults, err := m.db.Repo.GetPaymentDefaults(m.db.DB, wallet.Username)
+	if err != nil {
+		return nil, err
+	}
+	options, err = m.paymentBounds.resolve(options, defaults)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.reserveBatch(wallet, items, options); err != nil {
+		return nil, err
+	}
+	log.WithFields(log.Fields{
+		"user":     wallet.Username,
+		"wallet":   wallet.ID,
+		"invoices": len(items),
+	}).Info("processing batch payment")
+
+	results := make(chan *BatchPaymentResult, len(items))
+	go m.payBatch(wallet, items, options, results)
+	return results, nil
+}
+
+// decodeBatch decodes the payment requests of a batch, and errors if any of them cannot be paid.
+func (m *manager) decodeBatch(prs []string) ([]*batchItem, error) {
+	items := make([]*batchItem, len(prs))
+	hashes := make(map[string]struct{}, len(prs))
+	for i, pr := range prs {
+		payreq, err := m.lnClient.DecodePayReq(context.Background(), &lnrpc.PayReqString{PayReq: pr})
+		if err != nil {
+			log.WithError(err).WithField("pr", pr).Warn("BatchPayInvoices called with invalid payment request format")
+			return nil, fmt.Errorf("invalid payment request format at index %d", i)
+		} else if payreq.NumMsat <= 0 {
+			return nil, fmt.Errorf("payment request at index %d does not specify an amount", i)
+		} else if payreq.NumMsat > m.maxPayment {
+			return nil, fmt.Errorf("size %d msat of payment request at index %d is greater than the maximum payment size",
+				payreq.NumMsat, i)
+		} else if _, duplicate := hashes[payreq.PaymentHash]; duplicate {
+			return nil, fmt.Errorf("payment request at index %d is already in the batch", i)
+		}
+		hashes[payreq.PaymentHash] = struct{}{}
+		internal, err := m.getInternalInvoice(payreq)
+		if err != nil {
+			return nil, fmt.Errorf("payment request at index %d cannot be paid: %v", i, err)
+		}
+		items[i] = &batchItem{index: i, pr: pr, payreq: payreq, internal: internal}
+	}
+	return items, nil
+}
+
+// reserveBatch checks that the confirmed balance of the wallet covers the amounts and service fees of the batch, and
+// that the batch does not violate the wallet's spending policy, within a single DB transaction. The transaction
+// settles the payments of internal invoices, and reserves the funds of the payments sent over lightning together
+// with their routing fees, which are limited to an even share of the balance that is left after the batch.
+func (m *manager) reserveBatch(wal *models.Wallet, items []*batchItem, options *PaymentOptions) error {
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		cBal, err := m.db.Repo.GetConfirmedBalance(tx, wal.Username, wal.ID)
+		if err != nil {
+			return err
+		}
+		fees := make([]uint64, len(items))
+		var total, external uint64
+		for i, item := range items {
+			amount := uint64(item.payreq.NumMsat)
+			kind := models.FeeKindExternal
+			if item.internal != nil {
+				kind = models.FeeKindInternal
+			} else {
+				external++
+			}
+			if fees[i], err = m.wallets.ServiceFee(tx, wal.Username, wal.ID, kind, amount); err != nil {
+				return err
+			}
+			total += amount + fees[i]
+		}
+		if cBal < total {
+			log.WithFields(log.Fields{
+				"user":   wal.Username,
+				"wallet": wal.ID,
+				"total":  total,
+			}).Warn("Wallet attempted batch payment with insufficient funds")
+			return errors.New("insufficient funds")
+		}
+		var routingFeeBudget uint64
+		if external > 0 {
+			routingFeeBudget = (cBal - total) / external
+		}
+
+		for i, item := range items {
+			amount := uint64(item.payreq.NumMsat)
+			if item.internal != nil {
+				// settling the payment counts it towards the spending policy of the payments after it
+				item.settled, err = m.settleSelfPayment(tx, wal.Username, wal.ID, item.internal.WalletUsername,
+					item.internal.WalletID, item.internal.PaymentHash, item.payreq.Destination, int64(amount))
+				if err != nil {
+					return fmt.Errorf("payment request at index %d cannot be paid: %w", item.index, err)
+				}
+				continue
+			}
+			if err := m.wallets.CheckSpendingPolicy(tx, wal.Username, wal.ID, &item.payreq.Destination, nil,
+				amount); err != nil {
+				return fmt.Errorf("payment request at index %d cannot be paid: %w", item.index, err)
+			}
+			feeReserve := options.feeLimit(amount)
+			if routingFeeBudget < feeReserve {
+				feeReserve = routingFeeBudget
+			}
+			item.pending = &models.PendingPayment{
+				WalletID:       wal.ID,
+				WalletUsername: wal.Username,
+				PaymentHash:    item.payreq.PaymentHash,
+				Amount:         amount,
+				ServiceFee:     fees[i],
+				FeeReserve:     feeReserve,
+			}
+			if err := m.db.Repo.CreatePendingPayment(tx, item.pending); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// payBatch pays the invoices of a batch, of which at most batchPaymentConcurrency are in flight at once, and sends
+// the result of each once it completes. results is closed once all of the invoices have been paid.
+func (m *manager) payBatch(wal *models.Wallet, items []*batchItem, options *PaymentOptions,
+	results chan<- *BatchPaymentResult) {
+	defer close(results)
+	var wg sync.WaitGroup
+	inFlight := make(chan struct{}, batchPaymentConcurrency)
+	for _, item := range items {
+		wg.Add(1)
+		inFlight <- struct{}{}
+		go func(item *batchItem) {
+			defer wg.Done()
+			results <- m.payBatchItem(wal, item, options)
+			<-inFlight
+		}(item)
+	}
+	wg.Wait()
+}
+
+// payBatchItem pays an invoice of a batch, and waits for the payment to complete. The payments of internal invoices
+// were already settled when the batch was reserved.
+func (m *manager) payBatchItem(wal *models.Wallet, item *batchItem, options *PaymentOptions) *BatchPaymentResult {
+	result := &BatchPaymentResult{Index: item.index, PaymentRequest: item.pr}
+	amount := item.payreq.NumMsat
+	if item.internal != nil {
+		m.publishSelfPayment(item.settled)
+		result.Payment = &Payment{Success: true, AmountMsat: uint64(amount)}
+		return result
+	}
+
+	req := &routerrpc.SendPaymentRequest{PaymentRequest: item.pr}
+	options.apply(req, uint64(amount), uint64(amount)+item.pending.FeeReserve)
+	stream, err := m.routerClient.SendPaymentV2(context.Background(), req)
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"wallet": wal.ID,
+			"hash":   item.pending.PaymentHash,
+		}).Warn("Failed to send a payment of a batch")
+		if err := m.db.Repo.DeletePendingPayment(m.db.DB, item.pending); err != nil {
+			log.WithError(err).WithField("hash", item.pending.PaymentHash).Error("Failed to release reserved funds")
+		}
+		result.Err = fmt.Errorf("error sending payment: %v", err)
+		return result
+	}
+	m.pendingPaymentCache.SetDefault(item.pending.PaymentHash, item.pending)
+	err = m.db.Repo.CreateInvoice(m.db.DB, &models.Invoice{
+		PaymentHash:    item.pending.PaymentHash,
+		Amount:         uint64(amount),
+		PaymentRequest: item.pr,
+		Pubkey:         item.payreq.Destination,
+		SenderID:       &wal.ID,
+		SenderUsername: &wal.Username,
+		Timestamp:      time.Now().UTC(),
+	})
+	if err != nil {
+		log.WithError(err).WithField("hash", item.pending.PaymentHash).Error("Failed to record invoice of batch payment")
+	}
+	result.Payment = m.trackPendingPayment(item.pending.PaymentHash)
+	return result
+}
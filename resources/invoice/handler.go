@@ -1,17 +1,22 @@
 package invoice
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"io"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	log "github.com/sirupsen/logrus"
 	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/resources/events"
+	"github.com/xbit-gg/xln/resources/webhook"
 	"gorm.io/gorm"
 )
 
@@ -38,10 +43,18 @@ func (m *manager) handleStaleInvoices() {
 	if err != nil {
 		log.WithError(err).Fatal("Failed to get pending invoices from DB")
 	}
-	if len(pendingInvoices) > 0 {
-		log.WithField("amount", len(pendingInvoices)).Info("Handling invoice updates that occurred while XLN was offline")
-	}
+	// invoices that have expired are left to the sweeper, which resolves them without delay
+	expiredBefore := time.Now().UTC().Add(-expiryGracePeriod)
+	var stale []*models.PendingInvoice
 	for _, pi := range pendingInvoices {
+		if pi.Accepted || pi.ExpiresAt == nil || !pi.ExpiresAt.Before(expiredBefore) {
+			stale = append(stale, pi)
+		}
+	}
+	if len(stale) > 0 {
+		log.WithField("amount", len(stale)).Info("Handling invoice updates that occurred while XLN was offline")
+	}
+	for _, pi := range stale {
 		m.pendingInvoiceCache.SetDefault(pi.PaymentHash, pi)
 		paymentHash, err := base64.StdEncoding.DecodeString(pi.PaymentHash)
 		if err != nil {
@@ -52,7 +65,7 @@ func (m *manager) handleStaleInvoices() {
 		if err != nil {
 			log.WithError(err).Fatal("Failed to lookup stale invoice")
 		}
-		m.finalizeInvoice(invoice)
+		m.handleInvoiceUpdate(invoice)
 
 		// Prevent overloading LND
 		// For some reason, LND will return "could not locate invoice" when sending requests too fast
@@ -61,7 +74,19 @@ func (m *manager) handleStaleInvoices() {
 }
 
 func (m *manager) trackPendingInvoices() {
-	stream, err := m.lnClient.SubscribeInvoices(context.Background(), &lnrpc.InvoiceSubscription{AddIndex: 0})
+	// Replay the invoices that settled after the last one processed, which includes those settled while XLN was
+	// offline. Replayed invoices that were already processed are skipped.
+	settleIndex, err := m.db.Repo.GetLastSettleIndex(m.db.DB)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to get last settle index from DB")
+	} else if settleIndex == 0 {
+		// LND only replays the invoices that settled after a settle index above 0
+		settleIndex = m.catchUpSettledInvoices()
+	}
+	stream, err := m.lnClient.SubscribeInvoices(context.Background(), &lnrpc.InvoiceSubscription{
+		AddIndex:    0,
+		SettleIndex: settleIndex,
+	})
 	if err != nil {
 		log.WithError(err).Fatal("Failed to subscribe to invoice events")
 	}
@@ -77,10 +102,118 @@ func (m *manager) trackPendingInvoices() {
 			"paymentHash": base64.StdEncoding.EncodeToString(invoice.RHash),
 			"state":       invoice.State.String(),
 		}).Debug("Received invoice event")
-		if invoice.State == lnrpc.Invoice_SETTLED || invoice.State == lnrpc.Invoice_CANCELED {
-			m.finalizeInvoice(invoice)
+		m.handleInvoiceUpdate(invoice)
+		// invoices are only recorded as processed once they have been, so that they are replayed otherwise
+		m.advanceSettleCursor(settleIndexOf(invoice))
+	}
+}
+
+// catchUpSettledInvoices processes every settled invoice of LND, and returns the highest settle index of them.
+func (m *manager) catchUpSettledInvoices() uint64 {
+	var settleIndex, offset uint64
+	for {
+		res, err := m.lnClient.ListInvoices(context.Background(), &lnrpc.ListInvoiceRequest{
+			IndexOffset:    offset,
+			NumMaxInvoices: 100,
+		})
+		if err != nil {
+			log.WithError(err).Fatal("Failed to list invoices to catch up on")
+		} else if len(res.Invoices) == 0 {
+			break
+		}
+		for _, invoice := range res.Invoices {
+			if index := settleIndexOf(invoice); index > 0 {
+				m.handleInvoiceUpdate(invoice)
+				if index > settleIndex {
+					settleIndex = index
+				}
+			}
+		}
+		offset = res.LastIndexOffset
+	}
+	if settleIndex > 0 {
+		log.WithField("settleIndex", settleIndex).Info("Caught up on invoices that settled before XLN tracked them")
+		m.advanceSettleCursor(settleIndex)
+	}
+	return settleIndex
+}
+
+// advanceSettleCursor records that the invoices up to settleIndex have been processed. A cursor that is not
+// advanced only replays invoices, which is why failures are not fatal.
+func (m *manager) advanceSettleCursor(settleIndex uint64) {
+	if settleIndex == 0 {
+		return
+	} else if err := m.db.Repo.SetLastSettleIndex(m.db.DB, settleIndex); err != nil {
+		log.WithError(err).WithField("settleIndex", settleIndex).Warn("Failed to advance settle cursor")
+	}
+}
+
+// settleIndexOf returns the highest settle index of the invoice, which is that of its latest payment if it is an AMP
+// invoice, or 0 if it has not settled.
+func settleIndexOf(invoice *lnrpc.Invoice) uint64 {
+	settleIndex := invoice.SettleIndex
+	for _, state := range invoice.AmpInvoiceState {
+		if state.State == lnrpc.InvoiceHTLCState_SETTLED && state.SettleIndex > settleIndex {
+			settleIndex = state.SettleIndex
+		}
+	}
+	return settleIndex
+}
+
+// handleInvoiceUpdate finalizes invoices that were settled or cancelled, and records the payments of hold invoices
+// that were accepted. Invoices that are still open are left pending.
+func (m *manager) handleInvoiceUpdate(invoice *lnrpc.Invoice) {
+	// AMP invoices remain open, and are settled once per payment made to them
+	if invoice.State == lnrpc.Invoice_SETTLED || invoice.State == lnrpc.Invoice_CANCELED || invoice.IsAmp {
+		m.finalizeInvoice(invoice)
+	} else if invoice.State == lnrpc.Invoice_ACCEPTED {
+		m.acceptHoldInvoice(invoice)
+	}
+}
+
+// acceptHoldInvoice records that a hold invoice has been paid, and notifies the wallet that it may settle it.
+func (m *manager) acceptHoldInvoice(invoice *lnrpc.Invoice) {
+	paymentHash := base64.StdEncoding.EncodeToString(invoice.RHash)
+	var pendingInvoice *models.PendingInvoice
+	if pi, contains := m.pendingInvoiceCache.Get(paymentHash); contains {
+		pendingInvoice = pi.(*models.PendingInvoice)
+	} else {
+		var err error
+		pendingInvoice, err = m.db.Repo.GetPendingInvoice(m.db.DB, paymentHash)
+		if err != nil {
+			log.WithError(err).WithField("hash", paymentHash).Debug("Accepted invoice not associated with XLN")
+			return
+		}
+	}
+	if !pendingInvoice.Hold || pendingInvoice.Accepted {
+		return
+	}
+
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		if err := m.db.Repo.AcceptPendingInvoice(tx, paymentHash); err != nil {
+			return err
 		}
+		return m.webhooks.Enqueue(tx, pendingInvoice.WalletUsername, pendingInvoice.WalletID,
+			webhook.EventInvoiceAccepted, &webhook.InvoiceData{
+				PaymentHash: paymentHash,
+				AmountMsat:  uint64(invoice.AmtPaidMsat),
+			})
+	})
+	if err != nil {
+		log.WithError(err).Fatal("Failed to record accepted hold invoice")
 	}
+	pendingInvoice.Accepted = true
+	m.events.Publish(&events.Event{
+		Type:        events.EventInvoiceAccepted,
+		Username:    pendingInvoice.WalletUsername,
+		WalletID:    pendingInvoice.WalletID,
+		PaymentHash: paymentHash,
+		AmountMsat:  uint64(invoice.AmtPaidMsat),
+	})
+	log.WithFields(log.Fields{
+		"hash":   paymentHash,
+		"wallet": pendingInvoice.WalletID,
+	}).Debug("Hold invoice accepted")
 }
 
 func (m *manager) trackPendingPayment(paymentHash string) *Payment {
@@ -97,7 +230,12 @@ func (m *manager) trackPendingPayment(paymentHash string) *Payment {
 		log.WithError(err).Fatal("Failed to track outgoing payment")
 	}
 	lnPayment, err := stream.Recv()
-	if err != nil {
+	if err != nil && strings.Contains(err.Error(), lndMsgSubstrPaymentNotInitiated) {
+		// funds were reserved for the payment, but XLN stopped before sending it
+		log.WithField("hash", paymentHash).Warn("Pending payment was never sent")
+		m.finalizePayment(paymentHash, false, 0, "payment was not sent")
+		return &Payment{PaymentHash: paymentHash, FailureReason: "payment was not sent"}
+	} else if err != nil {
 		log.WithError(err).Fatal("Failed to track outgoing payment")
 	}
 	log.WithFields(log.Fields{
@@ -106,12 +244,14 @@ func (m *manager) trackPendingPayment(paymentHash string) *Payment {
 		"status": lnPayment.Status.String(),
 		"reason": lnPayment.FailureReason.String(),
 	}).Debug("Pending payment update")
-	m.finalizePayment(paymentHash, lnPayment.Status == lnrpc.Payment_SUCCEEDED, lnPayment.FeeMsat)
 	var failureReason string
 	if lnPayment.FailureReason != lnrpc.PaymentFailureReason_FAILURE_REASON_NONE {
 		failureReason = lnPayment.FailureReason.String()
 	}
+	m.finalizePayment(paymentHash, lnPayment.Status == lnrpc.Payment_SUCCEEDED, lnPayment.FeeMsat, failureReason)
 	return &Payment{
+		PaymentHash:   lnPayment.PaymentHash,
+		Preimage:      lnPayment.PaymentPreimage,
 		Success:       lnPayment.Status == lnrpc.Payment_SUCCEEDED,
 		AmountMsat:    uint64(lnPayment.ValueMsat),
 		FeeMsat:       uint64(lnPayment.FeeMsat),
@@ -119,7 +259,7 @@ func (m *manager) trackPendingPayment(paymentHash string) *Payment {
 	}
 }
 
-func (m *manager) finalizePayment(paymentHash string, success bool, feesPaid int64) {
+func (m *manager) finalizePayment(paymentHash string, success bool, feesPaid int64, failureReason string) {
 	var pendingPayment *models.PendingPayment
 	if pp, contains := m.pendingPaymentCache.Get(paymentHash); contains {
 		pendingPayment = pp.(*models.PendingPayment)
@@ -133,6 +273,7 @@ func (m *manager) finalizePayment(paymentHash string, success bool, feesPaid int
 		}
 	}
 
+	var walTx *models.Transaction
 	err := m.db.Transaction(func(tx *gorm.DB) error {
 		// Clear pending payment
 
@@ -140,23 +281,23 @@ func (m *manager) finalizePayment(paymentHash string, success bool, feesPaid int
 			return err
 		}
 		if !success {
-			return nil
+			return m.webhooks.Enqueue(tx, pendingPayment.WalletUsername, pendingPayment.WalletID,
+				webhook.EventPaymentFailed, &webhook.PaymentData{
+					PaymentHash:   paymentHash,
+					AmountMsat:    pendingPayment.Amount,
+					FailureReason: failureReason,
+				})
 		}
-		err := m.db.Repo.DecrementWalletBalance(tx, pendingPayment.WalletUsername,
-			pendingPayment.WalletID, pendingPayment.Amount-uint64(feesPaid))
-		if err != nil {
-			return err
-		}
-
 		paymentTime := time.Now().UTC()
 		// Record transaction
-		walTx := &models.Transaction{
+		walTx = &models.Transaction{
 			FromID:       &pendingPayment.WalletID,
 			FromUsername: &pendingPayment.WalletUsername,
 			ToID:         nil,
 			ToUsername:   nil,
 			Amount:       pendingPayment.Amount,
 			FeesPaid:     uint64(feesPaid),
+			ServiceFee:   pendingPayment.ServiceFee,
 			InvoiceID:    &paymentHash,
 			UpdatedAt:    paymentTime,
 		}
@@ -167,6 +308,14 @@ func (m *manager) finalizePayment(paymentHash string, success bool, feesPaid int
 				"fromUsername": pendingPayment.WalletUsername,
 			}).Error("Failed to create transaction when finalizing payment")
 			return err
+		} else if err := m.db.Repo.PostJournal(tx, &walTx.ID,
+			models.WalletDebit(pendingPayment.WalletUsername, pendingPayment.WalletID,
+				pendingPayment.Amount+uint64(feesPaid)+pendingPayment.ServiceFee),
+			models.AccountCredit(models.AccountLightningOutbound, pendingPayment.Amount),
+			models.AccountCredit(models.AccountRoutingFees, uint64(feesPaid)),
+			m.wallets.ServiceFeeCredit(pendingPayment.ServiceFee),
+		); err != nil {
+			return err
 		} else if err := m.db.Repo.UpdateInvoiceSettleTime(tx, paymentHash, &paymentTime); err != nil {
 			log.WithError(err).WithFields(log.Fields{
 				"InvoiceID":    paymentHash,
@@ -175,11 +324,30 @@ func (m *manager) finalizePayment(paymentHash string, success bool, feesPaid int
 			}).Error("Failed to update invoice")
 			return err
 		}
-		return nil
+		return m.webhooks.Enqueue(tx, pendingPayment.WalletUsername, pendingPayment.WalletID,
+			webhook.EventPaymentSucceeded, &webhook.PaymentData{
+				PaymentHash: paymentHash,
+				AmountMsat:  pendingPayment.Amount,
+				FeeMsat:     uint64(feesPaid),
+			})
 	})
 	if err != nil {
 		log.WithError(err).Fatal("Failed to update wallet balance after completed payment")
 	}
+	event := &events.Event{
+		Type:          events.EventPaymentFailed,
+		Username:      pendingPayment.WalletUsername,
+		WalletID:      pendingPayment.WalletID,
+		PaymentHash:   paymentHash,
+		AmountMsat:    pendingPayment.Amount,
+		FailureReason: failureReason,
+	}
+	if success {
+		event.Type = events.EventPaymentSucceeded
+		event.Transaction = walTx
+		event.FeeMsat = uint64(feesPaid)
+	}
+	m.events.Publish(event)
 	log.WithFields(log.Fields{
 		"hash":   pendingPayment.PaymentHash,
 		"wallet": pendingPayment.WalletID,
@@ -196,7 +364,12 @@ func (m *manager) finalizeInvoice(invoice *lnrpc.Invoice) {
 		log.WithField("hash", paymentHash).Debug("Cache miss for pending invoice when finalizing")
 		var err error
 		pendingInvoice, err = m.db.Repo.GetPendingInvoice(m.db.DB, paymentHash)
-		if err != nil {
+		if err != nil && (invoice.IsKeysend || invoice.IsAmp) {
+			for _, payment := range spontaneousPayments(invoice) {
+				m.creditSpontaneousPayment(payment)
+			}
+			return
+		} else if err != nil {
 			log.WithError(err).WithField("hash", paymentHash).Debug("Finalized invoice not associated with XLN")
 			return
 		}
@@ -207,6 +380,7 @@ func (m *manager) finalizeInvoice(invoice *lnrpc.Invoice) {
 		"paymentHash": pendingInvoice.PaymentHash,
 	}).Debug("finalizing invoice")
 
+	var walTx *models.Transaction
 	err := m.db.Transaction(func(tx *gorm.DB) error {
 		// Clear pending invoice
 		res := tx.Delete(pendingInvoice)
@@ -214,24 +388,22 @@ func (m *manager) finalizeInvoice(invoice *lnrpc.Invoice) {
 			return res.Error
 		}
 		if invoice.State != lnrpc.Invoice_SETTLED {
-			return nil
-		}
-
-		// Update wallet balance
-		wal := &models.Wallet{}
-		wal, err := m.db.Repo.GetWallet(m.db.DB, pendingInvoice.WalletUsername, pendingInvoice.WalletID)
-		if err != nil {
-			return err
+			if err := m.db.Repo.UpdateInvoicesCancelTime(tx, []string{paymentHash}, time.Now().UTC()); err != nil {
+				return err
+			}
+			return m.webhooks.Enqueue(tx, pendingInvoice.WalletUsername, pendingInvoice.WalletID,
+				webhook.EventInvoiceCancelled, &webhook.InvoiceData{
+					PaymentHash: paymentHash,
+					AmountMsat:  pendingInvoice.Amount,
+				})
 		}
-		wal.Balance = wal.Balance + uint64(invoice.AmtPaidMsat)
-		tx.Save(wal)
 
 		// Record transaction
-		walTx := &models.Transaction{
+		walTx = &models.Transaction{
 			FromID:       nil,
 			FromUsername: nil,
-			ToID:         &wal.ID,
-			ToUsername:   &wal.Username,
+			ToID:         &pendingInvoice.WalletID,
+			ToUsername:   &pendingInvoice.WalletUsername,
 			Amount:       uint64(invoice.AmtPaidMsat),
 			FeesPaid:     0,
 			InvoiceID:    &paymentHash,
@@ -241,65 +413,300 @@ func (m *manager) finalizeInvoice(invoice *lnrpc.Invoice) {
 			return err
 		}
 
-		return nil
+		// Update wallet balance
+		err := m.db.Repo.PostJournal(tx, &walTx.ID,
+			models.AccountDebit(models.AccountLightningInbound, uint64(invoice.AmtPaidMsat)),
+			models.WalletCredit(pendingInvoice.WalletUsername, pendingInvoice.WalletID, uint64(invoice.AmtPaidMsat)),
+		)
+		if err != nil {
+			return err
+		}
+
+		return m.webhooks.Enqueue(tx, pendingInvoice.WalletUsername, pendingInvoice.WalletID, webhook.EventInvoiceSettled, &webhook.InvoiceData{
+			PaymentHash: paymentHash,
+			AmountMsat:  uint64(invoice.AmtPaidMsat),
+		})
 	})
 	if err != nil {
 		log.WithError(err).Fatal("Failed to update wallet balance after finalized invoice")
 	}
+	event := &events.Event{
+		Type:        events.EventInvoiceCancelled,
+		Username:    pendingInvoice.WalletUsername,
+		WalletID:    pendingInvoice.WalletID,
+		PaymentHash: paymentHash,
+		AmountMsat:  pendingInvoice.Amount,
+	}
+	if invoice.State == lnrpc.Invoice_SETTLED {
+		event.Type = events.EventInvoiceSettled
+		event.Transaction = walTx
+		event.AmountMsat = uint64(invoice.AmtPaidMsat)
+	}
+	m.events.Publish(event)
 	log.WithFields(log.Fields{
 		"hash":   pendingInvoice.PaymentHash,
 		"wallet": pendingInvoice.WalletID,
 	}).Debug("Invoice finalized")
 }
 
-func (m *manager) handleSelfPayments(sUsername, sId, rUsername, rId string, payHash string, amount int64) error {
-	err := m.db.Transaction(func(tx *gorm.DB) error {
-		if cBal, err := m.db.Repo.GetConfirmedBalance(tx, sUsername, sId); err != nil {
-			return err
-		} else if cBal < uint64(amount) {
-			log.WithFields(log.Fields{
-				"user":        sUsername,
-				"wallet":      sId,
-				"paymentHash": payHash,
-			}).Warn("Wallet attempted payment with insufficient funds")
-			return errors.New("insufficient funds")
-		}
+// RoutingTagRecordType is the custom record that carries the routing tag of the wallet that an incoming keysend or
+// AMP payment is for.
+const RoutingTagRecordType uint64 = 696969
 
-		if err := m.db.Repo.SetInvoiceSenderAmount(tx, payHash, sUsername, sId, amount); err != nil {
-			return err
+// spontaneousPayment is a settled keysend payment, or a settled payment to an AMP invoice.
+type spontaneousPayment struct {
+	// base64 payment hash of a keysend payment, or base64 set id of an AMP payment
+	id          string
+	amount      uint64
+	preimage    *string
+	settleIndex uint64
+	routingTag  string
+}
+
+// spontaneousPayments returns the settled payments of a keysend or AMP invoice, in the order they settled.
+func spontaneousPayments(invoice *lnrpc.Invoice) []*spontaneousPayment {
+	var payments []*spontaneousPayment
+	if invoice.IsKeysend && invoice.State == lnrpc.Invoice_SETTLED {
+		preimage := hex.EncodeToString(invoice.RPreimage)
+		payments = append(payments, &spontaneousPayment{
+			id:          base64.StdEncoding.EncodeToString(invoice.RHash),
+			amount:      uint64(invoice.AmtPaidMsat),
+			preimage:    &preimage,
+			settleIndex: invoice.SettleIndex,
+			routingTag:  routingTag(invoice.Htlcs),
+		})
+	} else if invoice.IsAmp {
+		for setIdHex, state := range invoice.AmpInvoiceState {
+			setId, err := hex.DecodeString(setIdHex)
+			if err != nil || state.State != lnrpc.InvoiceHTLCState_SETTLED {
+				continue
+			}
+			var htlcs []*lnrpc.InvoiceHTLC
+			for _, htlc := range invoice.Htlcs {
+				if htlc.Amp != nil && bytes.Equal(htlc.Amp.SetId, setId) {
+					htlcs = append(htlcs, htlc)
+				}
+			}
+			payments = append(payments, &spontaneousPayment{
+				id:          base64.StdEncoding.EncodeToString(setId),
+				amount:      uint64(state.AmtPaidMsat),
+				settleIndex: state.SettleIndex,
+				routingTag:  routingTag(htlcs),
+			})
 		}
-		err := m.db.Repo.CreateTransaction(tx, &models.Transaction{
-			FromID:       &sId,
-			FromUsername: &sUsername,
-			ToID:         &rId,
-			ToUsername:   &rUsername,
-			Amount:       uint64(amount),
-			FeesPaid:     0,
-			InvoiceID:    &payHash,
-			UpdatedAt:    time.Now().UTC(),
+		sort.Slice(payments, func(i, j int) bool {
+			return payments[i].settleIndex < payments[j].settleIndex
 		})
-		if err != nil {
-			return err
+	}
+	return payments
+}
+
+// routingTag returns the routing tag carried by the settled htlcs, or an empty string if there is none.
+func routingTag(htlcs []*lnrpc.InvoiceHTLC) string {
+	for _, htlc := range htlcs {
+		if tag, ok := htlc.CustomRecords[RoutingTagRecordType]; ok && htlc.State == lnrpc.InvoiceHTLCState_SETTLED {
+			return string(tag)
 		}
-		if err := m.db.Repo.DeletePendingInvoice(tx, payHash); err != nil {
+	}
+	return ""
+}
+
+// creditSpontaneousPayment credits a spontaneous payment to the wallet with its routing tag. Payments without a
+// known routing tag are left uncredited, and appear as surplus of the node.
+func (m *manager) creditSpontaneousPayment(payment *spontaneousPayment) {
+	if _, err := m.db.Repo.GetInvoice(m.db.DB, payment.id); err == nil {
+		log.WithField("hash", payment.id).Debug("Spontaneous payment already credited")
+		return
+	} else if err != models.ErrInvoiceNotFound {
+		log.WithError(err).Fatal("Failed to lookup spontaneous payment")
+	}
+	if payment.routingTag == "" {
+		log.WithFields(log.Fields{
+			"hash":   payment.id,
+			"amount": payment.amount,
+		}).Warn("Received spontaneous payment without a routing tag")
+		return
+	}
+	wallet, err := m.db.Repo.GetWalletWithRoutingTag(m.db.DB, payment.routingTag)
+	if err == models.ErrRoutingTagNotFound {
+		log.WithFields(log.Fields{
+			"hash":   payment.id,
+			"amount": payment.amount,
+			"tag":    payment.routingTag,
+		}).Warn("Received spontaneous payment with an unknown routing tag")
+		return
+	} else if err != nil {
+		log.WithError(err).Fatal("Failed to lookup wallet of spontaneous payment")
+	}
+
+	settleTime := time.Now().UTC()
+	walTx := &models.Transaction{
+		ToID:       &wallet.ID,
+		ToUsername: &wallet.Username,
+		Amount:     payment.amount,
+		InvoiceID:  &payment.id,
+		UpdatedAt:  settleTime,
+	}
+	err = m.db.Transaction(func(tx *gorm.DB) error {
+		if err := m.db.Repo.CreateInvoice(tx, &models.Invoice{
+			PaymentHash:       payment.id,
+			Timestamp:         settleTime,
+			Preimage:          payment.preimage,
+			Settled:           settleTime,
+			Amount:            payment.amount,
+			RecipientID:       &wallet.ID,
+			RecipientUsername: &wallet.Username,
+			SettleIndex:       payment.settleIndex,
+		}); err != nil {
 			return err
-		}
-		if err := m.db.Repo.IncrementWalletBalance(tx, rUsername, rId, uint64(amount)); err != nil {
+		} else if err := m.db.Repo.CreateTransaction(tx, walTx); err != nil {
 			return err
-		}
-		if err := m.db.Repo.DecrementWalletBalance(tx, sUsername, sId, uint64(amount)); err != nil {
+		} else if err := m.db.Repo.PostJournal(tx, &walTx.ID,
+			models.AccountDebit(models.AccountLightningInbound, payment.amount),
+			models.WalletCredit(wallet.Username, wallet.ID, payment.amount),
+		); err != nil {
 			return err
 		}
+		return m.webhooks.Enqueue(tx, wallet.Username, wallet.ID, webhook.EventInvoiceSettled, &webhook.InvoiceData{
+			PaymentHash: payment.id,
+			AmountMsat:  payment.amount,
+		})
+	})
+	if err != nil {
+		log.WithError(err).Fatal("Failed to update wallet balance after spontaneous payment")
+	}
+	m.events.Publish(&events.Event{
+		Type:        events.EventInvoiceSettled,
+		Username:    wallet.Username,
+		WalletID:    wallet.ID,
+		Transaction: walTx,
+		PaymentHash: payment.id,
+		AmountMsat:  payment.amount,
+	})
+	log.WithFields(log.Fields{
+		"hash":   payment.id,
+		"wallet": wallet.ID,
+	}).Debug("Spontaneous payment credited")
+}
+
+// recipientOf returns the recipient that spending policies limit when a wallet of sender pays an invoice of a wallet
+// of receiver on this node, which is nil if the wallets belong to the same user.
+func recipientOf(sender, receiver string) *string {
+	if sender == receiver {
 		return nil
+	}
+	return &receiver
+}
+
+func (m *manager) handleSelfPayments(sUsername, sId, rUsername, rId string, payHash, destination string,
+	amount int64) error {
+	var walTx *models.Transaction
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		walTx, err = m.settleSelfPayment(tx, sUsername, sId, rUsername, rId, payHash, destination, amount)
+		return err
 	})
 	if err != nil {
 		return err
 	}
+	m.publishSelfPayment(walTx)
+	return nil
+}
+
+// settleSelfPayment pays the invoice of a wallet on this node from another wallet within tx, and returns the
+// transaction of the payment, which publishSelfPayment notifies the wallets of once tx commits.
+func (m *manager) settleSelfPayment(tx *gorm.DB, sUsername, sId, rUsername, rId string, payHash, destination string,
+	amount int64) (*models.Transaction, error) {
+	walTx := &models.Transaction{
+		FromID:       &sId,
+		FromUsername: &sUsername,
+		ToID:         &rId,
+		ToUsername:   &rUsername,
+		Amount:       uint64(amount),
+		FeesPaid:     0,
+		InvoiceID:    &payHash,
+		UpdatedAt:    time.Now().UTC(),
+	}
+	fee, err := m.wallets.ServiceFee(tx, sUsername, sId, models.FeeKindInternal, uint64(amount))
+	if err != nil {
+		return nil, err
+	}
+	walTx.ServiceFee = fee
+	if cBal, err := m.db.Repo.GetConfirmedBalance(tx, sUsername, sId); err != nil {
+		return nil, err
+	} else if cBal < uint64(amount)+fee {
+		log.WithFields(log.Fields{
+			"user":        sUsername,
+			"wallet":      sId,
+			"paymentHash": payHash,
+		}).Warn("Wallet attempted payment with insufficient funds")
+		return nil, errors.New("insufficient funds")
+	}
+	// payments to wallets of the same user are limited like transfers between them, by the wallet's own policy
+	recipient := recipientOf(sUsername, rUsername)
+	var policyDestination *string
+	if recipient != nil {
+		policyDestination = &destination
+	}
+	if err := m.wallets.CheckSpendingPolicy(tx, sUsername, sId, policyDestination, recipient,
+		uint64(amount)); err != nil {
+		return nil, err
+	}
+
+	if err := m.db.Repo.SetInvoiceSenderAmount(tx, payHash, sUsername, sId, amount); err != nil {
+		return nil, err
+	}
+	if err := m.db.Repo.CreateTransaction(tx, walTx); err != nil {
+		return nil, err
+	}
+	if err := m.db.Repo.DeletePendingInvoice(tx, payHash); err != nil {
+		return nil, err
+	}
+	err = m.db.Repo.PostJournal(tx, &walTx.ID,
+		models.WalletDebit(sUsername, sId, uint64(amount)+fee),
+		models.WalletCredit(rUsername, rId, uint64(amount)),
+		m.wallets.ServiceFeeCredit(fee),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.webhooks.Enqueue(tx, rUsername, rId, webhook.EventInvoiceSettled, &webhook.InvoiceData{
+		PaymentHash: payHash,
+		AmountMsat:  uint64(amount),
+	}); err != nil {
+		return nil, err
+	}
+	if err := m.webhooks.Enqueue(tx, sUsername, sId, webhook.EventPaymentSucceeded, &webhook.PaymentData{
+		PaymentHash: payHash,
+		AmountMsat:  uint64(amount),
+	}); err != nil {
+		return nil, err
+	}
+	return walTx, nil
+}
+
+// publishSelfPayment notifies the paying and the paid wallet of a payment that settleSelfPayment settled.
+func (m *manager) publishSelfPayment(walTx *models.Transaction) {
+	payHash := *walTx.InvoiceID
+	m.events.Publish(&events.Event{
+		Type:        events.EventInvoiceSettled,
+		Username:    *walTx.ToUsername,
+		WalletID:    *walTx.ToID,
+		Transaction: walTx,
+		PaymentHash: payHash,
+		AmountMsat:  walTx.Amount,
+	})
+	m.events.Publish(&events.Event{
+		Type:        events.EventPaymentSucceeded,
+		Username:    *walTx.FromUsername,
+		WalletID:    *walTx.FromID,
+		Transaction: walTx,
+		PaymentHash: payHash,
+		AmountMsat:  walTx.Amount,
+	})
 	if _, contains := m.pendingInvoiceCache.Get(payHash); contains {
 		m.pendingInvoiceCache.Delete(payHash)
 	} else {
 		log.WithField("paymentHash", payHash).Warn("Paid an invoice, but there was no record of a corresponding pending invoice")
 	}
-
-	return nil
 }
@@ -0,0 +1,90 @@
+package invoice
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func tagRecords(tag string) map[uint64][]byte {
+	return map[uint64][]byte{RoutingTagRecordType: []byte(tag)}
+}
+
+func TestSpontaneousPaymentsOfKeysend(t *testing.T) {
+	rHash, preimage := []byte{1, 2, 3}, []byte{4, 5, 6}
+	invoice := &lnrpc.Invoice{
+		RHash:       rHash,
+		RPreimage:   preimage,
+		IsKeysend:   true,
+		State:       lnrpc.Invoice_SETTLED,
+		AmtPaidMsat: 1000,
+		SettleIndex: 7,
+		Htlcs: []*lnrpc.InvoiceHTLC{
+			{State: lnrpc.InvoiceHTLCState_SETTLED, CustomRecords: tagRecords("test-tag")},
+		},
+	}
+
+	payments := spontaneousPayments(invoice)
+	require.Len(t, payments, 1)
+	require.Equal(t, base64.StdEncoding.EncodeToString(rHash), payments[0].id)
+	require.Equal(t, uint64(1000), payments[0].amount)
+	require.Equal(t, hex.EncodeToString(preimage), *payments[0].preimage)
+	require.Equal(t, uint64(7), payments[0].settleIndex)
+	require.Equal(t, "test-tag", payments[0].routingTag)
+
+	invoice.State = lnrpc.Invoice_OPEN
+	require.Empty(t, spontaneousPayments(invoice))
+}
+
+func TestSpontaneousPaymentsOfAmpInvoice(t *testing.T) {
+	firstSet, secondSet, openSet := []byte{1}, []byte{2}, []byte{3}
+	invoice := &lnrpc.Invoice{
+		IsAmp: true,
+		State: lnrpc.Invoice_OPEN,
+		AmpInvoiceState: map[string]*lnrpc.AMPInvoiceState{
+			hex.EncodeToString(secondSet): {State: lnrpc.InvoiceHTLCState_SETTLED, SettleIndex: 9, AmtPaidMsat: 2000},
+			hex.EncodeToString(firstSet):  {State: lnrpc.InvoiceHTLCState_SETTLED, SettleIndex: 8, AmtPaidMsat: 1000},
+			hex.EncodeToString(openSet):   {State: lnrpc.InvoiceHTLCState_ACCEPTED, AmtPaidMsat: 3000},
+		},
+		Htlcs: []*lnrpc.InvoiceHTLC{
+			{State: lnrpc.InvoiceHTLCState_SETTLED, Amp: &lnrpc.AMP{SetId: firstSet}, CustomRecords: tagRecords("first-tag")},
+			{State: lnrpc.InvoiceHTLCState_SETTLED, Amp: &lnrpc.AMP{SetId: secondSet}},
+			{State: lnrpc.InvoiceHTLCState_SETTLED, Amp: &lnrpc.AMP{SetId: secondSet}, CustomRecords: tagRecords("second-tag")},
+			{State: lnrpc.InvoiceHTLCState_ACCEPTED, Amp: &lnrpc.AMP{SetId: openSet}, CustomRecords: tagRecords("open-tag")},
+		},
+	}
+
+	payments := spontaneousPayments(invoice)
+	require.Len(t, payments, 2)
+	require.Equal(t, base64.StdEncoding.EncodeToString(firstSet), payments[0].id)
+	require.Equal(t, uint64(1000), payments[0].amount)
+	require.Equal(t, "first-tag", payments[0].routingTag)
+	require.Nil(t, payments[0].preimage)
+	require.Equal(t, base64.StdEncoding.EncodeToString(secondSet), payments[1].id)
+	require.Equal(t, uint64(2000), payments[1].amount)
+	require.Equal(t, "second-tag", payments[1].routingTag)
+}
+
+func TestRoutingTagIgnoresUnsettledHtlcs(t *testing.T) {
+	require.Empty(t, routingTag([]*lnrpc.InvoiceHTLC{
+		{State: lnrpc.InvoiceHTLCState_CANCELED, CustomRecords: tagRecords("test-tag")},
+		{State: lnrpc.InvoiceHTLCState_SETTLED},
+	}))
+}
+
+func TestSettleIndexOf(t *testing.T) {
+	require.Zero(t, settleIndexOf(&lnrpc.Invoice{State: lnrpc.Invoice_OPEN}))
+	require.Equal(t, uint64(7), settleIndexOf(&lnrpc.Invoice{State: lnrpc.Invoice_SETTLED, SettleIndex: 7}))
+	require.Equal(t, uint64(9), settleIndexOf(&lnrpc.Invoice{
+		IsAmp: true,
+		State: lnrpc.Invoice_OPEN,
+		AmpInvoiceState: map[string]*lnrpc.AMPInvoiceState{
+			"01": {State: lnrpc.InvoiceHTLCState_SETTLED, SettleIndex: 9},
+			"02": {State: lnrpc.InvoiceHTLCState_SETTLED, SettleIndex: 8},
+			"03": {State: lnrpc.InvoiceHTLCState_ACCEPTED},
+		},
+	}))
+}
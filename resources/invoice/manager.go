@@ -2,68 +2,122 @@ package invoice
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"math"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/record"
 	"github.com/patrickmn/go-cache"
 	log "github.com/sirupsen/logrus"
 	"github.com/xbit-gg/xln/db"
 	"github.com/xbit-gg/xln/lnd"
 	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/resources/events"
 	"github.com/xbit-gg/xln/resources/wallet"
+	"github.com/xbit-gg/xln/resources/webhook"
 	"gorm.io/gorm"
 )
 
-// Any more and you should just use VISA
-var feePercentLimit = 0.03
+const (
+	// substring of the error returned by LND when there is no route to a destination
+	lndMsgSubstrNoRoute = "unable to find a path to destination"
+	// substring of the error returned by LND when tracking a payment that was never sent
+	lndMsgSubstrPaymentNotInitiated = "payment isn't initiated"
+)
 
 type Manager interface {
-	CreateInvoice(username, walletId, memo string, value int64, expiry int64) (*models.Invoice, error)
-	ListWalletInvoices(username, walletId string) ([]*models.Invoice, error)
+	CreateInvoice(username, walletId, memo string, descriptionHash []byte, value int64, expiry int64) (*models.Invoice, error)
+	CreateHoldInvoice(username, walletId, memo string, value, expiry int64, cltvExpiry uint64) (*models.Invoice, error)
+	SettleHoldInvoice(username, walletId, paymentHash string) error
+	CancelHoldInvoice(username, walletId, paymentHash string) error
+	ListWalletInvoices(username, walletId string, filter *models.InvoiceFilter, offset, limit uint,
+		descending bool) (invoices []*models.Invoice, nextOffset int, total uint64, err error)
 	GetWalletInvoice(username, walletId, paymentHash string) (*models.Invoice, error)
 	GetInvoice(paymentHash string) (*models.Invoice, error)
-	PayInvoice(username, walletId, pr string, sync bool) (*Payment, error)
+	PayInvoice(username, walletId, pr string, sync bool, options *PaymentOptions) (*Payment, error)
 	PayWithdrawInvoice(k1, pr string) error
-	PayInvoiceAmount(username, walletId, pr string, sync bool, amount int64) (*Payment, error)
+	PayInvoiceAmount(username, walletId, pr string, sync bool, amount int64, options *PaymentOptions) (*Payment, error)
+	SendKeysend(username, walletId, destination string, amount int64, customRecords map[uint64][]byte,
+		options *PaymentOptions) (*Payment, error)
+	QuotePayment(username, walletId, pr string, amount int64, options *PaymentOptions) (*Quote, error)
+	// PaymentAmount returns the amount in msat that paying the invoice pr sends, which is amount if it is set.
+	PaymentAmount(pr string, amount int64) (uint64, error)
+	// BatchPayInvoices pays the invoices specified by prs from the wallet, unless its confirmed balance does not
+	// cover them all. The result of each payment is sent on the returned channel, which is closed once all complete.
+	BatchPayInvoices(username, walletId string, prs []string, options *PaymentOptions) (<-chan *BatchPaymentResult, error)
+
+	// GetPaymentDefaults returns the routing options of the payments of the user's wallets that do not set them.
+	GetPaymentDefaults(username string) (*models.PaymentDefaults, error)
+	// SetPaymentDefaults replaces the payment defaults of the user. Errors if any of the defaults exceed the
+	// bounds of XLN.
+	SetPaymentDefaults(defaults *models.PaymentDefaults) error
 }
 
 type manager struct {
 	lnClient             lnrpc.LightningClient
 	routerClient         routerrpc.RouterClient
+	invoicesClient       invoicesrpc.InvoicesClient
 	wallets              wallet.Manager
+	webhooks             webhook.Manager
+	events               events.Manager
 	pendingInvoiceCache  *cache.Cache
 	pendingPaymentCache  *cache.Cache
 	pendingWithdrawCache *cache.Cache
 	muWdraw              sync.Mutex
 	db                   *db.DB
 	maxPayment           int64
+	paymentBounds        PaymentBounds
 }
 
-func NewManager(lndClient *lnd.Client, walletManager wallet.Manager, db *db.DB, maxPayment int64) Manager {
+func NewManager(lndClient *lnd.Client, walletManager wallet.Manager, webhookManager webhook.Manager,
+	eventManager events.Manager, db *db.DB, maxPayment int64, paymentBounds PaymentBounds) Manager {
 	m := &manager{
 		lnClient:            lnrpc.NewLightningClient(lndClient.Conn),
 		routerClient:        routerrpc.NewRouterClient(lndClient.Conn),
+		invoicesClient:      invoicesrpc.NewInvoicesClient(lndClient.Conn),
 		wallets:             walletManager,
+		webhooks:            webhookManager,
+		events:              eventManager,
 		pendingInvoiceCache: cache.New(time.Hour, 6*time.Hour),
 		pendingPaymentCache: cache.New(time.Hour, 6*time.Hour),
 		db:                  db,
 		maxPayment:          maxPayment,
+		paymentBounds:       paymentBounds,
 	}
 	m.handleStalePayments()
 	m.handleStaleInvoices()
 	go m.trackPendingInvoices()
+	go m.sweepExpiredInvoices()
 
 	return m
 }
 
+// Quote is the estimated cost of paying an invoice.
+type Quote struct {
+	// Internal is true if the invoice belongs to a wallet of XLN, in which case it is paid without routing fees
+	Internal   bool
+	AmountMsat uint64
+	// FeeMsat is the lowest routing fee of the routes found
+	FeeMsat uint64
+	// ServiceFeeMsat is the fee that the wallet is charged by the node
+	ServiceFeeMsat uint64
+	RouteCount     int
+	// SufficientBalance is true if the confirmed balance of the wallet covers the amount and fees
+	SufficientBalance bool
+}
+
 type Payment struct {
+	PaymentHash   string
+	Preimage      string
 	Success       bool
 	AmountMsat    uint64
 	FeeMsat       uint64
@@ -71,7 +125,8 @@ type Payment struct {
 }
 
 // CreateInvoice creates an LN invoice payable to wallet with a given value in millisatoshis.
-func (m *manager) CreateInvoice(username string, walletId string, memo string, value int64, expiry int64) (*models.Invoice, error) {
+// If descriptionHash is not empty, then the invoice commits to the hash instead of the memo.
+func (m *manager) CreateInvoice(username string, walletId string, memo string, descriptionHash []byte, value int64, expiry int64) (*models.Invoice, error) {
 	if value > m.maxPayment {
 		log.WithField("value", value).Warn("CreateInvoice called with too large a value")
 		return nil, fmt.Errorf("invoice of size %d msat is greater than the maximum payment size", value)
@@ -86,9 +141,10 @@ func (m *manager) CreateInvoice(username string, walletId string, memo string, v
 	}
 
 	inv := &lnrpc.Invoice{
-		Memo:      memo,
-		ValueMsat: value,
-		Expiry:    expiry,
+		Memo:            memo,
+		DescriptionHash: descriptionHash,
+		ValueMsat:       value,
+		Expiry:          expiry,
 	}
 	invoice, err := m.lnClient.AddInvoice(context.Background(), inv)
 	if err != nil {
@@ -96,50 +152,158 @@ func (m *manager) CreateInvoice(username string, walletId string, memo string, v
 		return nil, err
 	}
 
-	decodePayRes, err := m.lnClient.DecodePayReq(context.Background(), &lnrpc.PayReqString{PayReq: invoice.PaymentRequest})
+	return m.recordInvoice(wallet, &models.PendingInvoice{
+		WalletID:       walletId,
+		WalletUsername: username,
+		PaymentHash:    base64.StdEncoding.EncodeToString(invoice.RHash),
+		Amount:         uint64(value),
+	}, invoice.PaymentRequest, memo)
+}
+
+// CreateHoldInvoice creates an LN invoice payable to wallet with a given value in millisatoshis. Once paid, the
+// payment is held until the wallet settles or cancels the invoice, and the wallet is only credited on settlement.
+// cltvExpiry is the number of blocks that the payment may be held for, or LND's default if zero.
+func (m *manager) CreateHoldInvoice(username, walletId, memo string, value, expiry int64, cltvExpiry uint64) (*models.Invoice, error) {
+	if value > m.maxPayment {
+		log.WithField("value", value).Warn("CreateHoldInvoice called with too large a value")
+		return nil, fmt.Errorf("invoice of size %d msat is greater than the maximum payment size", value)
+	}
+	wallet, err := m.getAndValidateWallet(username, walletId)
 	if err != nil {
-		log.WithError(err).Error("Unable to decode pay response")
+		log.WithError(err).WithFields(log.Fields{
+			"user":   username,
+			"wallet": walletId,
+		}).Warn("CreateHoldInvoice failed")
+		return nil, err
+	}
+
+	preimage := make([]byte, 32)
+	if _, err := rand.Read(preimage); err != nil {
+		log.WithError(err).Error("Failed to generate preimage for hold invoice")
+		return nil, err
+	}
+	hash := sha256.Sum256(preimage)
+	req := &invoicesrpc.AddHoldInvoiceRequest{
+		Memo:       memo,
+		Hash:       hash[:],
+		ValueMsat:  value,
+		Expiry:     expiry,
+		CltvExpiry: cltvExpiry,
+	}
+	invoice, err := m.invoicesClient.AddHoldInvoice(context.Background(), req)
+	if err != nil {
+		log.WithError(err).WithField("invoice", req).Warn("Unable to create hold invoice")
 		return nil, err
 	}
 
-	paymentHash := base64.StdEncoding.EncodeToString(invoice.RHash)
-	pendingInv := &models.PendingInvoice{
+	encodedPreimage := hex.EncodeToString(preimage)
+	return m.recordInvoice(wallet, &models.PendingInvoice{
 		WalletID:       walletId,
 		WalletUsername: username,
-		PaymentHash:    paymentHash,
+		PaymentHash:    base64.StdEncoding.EncodeToString(hash[:]),
 		Amount:         uint64(value),
+		Hold:           true,
+		Preimage:       &encodedPreimage,
+	}, invoice.PaymentRequest, memo)
+}
+
+// recordInvoice records an invoice that was added to LND for the wallet, and the pending invoice that credits
+// the wallet once the invoice is settled.
+func (m *manager) recordInvoice(wallet *models.Wallet, pendingInv *models.PendingInvoice, paymentRequest,
+	memo string) (*models.Invoice, error) {
+	decodePayRes, err := m.lnClient.DecodePayReq(context.Background(), &lnrpc.PayReqString{PayReq: paymentRequest})
+	if err != nil {
+		log.WithError(err).Error("Unable to decode pay response")
+		return nil, err
 	}
+	expiresAt := time.Unix(decodePayRes.Timestamp+decodePayRes.Expiry, 0).UTC()
+	pendingInv.ExpiresAt = &expiresAt
+
 	err = m.db.Repo.CreatePendingInvoice(m.db.DB, pendingInv)
 	if err != nil {
 		log.WithError(err).Error("Failed to add pending invoice to DB")
 		return nil, err
 	}
-	m.pendingInvoiceCache.SetDefault(paymentHash, pendingInv)
+	m.pendingInvoiceCache.SetDefault(pendingInv.PaymentHash, pendingInv)
 
 	xlnInvoice := models.Invoice{
-		Amount:            uint64(value),
+		Amount:            pendingInv.Amount,
 		Memo:              memo,
-		PaymentHash:       paymentHash,
-		PaymentRequest:    invoice.PaymentRequest,
+		PaymentHash:       pendingInv.PaymentHash,
+		PaymentRequest:    paymentRequest,
 		Pubkey:            decodePayRes.Destination,
 		RecipientID:       &wallet.ID,
 		RecipientUsername: &wallet.Username,
 		Settled:           time.Time{},
 		Timestamp:         time.Now().UTC(),
+		ExpiresAt:         &expiresAt,
 	}
 	err = m.db.Repo.CreateInvoice(m.db.DB, &xlnInvoice)
 	if err != nil {
 		log.WithError(err).WithFields(log.Fields{
-			"wallet":       walletId,
-			"payment_hash": paymentHash,
+			"wallet":       wallet.ID,
+			"payment_hash": pendingInv.PaymentHash,
 		}).Error("Unable to add invoice to the database")
 		return nil, err
 	}
 	return &xlnInvoice, nil
 }
 
-func (m *manager) ListWalletInvoices(username, walletId string) ([]*models.Invoice, error) {
-	return m.db.Repo.ListWalletInvoices(m.db.DB, username, walletId)
+// SettleHoldInvoice releases the payment of a hold invoice of the wallet to the wallet. The wallet is credited
+// once LND reports the invoice as settled.
+func (m *manager) SettleHoldInvoice(username, walletId, paymentHash string) error {
+	pendingInvoice, err := m.getHoldInvoice(username, walletId, paymentHash)
+	if err != nil {
+		return err
+	} else if !pendingInvoice.Accepted {
+		return models.ErrHoldInvoiceNotAccepted
+	}
+	preimage, err := hex.DecodeString(*pendingInvoice.Preimage)
+	if err != nil {
+		log.WithError(err).WithField("hash", paymentHash).Error("Unable to decode preimage of hold invoice")
+		return err
+	}
+	if _, err := m.invoicesClient.SettleInvoice(context.Background(),
+		&invoicesrpc.SettleInvoiceMsg{Preimage: preimage}); err != nil {
+		log.WithError(err).WithField("hash", paymentHash).Warn("Unable to settle hold invoice")
+		return err
+	}
+	return nil
+}
+
+// CancelHoldInvoice cancels a hold invoice of the wallet, and returns its payment to the payer if it was paid.
+func (m *manager) CancelHoldInvoice(username, walletId, paymentHash string) error {
+	if _, err := m.getHoldInvoice(username, walletId, paymentHash); err != nil {
+		return err
+	}
+	hash, err := base64.StdEncoding.DecodeString(paymentHash)
+	if err != nil {
+		return err
+	}
+	if _, err := m.invoicesClient.CancelInvoice(context.Background(),
+		&invoicesrpc.CancelInvoiceMsg{PaymentHash: hash}); err != nil {
+		log.WithError(err).WithField("hash", paymentHash).Warn("Unable to cancel hold invoice")
+		return err
+	}
+	return nil
+}
+
+// getHoldInvoice returns the pending hold invoice of the wallet with the payment hash.
+func (m *manager) getHoldInvoice(username, walletId, paymentHash string) (*models.PendingInvoice, error) {
+	pendingInvoice, err := m.db.Repo.GetPendingInvoice(m.db.DB, paymentHash)
+	if err != nil {
+		return nil, err
+	} else if pendingInvoice.WalletUsername != username || pendingInvoice.WalletID != walletId {
+		return nil, models.ErrPendingInvoiceNotFound
+	} else if !pendingInvoice.Hold {
+		return nil, models.ErrNotHoldInvoice
+	}
+	return pendingInvoice, nil
+}
+
+func (m *manager) ListWalletInvoices(username, walletId string, filter *models.InvoiceFilter, offset, limit uint,
+	descending bool) (invoices []*models.Invoice, nextOffset int, total uint64, err error) {
+	return m.db.Repo.ListWalletInvoices(m.db.DB, username, walletId, filter, offset, limit, descending)
 }
 
 func (m *manager) GetWalletInvoice(username, walletId, paymentHash string) (*models.Invoice, error) {
@@ -151,7 +315,7 @@ func (m *manager) GetInvoice(paymentHash string) (*models.Invoice, error) {
 }
 
 // PayInvoice uses wallet to pay the invoice specified by paymentRequest.
-func (m *manager) PayInvoice(username string, walletId string, pr string, sync bool) (*Payment, error) {
+func (m *manager) PayInvoice(username string, walletId string, pr string, sync bool, options *PaymentOptions) (*Payment, error) {
 	wallet, err := m.getAndValidateWallet(username, walletId)
 	if err != nil {
 		log.WithError(err).WithFields(log.Fields{
@@ -169,7 +333,7 @@ func (m *manager) PayInvoice(username string, walletId string, pr string, sync b
 	if payreq.NumMsat == 0 {
 		return nil, errors.New("amount must be specified when paying a zero amount invoice")
 	}
-	return m.payInvoice(wallet, pr, payreq, payreq.NumMsat, sync)
+	return m.payInvoice(wallet, pr, payreq, payreq.NumMsat, sync, options)
 }
 
 func (m *manager) PayWithdrawInvoice(k1, pr string) error {
@@ -231,7 +395,7 @@ func (m *manager) PayWithdrawInvoice(k1, pr string) error {
 		return fmt.Errorf("amount exceeds the maximum withdrawable value")
 	} else {
 		go func() {
-			_, err := m.payInvoice(wallet, pr, payreq, payreq.NumMsat, true)
+			_, err := m.payInvoice(wallet, pr, payreq, payreq.NumMsat, true, nil)
 			if err != nil {
 				log.WithError(err).WithFields(log.Fields{
 					"user":     withdrawal.Username,
@@ -247,7 +411,8 @@ func (m *manager) PayWithdrawInvoice(k1, pr string) error {
 	}
 }
 
-func (m *manager) PayInvoiceAmount(username string, walletId string, pr string, sync bool, amount int64) (*Payment, error) {
+func (m *manager) PayInvoiceAmount(username string, walletId string, pr string, sync bool, amount int64,
+	options *PaymentOptions) (*Payment, error) {
 	wallet, err := m.getAndValidateWallet(username, walletId)
 	if err != nil {
 		log.WithError(err).WithFields(log.Fields{
@@ -265,24 +430,34 @@ func (m *manager) PayInvoiceAmount(username string, walletId string, pr string,
 	if payreq.NumMsat != 0 && amount != payreq.NumMsat {
 		return nil, fmt.Errorf("provided amount %d does not satisfy payment request amount %d", amount, payreq.NumMsat)
 	}
-	return m.payInvoice(wallet, pr, payreq, amount, sync)
+	return m.payInvoice(wallet, pr, payreq, amount, sync, options)
 }
 
-func (m *manager) payInvoice(wal *models.Wallet, pr string, payreq *lnrpc.PayReq, amount int64, sync bool) (*Payment, error) {
+func (m *manager) PaymentAmount(pr string, amount int64) (uint64, error) {
+	if amount > 0 {
+		return uint64(amount), nil
+	}
+	payreq, err := m.lnClient.DecodePayReq(context.Background(), &lnrpc.PayReqString{PayReq: pr})
+	if err != nil {
+		log.WithError(err).WithField("pr", pr).Warn("PaymentAmount called with invalid payment request format")
+		return 0, errors.New("invalid payment request format")
+	}
+	return uint64(payreq.NumMsat), nil
+}
+
+func (m *manager) payInvoice(wal *models.Wallet, pr string, payreq *lnrpc.PayReq, amount int64, sync bool,
+	options *PaymentOptions) (*Payment, error) {
 	if payreq.NumMsat > m.maxPayment {
 		log.WithField("value", payreq.NumMsat).Warn("payInvoice called with too large a value")
 		return nil, fmt.Errorf("size %d msat is greater than the maximum payment size", payreq.NumMsat)
 	}
 
 	// if pending invoice in db then it is self-payment
-	hexPayH, err := hex.DecodeString(payreq.PaymentHash)
-	if err != nil {
-		log.WithError(err).Error("failed to decode payment request's payment hash")
+	if pending, err := m.getInternalInvoice(payreq); err != nil {
 		return nil, err
-	}
-	payH := base64.StdEncoding.EncodeToString(hexPayH)
-	if pending, err := m.db.Repo.GetPendingInvoice(m.db.DB, payH); err == nil {
-		if err := m.handleSelfPayments(wal.Username, wal.ID, pending.WalletUsername, pending.WalletID, payH, amount); err == nil {
+	} else if pending != nil {
+		if err := m.handleSelfPayments(wal.Username, wal.ID, pending.WalletUsername, pending.WalletID,
+			pending.PaymentHash, payreq.Destination, amount); err == nil {
 			return &Payment{
 				Success:    true,
 				AmountMsat: uint64(amount),
@@ -290,8 +465,6 @@ func (m *manager) payInvoice(wal *models.Wallet, pr string, payreq *lnrpc.PayReq
 		} else {
 			return nil, err
 		}
-	} else if err != models.ErrPendingInvoiceNotFound {
-		return nil, err
 	}
 	log.WithFields(log.Fields{
 		"user":   wal.Username,
@@ -299,34 +472,278 @@ func (m *manager) payInvoice(wal *models.Wallet, pr string, payreq *lnrpc.PayReq
 		"pr":     pr,
 	}).Info("processing external payment")
 
+	// Clear amount when paying an invoice with specified amount
+	specifiedAmount := amount
+	if payreq.NumMsat > 0 {
+		specifiedAmount = 0
+	}
+	invoice := &models.Invoice{
+		Amount:         uint64(amount),
+		PaymentRequest: pr,
+		Pubkey:         payreq.Destination,
+	}
+	paymentHash, err := m.sendPayment(wal, payreq.Destination, amount, invoice, options,
+		&routerrpc.SendPaymentRequest{
+			PaymentRequest: pr,
+			AmtMsat:        specifiedAmount,
+		})
+	if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"paymentRequest": pr,
+			"destination":    payreq.Destination,
+			"user":           wal.Username,
+			"wallet":         wal.ID,
+		}).Error("failed to finalize payment")
+		return nil, err
+	}
+	if sync {
+		return m.trackPendingPayment(paymentHash), nil
+	} else {
+		go m.trackPendingPayment(paymentHash)
+	}
+
+	return nil, nil
+}
+
+// getInternalInvoice returns the pending invoice of the wallet that payreq pays, or nil if payreq does not pay a
+// wallet of XLN. Errors if payreq pays a hold invoice of a wallet, which cannot be paid without LND.
+func (m *manager) getInternalInvoice(payreq *lnrpc.PayReq) (*models.PendingInvoice, error) {
+	hexPayH, err := hex.DecodeString(payreq.PaymentHash)
+	if err != nil {
+		log.WithError(err).Error("failed to decode payment request's payment hash")
+		return nil, err
+	}
+	pending, err := m.db.Repo.GetPendingInvoice(m.db.DB, base64.StdEncoding.EncodeToString(hexPayH))
+	if err == models.ErrPendingInvoiceNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	} else if pending.Hold {
+		return nil, errors.New("hold invoices of wallets on this node cannot be paid by a wallet")
+	} else if pending.ExpiresAt != nil && !time.Now().UTC().Before(*pending.ExpiresAt) {
+		// LND cancels expired invoices, which the sweeper only removes later
+		return nil, models.ErrInvoiceExpired
+	}
+	return pending, nil
+}
+
+// QuotePayment estimates the routing fee of paying the invoice specified by pr from the wallet. amount is
+// required if the invoice does not specify one, and must match it otherwise.
+func (m *manager) QuotePayment(username, walletId, pr string, amount int64, options *PaymentOptions) (*Quote, error) {
+	wallet, err := m.getAndValidateWallet(username, walletId)
+	if err != nil {
+		return nil, err
+	}
+	payreq, err := m.lnClient.DecodePayReq(context.Background(), &lnrpc.PayReqString{PayReq: pr})
+	if err != nil {
+		log.WithError(err).WithField("pr", pr).Warn("QuotePayment called with invalid payment request format")
+		return nil, errors.New("invalid payment request format")
+	}
+	if payreq.NumMsat == 0 && amount == 0 {
+		return nil, errors.New("amount must be specified when paying a zero amount invoice")
+	} else if payreq.NumMsat != 0 && amount != 0 && amount != payreq.NumMsat {
+		return nil, fmt.Errorf("provided amount %d does not satisfy payment request amount %d", amount, payreq.NumMsat)
+	} else if payreq.NumMsat != 0 {
+		amount = payreq.NumMsat
+	}
+	if amount > m.maxPayment {
+		return nil, fmt.Errorf("size %d msat is greater than the maximum payment size", amount)
+	}
+	cBal, err := m.db.Repo.GetConfirmedBalance(m.db.DB, wallet.Username, wallet.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &Quote{AmountMsat: uint64(amount)}
+	if pending, err := m.getInternalInvoice(payreq); err != nil {
+		return nil, err
+	} else if pending != nil {
+		quote.Internal = true
+		quote.ServiceFeeMsat, err = m.wallets.ServiceFee(m.db.DB, wallet.Username, wallet.ID, models.FeeKindInternal,
+			quote.AmountMsat)
+		if err != nil {
+			return nil, err
+		}
+		quote.SufficientBalance = cBal >= quote.AmountMsat+quote.ServiceFeeMsat
+		return quote, nil
+	}
+	quote.ServiceFeeMsat, err = m.wallets.ServiceFee(m.db.DB, wallet.Username, wallet.ID, models.FeeKindExternal,
+		quote.AmountMsat)
+	if err != nil {
+		return nil, err
+	}
+
+	defaults, err := m.db.Repo.GetPaymentDefaults(m.db.DB, wallet.Username)
+	if err != nil {
+		return nil, err
+	}
+	options, err = m.paymentBounds.resolve(options, defaults)
+	if err != nil {
+		return nil, err
+	}
+	req, err := newQueryRoutesRequest(payreq, quote.AmountMsat, options)
+	if err != nil {
+		return nil, err
+	}
+	routes, err := m.lnClient.QueryRoutes(context.Background(), req)
+	if err != nil && strings.Contains(err.Error(), lndMsgSubstrNoRoute) {
+		log.WithError(err).WithField("destination", payreq.Destination).Debug("No route found for quote")
+		return quote, nil
+	} else if err != nil {
+		log.WithError(err).Error("Error calling QueryRoutes")
+		return nil, fmt.Errorf("error querying routes: %v", err)
+	}
+	quote.RouteCount = len(routes.Routes)
+	for _, route := range routes.Routes {
+		if quote.FeeMsat == 0 || uint64(route.TotalFeesMsat) < quote.FeeMsat {
+			quote.FeeMsat = uint64(route.TotalFeesMsat)
+		}
+	}
+	quote.SufficientBalance = quote.RouteCount > 0 && cBal >= quote.AmountMsat+quote.FeeMsat+quote.ServiceFeeMsat
+	return quote, nil
+}
+
+// newQueryRoutesRequest returns the request for the routes that a payment of amount to payreq with the resolved
+// options may take.
+func newQueryRoutesRequest(payreq *lnrpc.PayReq, amount uint64, options *PaymentOptions) (*lnrpc.QueryRoutesRequest, error) {
+	req := &lnrpc.QueryRoutesRequest{
+		PubKey:            payreq.Destination,
+		AmtMsat:           int64(amount),
+		FinalCltvDelta:    int32(payreq.CltvExpiry),
+		RouteHints:        payreq.RouteHints,
+		UseMissionControl: true,
+		FeeLimit: &lnrpc.FeeLimit{
+			Limit: &lnrpc.FeeLimit_FixedMsat{FixedMsat: int64(options.feeLimit(amount))},
+		},
+	}
+	for bit := range payreq.Features {
+		req.DestFeatures = append(req.DestFeatures, lnrpc.FeatureBit(bit))
+	}
+	// routes are only queried through a single outgoing channel
+	if len(options.OutgoingChanIds) == 1 {
+		req.OutgoingChanId = options.OutgoingChanIds[0]
+	}
+	if options.LastHopPubkey != "" {
+		lastHop, err := hex.DecodeString(options.LastHopPubkey)
+		if err != nil {
+			return nil, errors.New("invalid last hop pubkey")
+		}
+		req.LastHopPubkey = lastHop
+	}
+	return req, nil
+}
+
+// SendKeysend pays amount to the node with pubkey destination without a payment request, by sending it the
+// preimage of the payment in the keysend custom record.
+func (m *manager) SendKeysend(username, walletId, destination string, amount int64, customRecords map[uint64][]byte,
+	options *PaymentOptions) (*Payment, error) {
+	if amount <= 0 {
+		return nil, errors.New("amount must be positive")
+	} else if amount > m.maxPayment {
+		log.WithField("value", amount).Warn("SendKeysend called with too large a value")
+		return nil, fmt.Errorf("size %d msat is greater than the maximum payment size", amount)
+	}
+	dest, err := hex.DecodeString(destination)
+	if err != nil {
+		return nil, errors.New("invalid destination pubkey")
+	}
+	for key := range customRecords {
+		if key < record.CustomTypeStart {
+			return nil, fmt.Errorf("custom record type %d is below the custom range starting at %d", key,
+				record.CustomTypeStart)
+		} else if key == record.KeySendType {
+			return nil, errors.New("custom records cannot contain the keysend preimage record")
+		}
+	}
+	wal, err := m.getAndValidateWallet(username, walletId)
+	if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"user":        username,
+			"wallet":      walletId,
+			"destination": destination,
+		}).Warn("SendKeysend failed")
+		return nil, err
+	}
+
+	preimage := make([]byte, 32)
+	if _, err := rand.Read(preimage); err != nil {
+		log.WithError(err).Error("Failed to generate keysend preimage")
+		return nil, err
+	}
+	hash := sha256.Sum256(preimage)
+	destCustomRecords := map[uint64][]byte{record.KeySendType: preimage}
+	for key, value := range customRecords {
+		destCustomRecords[key] = value
+	}
+	encodedPreimage := hex.EncodeToString(preimage)
+	invoice := &models.Invoice{
+		Amount:   uint64(amount),
+		Preimage: &encodedPreimage,
+		Pubkey:   destination,
+	}
+	log.WithFields(log.Fields{
+		"user":        wal.Username,
+		"wallet":      wal.ID,
+		"destination": destination,
+	}).Info("processing keysend payment")
+
+	paymentHash, err := m.sendPayment(wal, destination, amount, invoice, options,
+		&routerrpc.SendPaymentRequest{
+			Dest:              dest,
+			AmtMsat:           amount,
+			PaymentHash:       hash[:],
+			DestCustomRecords: destCustomRecords,
+		})
+	if err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"destination": destination,
+			"user":        wal.Username,
+			"wallet":      wal.ID,
+		}).Error("failed to finalize keysend payment")
+		return nil, err
+	}
+	return m.trackPendingPayment(paymentHash), nil
+}
+
+// sendPayment sends a payment of amount from the wallet to destination, and records it as a pending payment and
+// an outgoing invoice. It is sent within the DB transaction that checks the wallet's confirmed balance and spending
+// policy. The service fee is held with the pending payment, and is charged once the payment succeeds.
+// The routing options are resolved against the payment defaults of the wallet's user and set on req.
+// Returns the hex encoded payment hash, with which the payment is tracked.
+func (m *manager) sendPayment(wal *models.Wallet, destination string, amount int64, invoice *models.Invoice,
+	options *PaymentOptions, req *routerrpc.SendPaymentRequest) (string, error) {
+	defaults, err := m.db.Repo.GetPaymentDefaults(m.db.DB, wal.Username)
+	if err != nil {
+		return "", err
+	}
+	options, err = m.paymentBounds.resolve(options, defaults)
+	if err != nil {
+		return "", err
+	}
+
 	var payment *lnrpc.Payment
 	err = m.db.Transaction(func(tx *gorm.DB) error {
+		fee, err := m.wallets.ServiceFee(tx, wal.Username, wal.ID, models.FeeKindExternal, uint64(amount))
+		if err != nil {
+			return err
+		}
 		cBal, err := m.db.Repo.GetConfirmedBalance(tx, wal.Username, wal.ID)
 		if err != nil {
 			return err
 		}
-		if cBal < uint64(amount) {
+		if cBal < uint64(amount)+fee {
 			log.WithFields(log.Fields{
-				"user":   wal.Username,
-				"wallet": wal.ID,
-				"pr":     pr,
+				"user":        wal.Username,
+				"wallet":      wal.ID,
+				"destination": destination,
 			}).Warn("Wallet attempted payment with insufficient funds")
 			return errors.New("insufficient funds")
 		}
-
-		// Clear amount when paying an invoice with specified amount
-		specifiedAmount := amount
-		if payreq.NumMsat > 0 {
-			specifiedAmount = 0
-		}
-		req := &routerrpc.SendPaymentRequest{
-			PaymentRequest: pr,
-			FeeLimitMsat: int64(math.Min(
-				float64(payreq.NumMsat)*feePercentLimit,
-				float64(cBal-uint64(payreq.NumMsat)))),
-			TimeoutSeconds: 30,
-			AmtMsat:        specifiedAmount,
+		if err := m.wallets.CheckSpendingPolicy(tx, wal.Username, wal.ID, &destination, nil, uint64(amount)); err != nil {
+			return err
 		}
+
+		options.apply(req, uint64(amount), cBal-fee)
 		stream, err := m.routerClient.SendPaymentV2(context.Background(), req)
 		if err != nil {
 			log.WithError(err).Error("Error calling SendPaymentV2")
@@ -335,7 +752,7 @@ func (m *manager) payInvoice(wal *models.Wallet, pr string, payreq *lnrpc.PayReq
 
 		payment, err = stream.Recv()
 		if err != nil {
-			log.WithError(err).WithField("wallet", wal.ID).Warn("Failed to pay an invoice")
+			log.WithError(err).WithField("wallet", wal.ID).Warn("Failed to send a payment")
 			return err
 		}
 		pendingPayment := &models.PendingPayment{
@@ -343,6 +760,7 @@ func (m *manager) payInvoice(wal *models.Wallet, pr string, payreq *lnrpc.PayReq
 			WalletUsername: wal.Username,
 			PaymentHash:    payment.PaymentHash,
 			Amount:         uint64(amount),
+			ServiceFee:     fee,
 		}
 		err = m.db.Repo.CreatePendingPayment(tx, pendingPayment)
 		if err != nil {
@@ -350,37 +768,28 @@ func (m *manager) payInvoice(wal *models.Wallet, pr string, payreq *lnrpc.PayReq
 			return err
 		}
 		m.pendingPaymentCache.SetDefault(payment.PaymentHash, pendingPayment)
-		invoice := models.Invoice{
-			Amount:         uint64(amount),
-			PaymentHash:    payment.PaymentHash,
-			PaymentRequest: pr,
-			Pubkey:         payreq.Destination,
-			SenderID:       &wal.ID,
-			SenderUsername: &wal.Username,
-			Settled:        time.Time{},
-			Timestamp:      time.Now().UTC(),
-		}
-		if err := m.db.Repo.CreateInvoice(tx, &invoice); err != nil {
-			return err
-		}
-		return nil
+		invoice.PaymentHash = payment.PaymentHash
+		invoice.SenderID = &wal.ID
+		invoice.SenderUsername = &wal.Username
+		invoice.Settled = time.Time{}
+		invoice.Timestamp = time.Now().UTC()
+		return m.db.Repo.CreateInvoice(tx, invoice)
 	})
 	if err != nil {
-		log.WithError(err).WithFields(log.Fields{
-			"paymentRequest": pr,
-			"destination":    payreq.Destination,
-			"user":           wal.Username,
-			"wallet":         wal.ID,
-		}).Error("failed to finalize payment")
-		return nil, err
-	}
-	if sync {
-		return m.trackPendingPayment(payment.PaymentHash), nil
-	} else {
-		go m.trackPendingPayment(payment.PaymentHash)
+		return "", err
 	}
+	return payment.PaymentHash, nil
+}
 
-	return nil, nil
+func (m *manager) GetPaymentDefaults(username string) (*models.PaymentDefaults, error) {
+	return m.db.Repo.GetPaymentDefaults(m.db.DB, username)
+}
+
+func (m *manager) SetPaymentDefaults(defaults *models.PaymentDefaults) error {
+	if err := m.paymentBounds.validateDefaults(defaults); err != nil {
+		return err
+	}
+	return m.db.Repo.SetPaymentDefaults(m.db.DB, defaults)
 }
 
 // validateWallet throws an error if the wallet, which exists, is not valid.
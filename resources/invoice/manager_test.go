@@ -0,0 +1,585 @@
+package invoice
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/suite"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/resources/events"
+	"github.com/xbit-gg/xln/resources/wallet"
+	"github.com/xbit-gg/xln/resources/webhook"
+	"google.golang.org/grpc"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func TestInvoiceManager(t *testing.T) {
+	suite.Run(t, new(invoiceManagerSuite))
+}
+
+type invoiceManagerSuite struct {
+	suite.Suite
+	mgr          *manager
+	mock         sqlmock.Sqlmock
+	mockRepo     mockRepo
+	mockInvoices mockInvoicesClient
+	mockWebhooks mockWebhooks
+	mockLn       mockLightningClient
+	mockRouter   mockRouterClient
+}
+
+const (
+	username = "test-username"
+	walletId = "test-walletid"
+)
+
+var (
+	rHash       = []byte{1, 2, 3}
+	paymentHash = base64.StdEncoding.EncodeToString(rHash)
+	preimage    = hex.EncodeToString([]byte{4, 5, 6})
+)
+
+func (s *invoiceManagerSuite) SetupTest() {
+	var (
+		err   error
+		sqlDB *sql.DB
+	)
+	sqlDB, s.mock, err = sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+	sDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	s.Require().NoError(err)
+	s.mockRepo = mockRepo{pendingInvoices: map[string]*models.PendingInvoice{
+		paymentHash: {
+			PaymentHash:    paymentHash,
+			WalletUsername: username,
+			WalletID:       walletId,
+			Hold:           true,
+			Preimage:       &preimage,
+		},
+	}}
+	s.mockInvoices = mockInvoicesClient{}
+	s.mockWebhooks = mockWebhooks{}
+	s.mockLn = mockLightningClient{payreq: &lnrpc.PayReq{
+		Destination: "test-destination",
+		PaymentHash: hex.EncodeToString([]byte{7, 8, 9}),
+		NumMsat:     100000,
+	}}
+	s.mockRouter = mockRouterClient{}
+	s.mgr = &manager{
+		lnClient:            &s.mockLn,
+		routerClient:        &s.mockRouter,
+		invoicesClient:      &s.mockInvoices,
+		wallets:             mockWallets{},
+		webhooks:            &s.mockWebhooks,
+		events:              events.NewManager(),
+		pendingInvoiceCache: cache.New(cache.NoExpiration, 0),
+		pendingPaymentCache: cache.New(cache.NoExpiration, 0),
+		db:                  &db.DB{DB: sDB, Repo: &s.mockRepo},
+		maxPayment:          1000000,
+		paymentBounds:       testBounds,
+	}
+}
+
+func (s *invoiceManagerSuite) TestSettleHoldInvoiceRequiresPayment() {
+	err := s.mgr.SettleHoldInvoice(username, walletId, paymentHash)
+	s.Require().Equal(models.ErrHoldInvoiceNotAccepted, err)
+	s.Require().Nil(s.mockInvoices.settled)
+
+	s.mockRepo.pendingInvoices[paymentHash].Accepted = true
+	s.Require().NoError(s.mgr.SettleHoldInvoice(username, walletId, paymentHash))
+	s.Require().Equal(preimage, hex.EncodeToString(s.mockInvoices.settled))
+}
+
+func (s *invoiceManagerSuite) TestCancelHoldInvoice() {
+	s.Require().NoError(s.mgr.CancelHoldInvoice(username, walletId, paymentHash))
+	s.Require().Equal(rHash, s.mockInvoices.cancelled)
+}
+
+func (s *invoiceManagerSuite) TestHoldInvoicesOfOtherWalletsAreNotFound() {
+	err := s.mgr.CancelHoldInvoice(username, "other-walletid", paymentHash)
+	s.Require().Equal(models.ErrPendingInvoiceNotFound, err)
+	err = s.mgr.SettleHoldInvoice("other-username", walletId, paymentHash)
+	s.Require().Equal(models.ErrPendingInvoiceNotFound, err)
+	s.Require().Nil(s.mockInvoices.cancelled)
+}
+
+func (s *invoiceManagerSuite) TestRegularInvoicesCannotBeHeld() {
+	s.mockRepo.pendingInvoices[paymentHash].Hold = false
+	err := s.mgr.CancelHoldInvoice(username, walletId, paymentHash)
+	s.Require().Equal(models.ErrNotHoldInvoice, err)
+
+	s.mgr.handleInvoiceUpdate(&lnrpc.Invoice{RHash: rHash, State: lnrpc.Invoice_ACCEPTED})
+	s.Require().False(s.mockRepo.pendingInvoices[paymentHash].Accepted)
+	s.Require().Empty(s.mockWebhooks.enqueued)
+}
+
+func (s *invoiceManagerSuite) TestAcceptedHoldInvoiceNotifiesWalletOnce() {
+	ch, unsubscribe := s.mgr.events.Subscribe(username, walletId)
+	defer unsubscribe()
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectCommit()
+	invoice := &lnrpc.Invoice{RHash: rHash, State: lnrpc.Invoice_ACCEPTED, AmtPaidMsat: 1000}
+	s.mgr.handleInvoiceUpdate(invoice)
+	s.mgr.handleInvoiceUpdate(invoice)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+
+	s.Require().True(s.mockRepo.pendingInvoices[paymentHash].Accepted)
+	s.Require().Equal([]string{webhook.EventInvoiceAccepted}, s.mockWebhooks.enqueued)
+	event := <-ch
+	s.Require().Equal(events.EventInvoiceAccepted, event.Type)
+	s.Require().Equal(uint64(1000), event.AmountMsat)
+	s.Require().Empty(ch)
+}
+
+func (s *invoiceManagerSuite) TestOpenInvoicesRemainPending() {
+	s.mgr.handleInvoiceUpdate(&lnrpc.Invoice{RHash: rHash, State: lnrpc.Invoice_OPEN})
+	s.Require().Contains(s.mockRepo.pendingInvoices, paymentHash)
+	s.Require().Empty(s.mockWebhooks.enqueued)
+}
+
+func (s *invoiceManagerSuite) TestSweepRemovesCancelledExpiredInvoices() {
+	ch, unsubscribe := s.mgr.events.Subscribe(username, walletId)
+	defer unsubscribe()
+	now := time.Now().UTC()
+	expiredAt, openHash, missingHash := now.Add(-2*expiryGracePeriod), []byte{10}, []byte{11}
+	s.mockRepo.pendingInvoices[paymentHash].ExpiresAt = &expiredAt
+	for _, hash := range [][]byte{openHash, missingHash} {
+		encoded := base64.StdEncoding.EncodeToString(hash)
+		s.mockRepo.pendingInvoices[encoded] = &models.PendingInvoice{PaymentHash: encoded, ExpiresAt: &expiredAt}
+	}
+	recent := now.Add(-expiryGracePeriod / 2)
+	s.mockRepo.pendingInvoices["recent"] = &models.PendingInvoice{PaymentHash: "recent", ExpiresAt: &recent}
+	s.mockLn.invoices = map[string]*lnrpc.Invoice{
+		paymentHash: {RHash: rHash, State: lnrpc.Invoice_CANCELED},
+		base64.StdEncoding.EncodeToString(openHash): {RHash: openHash, State: lnrpc.Invoice_OPEN},
+	}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectCommit()
+	s.mgr.sweepExpired(now)
+
+	s.Require().NotContains(s.mockRepo.pendingInvoices, paymentHash)
+	s.Require().Len(s.mockRepo.pendingInvoices, 3, "open, unknown and recently expired invoices remain pending")
+	s.Require().Equal([]string{paymentHash}, s.mockRepo.cancelled)
+	s.Require().Equal([]string{webhook.EventInvoiceCancelled}, s.mockWebhooks.enqueued)
+	event := <-ch
+	s.Require().Equal(events.EventInvoiceCancelled, event.Type)
+	s.Require().Equal(paymentHash, event.PaymentHash)
+}
+
+func (s *invoiceManagerSuite) TestSweepLeavesAcceptedHoldInvoices() {
+	expiredAt := time.Now().UTC().Add(-2 * expiryGracePeriod)
+	s.mockRepo.pendingInvoices[paymentHash].ExpiresAt = &expiredAt
+	s.mockRepo.pendingInvoices[paymentHash].Accepted = true
+	s.mockLn.invoices = map[string]*lnrpc.Invoice{paymentHash: {RHash: rHash, State: lnrpc.Invoice_CANCELED}}
+
+	s.mgr.sweepExpired(time.Now().UTC())
+	s.Require().Contains(s.mockRepo.pendingInvoices, paymentHash)
+	s.Require().Empty(s.mockWebhooks.enqueued)
+}
+
+func (s *invoiceManagerSuite) TestQuoteInternalPayment() {
+	s.mockRepo.pendingInvoices[paymentHash].Hold = false
+	s.mockLn.payreq.PaymentHash = hex.EncodeToString(rHash)
+	s.mockRepo.balance = 100000
+
+	quote, err := s.mgr.QuotePayment(username, walletId, "test-pr", 0, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(&Quote{Internal: true, AmountMsat: 100000, SufficientBalance: true}, quote)
+	s.Require().Nil(s.mockLn.queried, "routes are not queried for wallets on this node")
+
+	s.mockRepo.pendingInvoices[paymentHash].Hold = true
+	_, err = s.mgr.QuotePayment(username, walletId, "test-pr", 0, nil)
+	s.Require().Error(err)
+}
+
+func (s *invoiceManagerSuite) TestExpiredInternalInvoicesCannotBePaid() {
+	expiredAt := time.Now().UTC().Add(-time.Minute)
+	s.mockRepo.pendingInvoices[paymentHash].Hold = false
+	s.mockRepo.pendingInvoices[paymentHash].ExpiresAt = &expiredAt
+	s.mockLn.payreq.PaymentHash = hex.EncodeToString(rHash)
+	s.mockRepo.balance = 100000
+
+	_, err := s.mgr.QuotePayment(username, walletId, "test-pr", 0, nil)
+	s.Require().Equal(models.ErrInvoiceExpired, err)
+	_, err = s.mgr.PayInvoice(username, walletId, "test-pr", true, nil)
+	s.Require().Equal(models.ErrInvoiceExpired, err)
+	_, err = s.mgr.BatchPayInvoices(username, walletId, []string{"test-pr"}, nil)
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), models.ErrInvoiceExpired.Error())
+}
+
+func (s *invoiceManagerSuite) TestQuoteExternalPayment() {
+	s.mockLn.routes = []*lnrpc.Route{{TotalFeesMsat: 200}, {TotalFeesMsat: 50}}
+	s.mockRepo.balance = 100050
+
+	quote, err := s.mgr.QuotePayment(username, walletId, "test-pr", 0, &PaymentOptions{MaxFeeMsat: 400})
+	s.Require().NoError(err)
+	s.Require().Equal(&Quote{AmountMsat: 100000, FeeMsat: 50, RouteCount: 2, SufficientBalance: true}, quote)
+	s.Require().Equal("test-destination", s.mockLn.queried.PubKey)
+	s.Require().Equal(int64(100000), s.mockLn.queried.AmtMsat)
+	s.Require().Equal(int64(400), s.mockLn.queried.FeeLimit.GetFixedMsat())
+
+	s.mockRepo.balance = 100049
+	quote, err = s.mgr.QuotePayment(username, walletId, "test-pr", 0, nil)
+	s.Require().NoError(err)
+	s.Require().False(quote.SufficientBalance)
+	s.Require().Equal(int64(3000), s.mockLn.queried.FeeLimit.GetFixedMsat(), "the fee is limited by the bounds")
+}
+
+func (s *invoiceManagerSuite) TestQuoteWithoutRoute() {
+	s.mockRepo.balance = 1000000
+	s.mockLn.routesErr = errors.New("rpc error: code = Unknown desc = unable to find a path to destination")
+
+	quote, err := s.mgr.QuotePayment(username, walletId, "test-pr", 0, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(&Quote{AmountMsat: 100000}, quote)
+
+	s.mockLn.routesErr = errors.New("rpc error: code = Unavailable")
+	_, err = s.mgr.QuotePayment(username, walletId, "test-pr", 0, nil)
+	s.Require().Error(err)
+}
+
+func (s *invoiceManagerSuite) TestQuoteRequiresAmountOfInvoice() {
+	_, err := s.mgr.QuotePayment(username, walletId, "test-pr", 1, nil)
+	s.Require().Error(err)
+
+	s.mockLn.payreq.NumMsat = 0
+	_, err = s.mgr.QuotePayment(username, walletId, "test-pr", 0, nil)
+	s.Require().Error(err)
+	_, err = s.mgr.QuotePayment(username, walletId, "test-pr", 1000001, nil)
+	s.Require().Error(err)
+}
+
+func (s *invoiceManagerSuite) TestBatchIsRejectedUnlessBalanceCoversIt() {
+	s.mockLn.payreqs = map[string]*lnrpc.PayReq{
+		"pr-1": {Destination: "test-destination", PaymentHash: "01", NumMsat: 60000},
+		"pr-2": {Destination: "test-destination", PaymentHash: "02", NumMsat: 50000},
+	}
+	s.mockRepo.balance = 100000
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectRollback()
+	_, err := s.mgr.BatchPayInvoices(username, walletId, []string{"pr-1", "pr-2"}, nil)
+	s.Require().EqualError(err, "insufficient funds")
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+	s.Require().Nil(s.mockRouter.sent, "no payment is sent")
+}
+
+func (s *invoiceManagerSuite) TestBatchRejectsInvoicesThatCannotBePaid() {
+	s.mockLn.payreqs = map[string]*lnrpc.PayReq{
+		"pr-1":        {PaymentHash: "01", NumMsat: 1000},
+		"zero-amount": {PaymentHash: "02"},
+		"too-large":   {PaymentHash: "03", NumMsat: 1000001},
+	}
+	for _, prs := range [][]string{{}, {"pr-1", "zero-amount"}, {"too-large"}, {"pr-1", "pr-1"}} {
+		_, err := s.mgr.BatchPayInvoices(username, walletId, prs, nil)
+		s.Require().Error(err)
+	}
+}
+
+func (s *invoiceManagerSuite) TestBatchReservesFundsOfExternalPayments() {
+	s.mockRepo.pendingInvoices[paymentHash].Hold = false
+	s.mockRepo.pendingInvoices[paymentHash].WalletID = "other-walletid"
+	s.mockLn.payreqs = map[string]*lnrpc.PayReq{
+		"internal": {Destination: "test-destination", PaymentHash: hex.EncodeToString(rHash), NumMsat: 1000},
+		"external": {Destination: "test-destination", PaymentHash: "0a", NumMsat: 2000},
+	}
+	s.mockRepo.balance = 10000
+	s.mockRouter.sendErr = errors.New("invoice is already paid")
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectCommit()
+	results, err := s.mgr.BatchPayInvoices(username, walletId, []string{"internal", "external"}, nil)
+	s.Require().NoError(err)
+	byIndex := map[int]*BatchPaymentResult{}
+	for result := range results {
+		byIndex[result.Index] = result
+	}
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+
+	s.Require().Len(byIndex, 2)
+	s.Require().NoError(byIndex[0].Err)
+	s.Require().True(byIndex[0].Payment.Success)
+	s.Require().Error(byIndex[1].Err)
+	s.Require().Equal("external", byIndex[1].PaymentRequest)
+	s.Require().Len(s.mockRepo.reserved, 1, "internal payments are settled when the batch is reserved")
+	s.Require().Equal(uint64(2000), s.mockRepo.reserved[0].Amount)
+	s.Require().Equal(uint64(testBounds.MaxFeePpm*2000/1000000), s.mockRepo.reserved[0].FeeReserve,
+		"routing fees are reserved")
+	s.Require().Equal(s.mockRepo.reserved, s.mockRepo.released, "funds of payments that are not sent are released")
+	s.Require().Equal(int64(testBounds.MaxFeePpm*2000/1000000), s.mockRouter.sent.FeeLimitMsat)
+}
+
+func (s *invoiceManagerSuite) TestBatchLimitsFeeReservesToBalanceLeft() {
+	s.mockLn.payreqs = map[string]*lnrpc.PayReq{
+		"pr-1": {Destination: "test-destination", PaymentHash: "01", NumMsat: 60000},
+		"pr-2": {Destination: "test-destination", PaymentHash: "02", NumMsat: 39000},
+	}
+	s.mockRepo.balance = 100000
+	s.mockRouter.sendErr = errors.New("invoice is already paid")
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectCommit()
+	results, err := s.mgr.BatchPayInvoices(username, walletId, []string{"pr-1", "pr-2"}, nil)
+	s.Require().NoError(err)
+	for range results {
+	}
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+
+	s.Require().Len(s.mockRepo.reserved, 2)
+	s.Require().Equal(uint64(500), s.mockRepo.reserved[0].FeeReserve, "the balance left is shared evenly")
+	s.Require().Equal(uint64(500), s.mockRepo.reserved[1].FeeReserve)
+}
+
+func (s *invoiceManagerSuite) TestPaymentsToWalletsOfSameUserAreLimitedLikeTransfers() {
+	var destinations []*string
+	s.mgr.wallets = mockWallets{destinations: &destinations}
+	s.mockRepo.balance = 10000
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectCommit()
+	s.mock.ExpectBegin()
+	s.mock.ExpectCommit()
+	s.Require().NoError(s.mgr.handleSelfPayments(username, walletId, username, "other-walletid", paymentHash,
+		"test-destination", 1000))
+	s.Require().NoError(s.mgr.handleSelfPayments(username, walletId, "other-username", "other-walletid",
+		paymentHash, "test-destination", 1000))
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+
+	s.Require().Len(destinations, 2)
+	s.Require().Nil(destinations[0], "user policies do not limit payments between wallets of the same user")
+	s.Require().Equal("test-destination", *destinations[1])
+}
+
+func (s *invoiceManagerSuite) TestUnsentPendingPaymentFails() {
+	hash := hex.EncodeToString(rHash)
+	s.mgr.pendingPaymentCache.SetDefault(hash, &models.PendingPayment{
+		WalletUsername: username,
+		WalletID:       walletId,
+		PaymentHash:    hash,
+		Amount:         1000,
+	})
+	s.mockRouter.trackErr = errors.New("rpc error: code = NotFound desc = payment isn't initiated")
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectCommit()
+	payment := s.mgr.trackPendingPayment(hash)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+	s.Require().False(payment.Success)
+	s.Require().Len(s.mockRepo.released, 1)
+	s.Require().Equal([]string{webhook.EventPaymentFailed}, s.mockWebhooks.enqueued)
+}
+
+type mockRouterClient struct {
+	routerrpc.RouterClient
+
+	sent     *routerrpc.SendPaymentRequest
+	sendErr  error
+	trackErr error
+}
+
+func (m *mockRouterClient) SendPaymentV2(_ context.Context, in *routerrpc.SendPaymentRequest, _ ...grpc.CallOption) (routerrpc.Router_SendPaymentV2Client, error) {
+	m.sent = in
+	return &mockPaymentStream{err: m.sendErr}, nil
+}
+
+func (m *mockRouterClient) TrackPaymentV2(_ context.Context, _ *routerrpc.TrackPaymentRequest, _ ...grpc.CallOption) (routerrpc.Router_TrackPaymentV2Client, error) {
+	return &mockPaymentStream{err: m.trackErr}, nil
+}
+
+type mockPaymentStream struct {
+	grpc.ClientStream
+
+	err error
+}
+
+func (m *mockPaymentStream) Recv() (*lnrpc.Payment, error) {
+	return &lnrpc.Payment{}, m.err
+}
+
+type mockLightningClient struct {
+	lnrpc.LightningClient
+
+	payreq    *lnrpc.PayReq
+	payreqs   map[string]*lnrpc.PayReq
+	routes    []*lnrpc.Route
+	routesErr error
+	queried   *lnrpc.QueryRoutesRequest
+	invoices  map[string]*lnrpc.Invoice
+}
+
+func (m *mockLightningClient) LookupInvoice(_ context.Context, in *lnrpc.PaymentHash, _ ...grpc.CallOption) (*lnrpc.Invoice, error) {
+	if invoice, ok := m.invoices[base64.StdEncoding.EncodeToString(in.RHash)]; ok {
+		return invoice, nil
+	}
+	return nil, errors.New("unable to locate invoice")
+}
+
+func (m *mockLightningClient) DecodePayReq(_ context.Context, in *lnrpc.PayReqString, _ ...grpc.CallOption) (*lnrpc.PayReq, error) {
+	if payreq, ok := m.payreqs[in.PayReq]; ok {
+		return payreq, nil
+	}
+	return m.payreq, nil
+}
+
+func (m *mockLightningClient) QueryRoutes(_ context.Context, in *lnrpc.QueryRoutesRequest, _ ...grpc.CallOption) (*lnrpc.QueryRoutesResponse, error) {
+	m.queried = in
+	if m.routesErr != nil {
+		return nil, m.routesErr
+	}
+	return &lnrpc.QueryRoutesResponse{Routes: m.routes}, nil
+}
+
+type mockWallets struct {
+	wallet.Manager
+
+	// destinations of the payments that spending policies were checked for
+	destinations *[]*string
+}
+
+func (m mockWallets) GetWallet(username, walletId string) (*models.Wallet, error) {
+	return &models.Wallet{Username: username, ID: walletId}, nil
+}
+
+func (m mockWallets) ServiceFee(_ *gorm.DB, _, _, _ string, _ uint64) (uint64, error) {
+	return 0, nil
+}
+
+func (m mockWallets) ServiceFeeCredit(fee uint64) *models.LedgerEntry {
+	return models.AccountCredit(models.AccountServiceFees, fee)
+}
+
+func (m mockWallets) CheckSpendingPolicy(_ *gorm.DB, _, _ string, destination, _ *string, _ uint64) error {
+	if m.destinations != nil {
+		*m.destinations = append(*m.destinations, destination)
+	}
+	return nil
+}
+
+type mockInvoicesClient struct {
+	invoicesrpc.InvoicesClient
+
+	settled   []byte
+	cancelled []byte
+}
+
+func (m *mockInvoicesClient) SettleInvoice(_ context.Context, in *invoicesrpc.SettleInvoiceMsg, _ ...grpc.CallOption) (*invoicesrpc.SettleInvoiceResp, error) {
+	m.settled = in.Preimage
+	return &invoicesrpc.SettleInvoiceResp{}, nil
+}
+
+func (m *mockInvoicesClient) CancelInvoice(_ context.Context, in *invoicesrpc.CancelInvoiceMsg, _ ...grpc.CallOption) (*invoicesrpc.CancelInvoiceResp, error) {
+	m.cancelled = in.PaymentHash
+	return &invoicesrpc.CancelInvoiceResp{}, nil
+}
+
+type mockWebhooks struct {
+	webhook.Manager
+
+	enqueued []string
+}
+
+func (m *mockWebhooks) Enqueue(_ *gorm.DB, _, _, event string, _ interface{}) error {
+	m.enqueued = append(m.enqueued, event)
+	return nil
+}
+
+type mockRepo struct {
+	models.Repository
+
+	pendingInvoices map[string]*models.PendingInvoice
+	balance         uint64
+	reserved        []*models.PendingPayment
+	released        []*models.PendingPayment
+	cancelled       []string
+}
+
+func (m *mockRepo) GetPendingInvoice(_ *gorm.DB, paymentHash string) (*models.PendingInvoice, error) {
+	if pi, ok := m.pendingInvoices[paymentHash]; ok {
+		copied := *pi
+		return &copied, nil
+	}
+	return nil, models.ErrPendingInvoiceNotFound
+}
+
+func (m *mockRepo) GetConfirmedBalance(_ *gorm.DB, _, _ string) (uint64, error) {
+	return m.balance, nil
+}
+
+func (m *mockRepo) GetPaymentDefaults(_ *gorm.DB, _ string) (*models.PaymentDefaults, error) {
+	return &models.PaymentDefaults{}, nil
+}
+
+func (m *mockRepo) CreatePendingPayment(_ *gorm.DB, pendingPayment *models.PendingPayment) error {
+	m.reserved = append(m.reserved, pendingPayment)
+	return nil
+}
+
+func (m *mockRepo) DeletePendingPayment(_ *gorm.DB, pendingPayment *models.PendingPayment) error {
+	m.released = append(m.released, pendingPayment)
+	return nil
+}
+
+func (m *mockRepo) SetInvoiceSenderAmount(_ *gorm.DB, _, _, _ string, _ int64) error {
+	return nil
+}
+
+func (m *mockRepo) CreateTransaction(_ *gorm.DB, _ *models.Transaction) error {
+	return nil
+}
+
+func (m *mockRepo) DeletePendingInvoice(_ *gorm.DB, paymentHash string) error {
+	delete(m.pendingInvoices, paymentHash)
+	return nil
+}
+
+func (m *mockRepo) PostJournal(_ *gorm.DB, _ *string, _ ...*models.LedgerEntry) error {
+	return nil
+}
+
+func (m *mockRepo) ListExpiredPendingInvoices(_ *gorm.DB, before time.Time, _ int) ([]*models.PendingInvoice, error) {
+	var expired []*models.PendingInvoice
+	for _, pi := range m.pendingInvoices {
+		if pi.ExpiresAt != nil && pi.ExpiresAt.Before(before) && !pi.Accepted {
+			expired = append(expired, pi)
+		}
+	}
+	return expired, nil
+}
+
+func (m *mockRepo) DeletePendingInvoices(_ *gorm.DB, paymentHashes []string) error {
+	for _, paymentHash := range paymentHashes {
+		delete(m.pendingInvoices, paymentHash)
+	}
+	return nil
+}
+
+func (m *mockRepo) UpdateInvoicesCancelTime(_ *gorm.DB, paymentHashes []string, _ time.Time) error {
+	m.cancelled = append(m.cancelled, paymentHashes...)
+	return nil
+}
+
+func (m *mockRepo) AcceptPendingInvoice(_ *gorm.DB, paymentHash string) error {
+	if pi, ok := m.pendingInvoices[paymentHash]; ok && pi.Hold {
+		pi.Accepted = true
+		return nil
+	}
+	return models.ErrPendingInvoiceNotFound
+}
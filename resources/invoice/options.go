@@ -0,0 +1,121 @@
+package invoice
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/xbit-gg/xln/models"
+)
+
+// timeout of payments that neither set one nor have a default, in seconds
+var defaultPaymentTimeout uint32 = 30
+
+// PaymentOptions are the routing options of a payment. Options that are zero fall back to the payment defaults
+// of the wallet's user, and then to the bounds of XLN.
+type PaymentOptions struct {
+	// The routing fee is limited to the lower of MaxFeeMsat and MaxFeePpm of the amount
+	MaxFeeMsat     uint64
+	MaxFeePpm      uint64
+	TimeoutSeconds uint32
+	// maximum number of parts that the payment may be split into
+	MaxParts uint32
+	// channels that the payment may leave through. Any channel may be used if empty
+	OutgoingChanIds []uint64
+	// hex encoded pubkey of the node that must forward the payment to the destination. Optional
+	LastHopPubkey string
+}
+
+// PaymentBounds are the most lenient routing options that payments, and the payment defaults of users, may set.
+type PaymentBounds struct {
+	MaxFeePpm      uint64
+	TimeoutSeconds uint32
+	MaxParts       uint32
+}
+
+// validateDefaults errors if any of the payment defaults exceed the bounds.
+func (b *PaymentBounds) validateDefaults(defaults *models.PaymentDefaults) error {
+	return b.validate(&PaymentOptions{
+		MaxFeePpm:      defaults.MaxFeePpm,
+		TimeoutSeconds: defaults.TimeoutSeconds,
+		MaxParts:       defaults.MaxParts,
+	})
+}
+
+// validate errors if any of the options exceed the bounds.
+func (b *PaymentBounds) validate(options *PaymentOptions) error {
+	if options.MaxFeePpm > b.MaxFeePpm {
+		return fmt.Errorf("max fee of %d ppm exceeds the limit of %d ppm", options.MaxFeePpm, b.MaxFeePpm)
+	} else if options.TimeoutSeconds > b.TimeoutSeconds {
+		return fmt.Errorf("timeout of %d seconds exceeds the limit of %d seconds", options.TimeoutSeconds,
+			b.TimeoutSeconds)
+	} else if options.MaxParts > b.MaxParts {
+		return fmt.Errorf("max parts of %d exceeds the limit of %d", options.MaxParts, b.MaxParts)
+	} else if _, err := hex.DecodeString(options.LastHopPubkey); err != nil {
+		return errors.New("invalid last hop pubkey")
+	}
+	return nil
+}
+
+// resolve returns the options with those that are not set filled from the defaults, and then from the bounds.
+// Errors if any of the options exceed the bounds.
+func (b *PaymentBounds) resolve(options *PaymentOptions, defaults *models.PaymentDefaults) (*PaymentOptions, error) {
+	var resolved PaymentOptions
+	if options != nil {
+		resolved = *options
+	}
+	if resolved.MaxFeeMsat == 0 {
+		resolved.MaxFeeMsat = defaults.MaxFeeMsat
+	}
+	if resolved.MaxFeePpm == 0 {
+		resolved.MaxFeePpm = defaults.MaxFeePpm
+	}
+	if resolved.MaxFeePpm == 0 {
+		resolved.MaxFeePpm = b.MaxFeePpm
+	}
+	if resolved.TimeoutSeconds == 0 {
+		resolved.TimeoutSeconds = defaults.TimeoutSeconds
+	}
+	if resolved.TimeoutSeconds == 0 {
+		resolved.TimeoutSeconds = defaultPaymentTimeout
+		if b.TimeoutSeconds < defaultPaymentTimeout {
+			resolved.TimeoutSeconds = b.TimeoutSeconds
+		}
+	}
+	if resolved.MaxParts == 0 {
+		resolved.MaxParts = defaults.MaxParts
+	}
+	if resolved.MaxParts == 0 {
+		resolved.MaxParts = b.MaxParts
+	}
+	if err := b.validate(&resolved); err != nil {
+		return nil, err
+	}
+	return &resolved, nil
+}
+
+// feeLimit returns the maximum routing fee of a payment of amount. The options must have been resolved.
+func (o *PaymentOptions) feeLimit(amount uint64) uint64 {
+	feeLimit := amount * o.MaxFeePpm / 1000000
+	if o.MaxFeeMsat > 0 && o.MaxFeeMsat < feeLimit {
+		feeLimit = o.MaxFeeMsat
+	}
+	return feeLimit
+}
+
+// apply sets the options on the request of a payment of amount by a wallet with the confirmed balance cBal, which
+// must cover the amount. The options must have been resolved.
+func (o *PaymentOptions) apply(req *routerrpc.SendPaymentRequest, amount, cBal uint64) {
+	feeLimit := o.feeLimit(amount)
+	if cBal-amount < feeLimit {
+		feeLimit = cBal - amount
+	}
+	req.FeeLimitMsat = int64(feeLimit)
+	req.TimeoutSeconds = int32(o.TimeoutSeconds)
+	req.MaxParts = o.MaxParts
+	req.OutgoingChanIds = o.OutgoingChanIds
+	if o.LastHopPubkey != "" {
+		req.LastHopPubkey, _ = hex.DecodeString(o.LastHopPubkey)
+	}
+}
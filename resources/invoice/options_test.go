@@ -0,0 +1,73 @@
+package invoice
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/stretchr/testify/require"
+	"github.com/xbit-gg/xln/models"
+)
+
+var testBounds = PaymentBounds{MaxFeePpm: 30000, TimeoutSeconds: 300, MaxParts: 16}
+
+func TestResolveFallsBackToDefaultsThenBounds(t *testing.T) {
+	resolved, err := testBounds.resolve(nil, &models.PaymentDefaults{})
+	require.NoError(t, err)
+	require.Equal(t, &PaymentOptions{MaxFeePpm: 30000, TimeoutSeconds: defaultPaymentTimeout, MaxParts: 16}, resolved)
+
+	defaults := &models.PaymentDefaults{MaxFeeMsat: 500, MaxFeePpm: 1000, TimeoutSeconds: 60, MaxParts: 4}
+	resolved, err = testBounds.resolve(&PaymentOptions{MaxParts: 1, LastHopPubkey: "02ab"}, defaults)
+	require.NoError(t, err)
+	require.Equal(t, &PaymentOptions{
+		MaxFeeMsat:     500,
+		MaxFeePpm:      1000,
+		TimeoutSeconds: 60,
+		MaxParts:       1,
+		LastHopPubkey:  "02ab",
+	}, resolved)
+}
+
+func TestResolveDefaultTimeoutWithinBounds(t *testing.T) {
+	bounds := PaymentBounds{MaxFeePpm: 30000, TimeoutSeconds: 10, MaxParts: 16}
+	resolved, err := bounds.resolve(nil, &models.PaymentDefaults{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), resolved.TimeoutSeconds)
+}
+
+func TestResolveRejectsOptionsBeyondBounds(t *testing.T) {
+	for _, options := range []*PaymentOptions{
+		{MaxFeePpm: 30001},
+		{TimeoutSeconds: 301},
+		{MaxParts: 17},
+		{LastHopPubkey: "not hex"},
+	} {
+		_, err := testBounds.resolve(options, &models.PaymentDefaults{})
+		require.Error(t, err)
+	}
+	_, err := testBounds.resolve(nil, &models.PaymentDefaults{MaxParts: 17})
+	require.Error(t, err)
+	require.Error(t, testBounds.validateDefaults(&models.PaymentDefaults{MaxFeePpm: 30001}))
+	require.NoError(t, testBounds.validateDefaults(&models.PaymentDefaults{MaxFeeMsat: 1000000}))
+}
+
+func TestApplyLimitsFee(t *testing.T) {
+	options := &PaymentOptions{MaxFeePpm: 10000, TimeoutSeconds: 30, MaxParts: 2, OutgoingChanIds: []uint64{7}}
+	req := &routerrpc.SendPaymentRequest{}
+	options.apply(req, 100000, 1000000)
+	require.Equal(t, int64(1000), req.FeeLimitMsat)
+	require.Equal(t, int32(30), req.TimeoutSeconds)
+	require.Equal(t, uint32(2), req.MaxParts)
+	require.Equal(t, []uint64{7}, req.OutgoingChanIds)
+	require.Nil(t, req.LastHopPubkey)
+
+	options.MaxFeeMsat = 400
+	options.apply(req, 100000, 1000000)
+	require.Equal(t, int64(400), req.FeeLimitMsat, "the absolute limit applies if lower")
+
+	options.apply(req, 100000, 100100)
+	require.Equal(t, int64(100), req.FeeLimitMsat, "fees are limited to the remaining balance")
+
+	options.LastHopPubkey = "02ab"
+	options.apply(req, 100000, 1000000)
+	require.Equal(t, []byte{0x02, 0xab}, req.LastHopPubkey)
+}
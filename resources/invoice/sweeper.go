@@ -0,0 +1,105 @@
+package invoice
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/resources/events"
+	"github.com/xbit-gg/xln/resources/webhook"
+	"gorm.io/gorm"
+)
+
+var (
+	// interval at which expired pending invoices are resolved
+	sweepInterval = time.Minute
+	// maximum number of expired pending invoices resolved on each sweep
+	sweepBatchSize = 100
+	// pending invoices are resolved once they have been expired for expiryGracePeriod, which leaves time for
+	// payments that were in flight when they expired to settle
+	expiryGracePeriod = time.Minute
+)
+
+func (m *manager) sweepExpiredInvoices() {
+	for {
+		m.sweepExpired(time.Now().UTC())
+		time.Sleep(sweepInterval)
+	}
+}
+
+// sweepExpired resolves the pending invoices that expired before now, less the grace period, and were not paid.
+// Invoices that LND reports as cancelled are removed together, and any that were settled are credited.
+func (m *manager) sweepExpired(now time.Time) {
+	pendingInvoices, err := m.db.Repo.ListExpiredPendingInvoices(m.db.DB, now.Add(-expiryGracePeriod), sweepBatchSize)
+	if err != nil {
+		log.WithError(err).Warn("Failed to get expired pending invoices")
+		return
+	}
+	var expired []*models.PendingInvoice
+	for _, pi := range pendingInvoices {
+		paymentHash, err := base64.StdEncoding.DecodeString(pi.PaymentHash)
+		if err != nil {
+			log.WithError(err).WithField("hash", pi.PaymentHash).Error("Unable to decode paymentHash of expired invoice")
+			continue
+		}
+		invoice, err := m.lnClient.LookupInvoice(context.Background(), &lnrpc.PaymentHash{RHash: paymentHash})
+		if err != nil {
+			// looked up again on the next sweep
+			log.WithError(err).WithField("hash", pi.PaymentHash).Warn("Failed to lookup expired invoice")
+			continue
+		}
+		switch invoice.State {
+		case lnrpc.Invoice_CANCELED:
+			expired = append(expired, pi)
+		case lnrpc.Invoice_SETTLED, lnrpc.Invoice_ACCEPTED:
+			m.handleInvoiceUpdate(invoice)
+		}
+	}
+	if len(expired) > 0 {
+		m.expireInvoices(expired)
+	}
+}
+
+// expireInvoices removes the pending invoices, which LND has cancelled because they expired, within a single DB
+// transaction, and notifies their wallets.
+func (m *manager) expireInvoices(pendingInvoices []*models.PendingInvoice) {
+	paymentHashes := make([]string, len(pendingInvoices))
+	for i, pi := range pendingInvoices {
+		paymentHashes[i] = pi.PaymentHash
+	}
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		if err := m.db.Repo.DeletePendingInvoices(tx, paymentHashes); err != nil {
+			return err
+		} else if err := m.db.Repo.UpdateInvoicesCancelTime(tx, paymentHashes, time.Now().UTC()); err != nil {
+			return err
+		}
+		for _, pi := range pendingInvoices {
+			if err := m.webhooks.Enqueue(tx, pi.WalletUsername, pi.WalletID, webhook.EventInvoiceCancelled,
+				&webhook.InvoiceData{
+					PaymentHash: pi.PaymentHash,
+					AmountMsat:  pi.Amount,
+				}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).Warn("Failed to remove expired pending invoices")
+		return
+	}
+	for _, pi := range pendingInvoices {
+		m.pendingInvoiceCache.Delete(pi.PaymentHash)
+		m.events.Publish(&events.Event{
+			Type:        events.EventInvoiceCancelled,
+			Username:    pi.WalletUsername,
+			WalletID:    pi.WalletID,
+			PaymentHash: pi.PaymentHash,
+			AmountMsat:  pi.Amount,
+		})
+	}
+	log.WithField("total", len(pendingInvoices)).Debug("Expired pending invoices removed")
+}
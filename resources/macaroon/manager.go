@@ -0,0 +1,300 @@
+package macaroon
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/util"
+	"gopkg.in/macaroon.v2"
+)
+
+const (
+	// location of the macaroons that XLN bakes
+	location = "xln"
+	// version of the identifiers of macaroons
+	identifierVersion = 1
+	// ID of the root key that macaroons are signed with
+	rootKeyId = "0"
+
+	// conditions of the first party caveats of macaroons, which are followed by a space and their value
+	condWallet        = "xln-wallet"
+	condMethods       = "xln-methods"
+	condMaxAmountMsat = "xln-max-amount-msat"
+	condTimeBefore    = "time-before"
+	condIPAddress     = "ipaddr"
+)
+
+var (
+	ErrInvalidMacaroon = errors.New("invalid macaroon")
+	ErrMacaroonExpired = errors.New("macaroon expired")
+)
+
+// Caveats restrict the requests that a macaroon may authorize. Caveats that are not set do not restrict requests.
+type Caveats struct {
+	// the only wallet of the user that the macaroon may be used for. Macaroons of wallets cannot call the RPCs of
+	// users
+	WalletID *string
+	// selectors of the RPCs that the macaroon may call, such as Xln.PayInvoice. A macaroon whose caveats allow no
+	// methods has an empty, non-nil list
+	Methods []string
+	// maximum amount of each payment or transfer that the macaroon may make
+	MaxAmountMsat *uint64
+	// time after which the macaroon is rejected
+	ExpiresAt *time.Time
+	// address of the only client that may use the macaroon
+	IPAddress *string
+}
+
+// AllowsMethod returns true if the macaroon may call the RPC of the selector.
+func (c *Caveats) AllowsMethod(selector string) bool {
+	if c.Methods == nil {
+		return true
+	}
+	for _, method := range c.Methods {
+		if method == selector {
+			return true
+		}
+	}
+	return false
+}
+
+type Manager interface {
+	// Bake returns a new hex encoded macaroon of the user, or of the user's wallet if the caveats set a wallet, that
+	// is restricted by the caveats.
+	Bake(username string, caveats *Caveats) (string, error)
+
+	// Verify checks that the hex encoded macaroon was baked by XLN and has not expired, and returns the user that
+	// it was baked for and the combination of its caveats, which the caller must enforce.
+	// Errors with ErrInvalidMacaroon if the macaroon was not baked by XLN or its caveats conflict, and with
+	// ErrMacaroonExpired if it expired.
+	Verify(encoded string) (username string, caveats *Caveats, err error)
+}
+
+type manager struct {
+	db *db.DB
+
+	muRootKey sync.Mutex
+	rootKey   []byte
+}
+
+func NewManager(db *db.DB) Manager {
+	return &manager{db: db}
+}
+
+// identifier is the encoded identifier of macaroons.
+type identifier struct {
+	Version   int    `json:"v"`
+	RootKeyId string `json:"k"`
+	Nonce     string `json:"n"`
+	Username  string `json:"u"`
+}
+
+func (m *manager) Bake(username string, caveats *Caveats) (string, error) {
+	if caveats == nil {
+		caveats = &Caveats{}
+	}
+	if _, err := m.db.Repo.GetUser(m.db.DB, username); err != nil {
+		return "", err
+	} else if caveats.WalletID != nil {
+		if _, err := m.db.Repo.GetWallet(m.db.DB, username, *caveats.WalletID); err != nil {
+			return "", err
+		}
+	}
+	if caveats.ExpiresAt != nil && !caveats.ExpiresAt.After(time.Now()) {
+		return "", errors.New("expiry must be in the future")
+	} else if caveats.IPAddress != nil && net.ParseIP(*caveats.IPAddress) == nil {
+		return "", fmt.Errorf("invalid ip address: %s", *caveats.IPAddress)
+	}
+	for _, method := range caveats.Methods {
+		if method == "" || strings.ContainsAny(method, ", ") {
+			return "", fmt.Errorf("invalid method: %s", method)
+		}
+	}
+
+	rootKey, err := m.getRootKey()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := util.GenKey()
+	if err != nil {
+		return "", err
+	}
+	id, err := json.Marshal(&identifier{
+		Version:   identifierVersion,
+		RootKeyId: rootKeyId,
+		Nonce:     nonce,
+		Username:  username,
+	})
+	if err != nil {
+		return "", err
+	}
+	mac, err := macaroon.New(rootKey, id, location, macaroon.LatestVersion)
+	if err != nil {
+		return "", err
+	}
+	for _, condition := range caveats.conditions() {
+		if err := mac.AddFirstPartyCaveat([]byte(condition)); err != nil {
+			return "", err
+		}
+	}
+	encoded, err := mac.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	log.WithFields(log.Fields{
+		"user":    username,
+		"wallet":  caveats.WalletID,
+		"methods": caveats.Methods,
+	}).Info("macaroon baked")
+	return hex.EncodeToString(encoded), nil
+}
+
+// conditions returns the conditions of the first party caveats that restrict macaroons to the caveats.
+func (c *Caveats) conditions() []string {
+	var conditions []string
+	if c.WalletID != nil {
+		conditions = append(conditions, condWallet+" "+*c.WalletID)
+	}
+	if len(c.Methods) > 0 {
+		conditions = append(conditions, condMethods+" "+strings.Join(c.Methods, ","))
+	}
+	if c.MaxAmountMsat != nil {
+		conditions = append(conditions, condMaxAmountMsat+" "+strconv.FormatUint(*c.MaxAmountMsat, 10))
+	}
+	if c.ExpiresAt != nil {
+		conditions = append(conditions, condTimeBefore+" "+c.ExpiresAt.UTC().Format(time.RFC3339Nano))
+	}
+	if c.IPAddress != nil {
+		conditions = append(conditions, condIPAddress+" "+*c.IPAddress)
+	}
+	return conditions
+}
+
+func (m *manager) Verify(encoded string) (string, *Caveats, error) {
+	raw, err := hex.DecodeString(encoded)
+	if err != nil {
+		return "", nil, ErrInvalidMacaroon
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(raw); err != nil {
+		return "", nil, ErrInvalidMacaroon
+	}
+	var id identifier
+	if err := json.Unmarshal(mac.Id(), &id); err != nil || id.Version != identifierVersion ||
+		id.RootKeyId != rootKeyId || id.Username == "" {
+		return "", nil, ErrInvalidMacaroon
+	}
+	rootKey, err := m.getRootKey()
+	if err != nil {
+		return "", nil, err
+	}
+
+	caveats := &Caveats{}
+	if err := mac.Verify(rootKey, caveats.restrict, nil); err != nil {
+		log.WithError(err).WithField("user", id.Username).Debug("failed to verify macaroon")
+		return "", nil, ErrInvalidMacaroon
+	} else if caveats.ExpiresAt != nil && !time.Now().Before(*caveats.ExpiresAt) {
+		return "", nil, ErrMacaroonExpired
+	}
+	return id.Username, caveats, nil
+}
+
+// restrict narrows the caveats to those that also satisfy the condition of a caveat. Macaroons only get more
+// restricted as caveats are added, so caveats that conflict with earlier ones error.
+func (c *Caveats) restrict(condition string) error {
+	parts := strings.SplitN(condition, " ", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return fmt.Errorf("invalid caveat: %s", condition)
+	}
+	value := parts[1]
+	switch parts[0] {
+	case condWallet:
+		if c.WalletID != nil && *c.WalletID != value {
+			return errors.New("caveats of multiple wallets")
+		}
+		c.WalletID = &value
+	case condMethods:
+		allowed := strings.Split(value, ",")
+		if c.Methods == nil {
+			c.Methods = allowed
+			return nil
+		}
+		methods := []string{}
+		for _, method := range allowed {
+			if c.AllowsMethod(method) {
+				methods = append(methods, method)
+			}
+		}
+		c.Methods = methods
+	case condMaxAmountMsat:
+		amount, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid caveat: %s", condition)
+		} else if c.MaxAmountMsat == nil || amount < *c.MaxAmountMsat {
+			c.MaxAmountMsat = &amount
+		}
+	case condTimeBefore:
+		expiresAt, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return fmt.Errorf("invalid caveat: %s", condition)
+		} else if c.ExpiresAt == nil || expiresAt.Before(*c.ExpiresAt) {
+			c.ExpiresAt = &expiresAt
+		}
+	case condIPAddress:
+		if net.ParseIP(value) == nil {
+			return fmt.Errorf("invalid caveat: %s", condition)
+		} else if c.IPAddress != nil && !net.ParseIP(*c.IPAddress).Equal(net.ParseIP(value)) {
+			return errors.New("caveats of multiple ip addresses")
+		}
+		c.IPAddress = &value
+	default:
+		return fmt.Errorf("unknown caveat: %s", condition)
+	}
+	return nil
+}
+
+// getRootKey returns the root key that macaroons are signed with, which is created the first time it is needed.
+func (m *manager) getRootKey() ([]byte, error) {
+	m.muRootKey.Lock()
+	defer m.muRootKey.Unlock()
+	if m.rootKey != nil {
+		return m.rootKey, nil
+	}
+
+	key, err := m.db.Repo.GetMacaroonRootKey(m.db.DB, rootKeyId)
+	if err == models.ErrMacaroonRootKeyNotFound {
+		secret, err := util.GenKey()
+		if err != nil {
+			return nil, err
+		}
+		key = &models.MacaroonRootKey{ID: rootKeyId, RootKey: secret}
+		if err := m.db.Repo.CreateMacaroonRootKey(m.db.DB, key); err != nil {
+			// another instance of XLN may have created it first
+			if key, err = m.db.Repo.GetMacaroonRootKey(m.db.DB, rootKeyId); err != nil {
+				return nil, err
+			}
+		} else {
+			log.Info("created macaroon root key")
+		}
+	} else if err != nil {
+		return nil, err
+	}
+	rootKey, err := base64.StdEncoding.DecodeString(key.RootKey)
+	if err != nil {
+		log.WithError(err).Error("failed to decode macaroon root key")
+		return nil, err
+	}
+	m.rootKey = rootKey
+	return rootKey, nil
+}
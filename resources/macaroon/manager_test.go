@@ -0,0 +1,194 @@
+package macaroon
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"gopkg.in/macaroon.v2"
+	"gorm.io/gorm"
+)
+
+func TestMacaroonManager(t *testing.T) {
+	suite.Run(t, new(macaroonManagerSuite))
+}
+
+type macaroonManagerSuite struct {
+	suite.Suite
+	mgr      Manager
+	mockRepo mockRepo
+}
+
+const (
+	username = "test-username"
+	walletId = "test-walletid"
+)
+
+func (s *macaroonManagerSuite) SetupTest() {
+	s.mockRepo = mockRepo{rootKeys: make(map[string]*models.MacaroonRootKey)}
+	s.mgr = NewManager(&db.DB{Repo: &s.mockRepo})
+}
+
+func (s *macaroonManagerSuite) TestBakeAndVerify() {
+	wallet := walletId
+	maxAmount := uint64(1000)
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	ip := "10.0.0.1"
+	mac, err := s.mgr.Bake(username, &Caveats{
+		WalletID:      &wallet,
+		Methods:       []string{"Xln.PayInvoice", "Xln.GetWallet"},
+		MaxAmountMsat: &maxAmount,
+		ExpiresAt:     &expiresAt,
+		IPAddress:     &ip,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(s.mockRepo.rootKeys, 1, "root key is created when it is first needed")
+
+	owner, caveats, err := s.mgr.Verify(mac)
+	s.Require().NoError(err)
+	s.Require().Equal(username, owner)
+	s.Require().Equal(walletId, *caveats.WalletID)
+	s.Require().Equal(maxAmount, *caveats.MaxAmountMsat)
+	s.Require().True(expiresAt.Equal(*caveats.ExpiresAt))
+	s.Require().Equal(ip, *caveats.IPAddress)
+	s.Require().True(caveats.AllowsMethod("Xln.PayInvoice"))
+	s.Require().False(caveats.AllowsMethod("Xln.Transfer"))
+
+	unrestricted, err := s.mgr.Bake(username, nil)
+	s.Require().NoError(err)
+	_, caveats, err = s.mgr.Verify(unrestricted)
+	s.Require().NoError(err)
+	s.Require().Nil(caveats.WalletID)
+	s.Require().Nil(caveats.MaxAmountMsat)
+	s.Require().True(caveats.AllowsMethod("Xln.Transfer"))
+}
+
+func (s *macaroonManagerSuite) TestBakeRejectsInvalidCaveats() {
+	past := time.Now().Add(-time.Minute)
+	invalidIP := "not-an-ip"
+	otherWallet := "other-walletid"
+	for _, caveats := range []*Caveats{
+		{ExpiresAt: &past},
+		{IPAddress: &invalidIP},
+		{Methods: []string{"Xln.PayInvoice,Xln.Transfer"}},
+	} {
+		_, err := s.mgr.Bake(username, caveats)
+		s.Require().Error(err)
+	}
+	_, err := s.mgr.Bake("other-username", nil)
+	s.Require().Equal(models.ErrUserNotFound, err)
+	_, err = s.mgr.Bake(username, &Caveats{WalletID: &otherWallet})
+	s.Require().Equal(models.ErrWalletNotFound, err)
+}
+
+func (s *macaroonManagerSuite) TestVerifyCombinesAddedCaveats() {
+	maxAmount := uint64(1000)
+	encoded, err := s.mgr.Bake(username, &Caveats{
+		Methods:       []string{"Xln.PayInvoice", "Xln.GetWallet"},
+		MaxAmountMsat: &maxAmount,
+	})
+	s.Require().NoError(err)
+
+	// holders of macaroons may restrict them further without the root key
+	mac := s.decode(encoded)
+	s.Require().NoError(mac.AddFirstPartyCaveat([]byte("xln-methods Xln.GetWallet,Xln.Transfer")))
+	s.Require().NoError(mac.AddFirstPartyCaveat([]byte("xln-max-amount-msat 2000")))
+	s.Require().NoError(mac.AddFirstPartyCaveat([]byte("xln-max-amount-msat 500")))
+	_, caveats, err := s.mgr.Verify(s.encode(mac))
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"Xln.GetWallet"}, caveats.Methods)
+	s.Require().Equal(uint64(500), *caveats.MaxAmountMsat)
+
+	s.Require().NoError(mac.AddFirstPartyCaveat([]byte("xln-methods Xln.Transfer")))
+	_, caveats, err = s.mgr.Verify(s.encode(mac))
+	s.Require().NoError(err)
+	s.Require().NotNil(caveats.Methods)
+	s.Require().False(caveats.AllowsMethod("Xln.GetWallet"))
+	s.Require().False(caveats.AllowsMethod("Xln.Transfer"))
+}
+
+func (s *macaroonManagerSuite) TestVerifyRejectsInvalidMacaroons() {
+	encoded, err := s.mgr.Bake(username, nil)
+	s.Require().NoError(err)
+
+	_, _, err = s.mgr.Verify("not-hex")
+	s.Require().Equal(ErrInvalidMacaroon, err)
+
+	for _, condition := range []string{
+		"unknown-caveat value",
+		"xln-max-amount-msat many",
+		"time-before tomorrow",
+	} {
+		mac := s.decode(encoded)
+		s.Require().NoError(mac.AddFirstPartyCaveat([]byte(condition)))
+		_, _, err = s.mgr.Verify(s.encode(mac))
+		s.Require().Equal(ErrInvalidMacaroon, err, condition)
+	}
+
+	mac := s.decode(encoded)
+	s.Require().NoError(mac.AddFirstPartyCaveat([]byte("xln-wallet a")))
+	s.Require().NoError(mac.AddFirstPartyCaveat([]byte("xln-wallet b")))
+	_, _, err = s.mgr.Verify(s.encode(mac))
+	s.Require().Equal(ErrInvalidMacaroon, err, "caveats of different wallets conflict")
+
+	mac = s.decode(encoded)
+	s.Require().NoError(mac.AddFirstPartyCaveat([]byte(
+		"time-before " + time.Now().Add(-time.Second).UTC().Format(time.RFC3339Nano))))
+	_, _, err = s.mgr.Verify(s.encode(mac))
+	s.Require().Equal(ErrMacaroonExpired, err)
+
+	// macaroons signed with a different root key are rejected
+	forged, err := macaroon.New([]byte("forged root key"), s.decode(encoded).Id(), location, macaroon.LatestVersion)
+	s.Require().NoError(err)
+	_, _, err = s.mgr.Verify(s.encode(forged))
+	s.Require().Equal(ErrInvalidMacaroon, err)
+}
+
+func (s *macaroonManagerSuite) decode(encoded string) *macaroon.Macaroon {
+	raw, err := hex.DecodeString(encoded)
+	s.Require().NoError(err)
+	mac := &macaroon.Macaroon{}
+	s.Require().NoError(mac.UnmarshalBinary(raw))
+	return mac
+}
+
+func (s *macaroonManagerSuite) encode(mac *macaroon.Macaroon) string {
+	raw, err := mac.MarshalBinary()
+	s.Require().NoError(err)
+	return hex.EncodeToString(raw)
+}
+
+type mockRepo struct {
+	models.Repository
+
+	rootKeys map[string]*models.MacaroonRootKey
+}
+
+func (m *mockRepo) GetUser(_ *gorm.DB, user string) (*models.User, error) {
+	if user != username {
+		return nil, models.ErrUserNotFound
+	}
+	return &models.User{Username: user}, nil
+}
+
+func (m *mockRepo) GetWallet(_ *gorm.DB, user, id string) (*models.Wallet, error) {
+	if user != username || id != walletId {
+		return nil, models.ErrWalletNotFound
+	}
+	return &models.Wallet{Username: user, ID: id}, nil
+}
+
+func (m *mockRepo) CreateMacaroonRootKey(_ *gorm.DB, key *models.MacaroonRootKey) error {
+	m.rootKeys[key.ID] = key
+	return nil
+}
+
+func (m *mockRepo) GetMacaroonRootKey(_ *gorm.DB, id string) (*models.MacaroonRootKey, error) {
+	if key, ok := m.rootKeys[id]; ok {
+		return key, nil
+	}
+	return nil, models.ErrMacaroonRootKeyNotFound
+}
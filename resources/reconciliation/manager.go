@@ -0,0 +1,357 @@
+package reconciliation
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/lnd"
+	"github.com/xbit-gg/xln/models"
+)
+
+// Kinds of mismatches between XLN and LND
+const (
+	// settled in LND, but no wallet was credited
+	MismatchSettledNotCredited = "settled_not_credited"
+	// a wallet was credited, but the invoice is not settled in LND
+	MismatchCreditedNotSettled = "credited_not_settled"
+	// the amount credited to the wallet differs from the amount paid in LND
+	MismatchInvoiceAmount = "invoice_amount"
+	// still pending in XLN, but settled or cancelled in LND
+	MismatchPendingInvoice = "pending_invoice_resolved"
+	// succeeded in LND, but no wallet was debited
+	MismatchPaidNotDebited = "paid_not_debited"
+	// a wallet was debited, but the payment did not succeed in LND
+	MismatchDebitedNotPaid = "debited_not_paid"
+	// the amount or fees debited from the wallet differ from the payment in LND
+	MismatchPaymentAmount = "payment_amount"
+	// still pending in XLN, but succeeded or failed in LND
+	MismatchPendingPayment = "pending_payment_resolved"
+	// the wallet balance differs from the balance derived from its ledger entries
+	MismatchLedger = "ledger"
+)
+
+// number of invoices or payments requested from LND at a time
+var lndPageSize = uint64(1000)
+
+// Mismatch is a discrepancy between XLN and LND, or between a wallet balance and the ledger.
+type Mismatch struct {
+	Kind string
+	// PaymentHash is hex encoded, and is empty for ledger mismatches
+	PaymentHash string
+	Username    string
+	WalletID    string
+	// amounts recorded by XLN and LND respectively. For ledger mismatches, LndAmountMsat is the ledger balance.
+	XlnAmountMsat int64
+	LndAmountMsat int64
+}
+
+// Report is the result of reconciling wallets against LND.
+type Report struct {
+	Time time.Time
+
+	WalletBalanceTotal  uint64
+	PendingPaymentTotal uint64
+	ChannelLocalBalance uint64
+	// Surplus is the local balance of LND's channels less the funds owed to wallets. Pending payments are
+	// excluded from the funds owed, since they are already in flight and so not part of the local balance.
+	// A negative surplus means that wallets are owed more than the node holds.
+	Surplus int64
+
+	Mismatches []*Mismatch
+}
+
+type Manager interface {
+	// GetReport returns the latest reconciliation report. If refresh is true, or if no report has been
+	// made yet, then wallets are reconciled first.
+	GetReport(refresh bool) (*Report, error)
+}
+
+type manager struct {
+	lnClient lnrpc.LightningClient
+	db       *db.DB
+
+	mu     sync.Mutex
+	report *Report
+}
+
+// NewManager returns a Manager that reconciles wallets against LND every interval.
+// Wallets are only reconciled on demand if interval is zero.
+func NewManager(lndClient *lnd.Client, db *db.DB, interval time.Duration) Manager {
+	m := &manager{
+		lnClient: lnrpc.NewLightningClient(lndClient.Conn),
+		db:       db,
+	}
+	if interval > 0 {
+		go m.reconcilePeriodically(interval)
+	}
+
+	return m
+}
+
+func (m *manager) GetReport(refresh bool) (*Report, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if refresh || m.report == nil {
+		report, err := m.reconcile()
+		if err != nil {
+			return nil, err
+		}
+		m.report = report
+	}
+	return m.report, nil
+}
+
+func (m *manager) reconcilePeriodically(interval time.Duration) {
+	for {
+		time.Sleep(interval)
+		report, err := m.GetReport(true)
+		if err != nil {
+			log.WithError(err).Warn("Failed to reconcile wallets against LND")
+		} else if len(report.Mismatches) > 0 || report.Surplus < 0 {
+			log.WithFields(log.Fields{
+				"mismatches": len(report.Mismatches),
+				"surplus":    report.Surplus,
+			}).Warn("Wallets do not reconcile with LND")
+		} else {
+			log.Debug("Wallets reconciled with LND")
+		}
+	}
+}
+
+func (m *manager) reconcile() (*Report, error) {
+	report := &Report{Time: time.Now().UTC()}
+
+	channels, err := m.lnClient.ChannelBalance(context.Background(), &lnrpc.ChannelBalanceRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if channels.LocalBalance != nil {
+		report.ChannelLocalBalance = channels.LocalBalance.Msat
+	}
+	lndInvoices, err := m.listLndInvoices()
+	if err != nil {
+		return nil, err
+	}
+	lndPayments, err := m.listLndPayments()
+	if err != nil {
+		return nil, err
+	}
+
+	report.WalletBalanceTotal, err = m.db.Repo.GetTotalWalletBalance(m.db.DB)
+	if err != nil {
+		return nil, err
+	}
+	pendingInvoices, err := m.db.Repo.ListPendingInvoices(m.db.DB)
+	if err != nil {
+		return nil, err
+	}
+	pendingPayments, err := m.db.Repo.ListPendingPayments(m.db.DB)
+	if err != nil {
+		return nil, err
+	}
+	invoices, err := m.db.Repo.ListInvoices(m.db.DB)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := m.db.Repo.ListInvoiceTransactions(m.db.DB)
+	if err != nil {
+		return nil, err
+	}
+	mismatchedWallets, err := m.db.Repo.ListLedgerMismatchedWallets(m.db.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pp := range pendingPayments {
+		report.PendingPaymentTotal += pp.Amount
+	}
+	report.Surplus = int64(report.ChannelLocalBalance) - (int64(report.WalletBalanceTotal) - int64(report.PendingPaymentTotal))
+
+	// Incoming invoices are keyed by their base64 payment hash and outgoing payments by their hex payment hash
+	credits := make(map[string]*models.Transaction)
+	debits := make(map[string]*models.Transaction)
+	for _, transaction := range transactions {
+		if transaction.FromID == nil && transaction.ToID != nil {
+			credits[*transaction.InvoiceID] = transaction
+		} else if transaction.FromID != nil && transaction.ToID == nil {
+			debits[*transaction.InvoiceID] = transaction
+		}
+	}
+	pendingInvoiceSet := make(map[string]*models.PendingInvoice)
+	for _, pi := range pendingInvoices {
+		pendingInvoiceSet[pi.PaymentHash] = pi
+	}
+	pendingPaymentSet := make(map[string]*models.PendingPayment)
+	for _, pp := range pendingPayments {
+		pendingPaymentSet[pp.PaymentHash] = pp
+	}
+
+	for _, invoice := range invoices {
+		if invoice.RecipientID != nil {
+			if mismatch := reconcileInvoice(invoice, lndInvoices[invoice.PaymentHash],
+				credits[invoice.PaymentHash], pendingInvoiceSet[invoice.PaymentHash]); mismatch != nil {
+				report.Mismatches = append(report.Mismatches, mismatch)
+			}
+		}
+		if invoice.SenderID != nil && invoice.RecipientID == nil {
+			if mismatch := reconcilePayment(invoice, lndPayments[invoice.PaymentHash],
+				debits[invoice.PaymentHash], pendingPaymentSet[invoice.PaymentHash]); mismatch != nil {
+				report.Mismatches = append(report.Mismatches, mismatch)
+			}
+		}
+	}
+
+	for _, wallet := range mismatchedWallets {
+		ledgerBalance, err := m.db.Repo.GetWalletLedgerBalance(m.db.DB, wallet.Username, wallet.ID)
+		if err != nil {
+			return nil, err
+		}
+		report.Mismatches = append(report.Mismatches, &Mismatch{
+			Kind:          MismatchLedger,
+			Username:      wallet.Username,
+			WalletID:      wallet.ID,
+			XlnAmountMsat: int64(wallet.Balance),
+			LndAmountMsat: ledgerBalance,
+		})
+	}
+
+	return report, nil
+}
+
+// reconcileInvoice compares an invoice created by a wallet with LND. lndInvoice, credit and pending are nil if
+// there is no such record.
+func reconcileInvoice(invoice *models.Invoice, lndInvoice *lnrpc.Invoice, credit *models.Transaction,
+	pending *models.PendingInvoice) *Mismatch {
+	mismatch := &Mismatch{
+		PaymentHash: base64ToHex(invoice.PaymentHash),
+		Username:    *invoice.RecipientUsername,
+		WalletID:    *invoice.RecipientID,
+	}
+	if credit != nil {
+		mismatch.XlnAmountMsat = int64(credit.Amount)
+	}
+	if lndInvoice != nil {
+		mismatch.LndAmountMsat = lndInvoice.AmtPaidMsat
+	}
+
+	settled := lndInvoice != nil && lndInvoice.State == lnrpc.Invoice_SETTLED
+	if settled && credit == nil && pending != nil {
+		mismatch.Kind = MismatchPendingInvoice
+	} else if settled && credit == nil {
+		mismatch.Kind = MismatchSettledNotCredited
+	} else if settled && int64(credit.Amount) != lndInvoice.AmtPaidMsat {
+		mismatch.Kind = MismatchInvoiceAmount
+	} else if !settled && credit != nil {
+		mismatch.Kind = MismatchCreditedNotSettled
+	} else if lndInvoice != nil && lndInvoice.State == lnrpc.Invoice_CANCELED && pending != nil {
+		mismatch.Kind = MismatchPendingInvoice
+	} else {
+		return nil
+	}
+	return mismatch
+}
+
+// reconcilePayment compares a payment made by a wallet with LND. lndPayment, debit and pending are nil if
+// there is no such record.
+func reconcilePayment(invoice *models.Invoice, lndPayment *lnrpc.Payment, debit *models.Transaction,
+	pending *models.PendingPayment) *Mismatch {
+	mismatch := &Mismatch{
+		PaymentHash: invoice.PaymentHash,
+		Username:    *invoice.SenderUsername,
+		WalletID:    *invoice.SenderID,
+	}
+	if debit != nil {
+		mismatch.XlnAmountMsat = int64(debit.Amount + debit.FeesPaid)
+	}
+	if lndPayment != nil {
+		mismatch.LndAmountMsat = lndPayment.ValueMsat + lndPayment.FeeMsat
+	}
+
+	succeeded := lndPayment != nil && lndPayment.Status == lnrpc.Payment_SUCCEEDED
+	failed := lndPayment == nil || lndPayment.Status == lnrpc.Payment_FAILED
+	if succeeded && debit == nil && pending != nil {
+		mismatch.Kind = MismatchPendingPayment
+	} else if succeeded && debit == nil {
+		mismatch.Kind = MismatchPaidNotDebited
+	} else if succeeded && (int64(debit.Amount) != lndPayment.ValueMsat || int64(debit.FeesPaid) != lndPayment.FeeMsat) {
+		mismatch.Kind = MismatchPaymentAmount
+	} else if failed && debit != nil {
+		mismatch.Kind = MismatchDebitedNotPaid
+	} else if lndPayment != nil && lndPayment.Status == lnrpc.Payment_FAILED && pending != nil {
+		mismatch.Kind = MismatchPendingPayment
+	} else {
+		return nil
+	}
+	return mismatch
+}
+
+// listLndInvoices returns all of LND's invoices keyed by their base64 payment hash, and the settled payments to AMP
+// invoices keyed by their base64 set id.
+func (m *manager) listLndInvoices() (map[string]*lnrpc.Invoice, error) {
+	invoices := make(map[string]*lnrpc.Invoice)
+	var offset uint64
+	for {
+		res, err := m.lnClient.ListInvoices(context.Background(), &lnrpc.ListInvoiceRequest{
+			IndexOffset:    offset,
+			NumMaxInvoices: lndPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, invoice := range res.Invoices {
+			invoices[base64.StdEncoding.EncodeToString(invoice.RHash)] = invoice
+			// payments to AMP invoices are credited per set id
+			for setIdHex, state := range invoice.AmpInvoiceState {
+				if setId, err := hex.DecodeString(setIdHex); err == nil && state.State == lnrpc.InvoiceHTLCState_SETTLED {
+					invoices[base64.StdEncoding.EncodeToString(setId)] = &lnrpc.Invoice{
+						RHash:       setId,
+						State:       lnrpc.Invoice_SETTLED,
+						AmtPaidMsat: state.AmtPaidMsat,
+						IsAmp:       true,
+					}
+				}
+			}
+		}
+		if len(res.Invoices) == 0 || res.LastIndexOffset <= offset {
+			return invoices, nil
+		}
+		offset = res.LastIndexOffset
+	}
+}
+
+// listLndPayments returns all of LND's payments keyed by their hex payment hash.
+func (m *manager) listLndPayments() (map[string]*lnrpc.Payment, error) {
+	payments := make(map[string]*lnrpc.Payment)
+	var offset uint64
+	for {
+		res, err := m.lnClient.ListPayments(context.Background(), &lnrpc.ListPaymentsRequest{
+			IncludeIncomplete: true,
+			IndexOffset:       offset,
+			MaxPayments:       lndPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, payment := range res.Payments {
+			payments[payment.PaymentHash] = payment
+		}
+		if len(res.Payments) == 0 || res.LastIndexOffset <= offset {
+			return payments, nil
+		}
+		offset = res.LastIndexOffset
+	}
+}
+
+func base64ToHex(paymentHash string) string {
+	hash, err := base64.StdEncoding.DecodeString(paymentHash)
+	if err != nil {
+		return paymentHash
+	}
+	return hex.EncodeToString(hash)
+}
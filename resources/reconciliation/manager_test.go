@@ -0,0 +1,233 @@
+package reconciliation
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/suite"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"google.golang.org/grpc"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func TestReconciliationManager(t *testing.T) {
+	suite.Run(t, new(reconciliationManagerSuite))
+}
+
+type reconciliationManagerSuite struct {
+	suite.Suite
+	mgr      *manager
+	mockRepo mockRepo
+	mockLnd  mockLightningClient
+}
+
+func (s *reconciliationManagerSuite) SetupTest() {
+	var (
+		err   error
+		sqlDB *sql.DB
+	)
+	sqlDB, _, err = sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+	sDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	s.Require().NoError(err)
+	s.mockRepo = mockRepo{}
+	s.mockLnd = mockLightningClient{localBalance: 10000}
+	s.mgr = &manager{lnClient: &s.mockLnd, db: &db.DB{DB: sDB, Repo: &s.mockRepo}}
+}
+
+func hash(b byte) (b64, hexHash string, raw []byte) {
+	raw = make([]byte, 32)
+	raw[0] = b
+	return base64.StdEncoding.EncodeToString(raw), hex.EncodeToString(raw), raw
+}
+
+func (s *reconciliationManagerSuite) TestReportsMismatchesPerPaymentHash() {
+	username, walletId := "test-username", "test-walletid"
+	// incoming invoices
+	creditedB64, _, creditedRaw := hash(1)
+	uncreditedB64, uncreditedHex, uncreditedRaw := hash(2)
+	underpaidB64, underpaidHex, underpaidRaw := hash(3)
+	stuckB64, stuckHex, stuckRaw := hash(4)
+	// outgoing payments
+	_, paidHex, _ := hash(5)
+	_, undebitedHex, _ := hash(6)
+	_, failedHex, _ := hash(7)
+
+	s.mockLnd.invoices = []*lnrpc.Invoice{
+		{RHash: creditedRaw, State: lnrpc.Invoice_SETTLED, AmtPaidMsat: 1000},
+		{RHash: uncreditedRaw, State: lnrpc.Invoice_SETTLED, AmtPaidMsat: 2000},
+		{RHash: underpaidRaw, State: lnrpc.Invoice_SETTLED, AmtPaidMsat: 3000},
+		{RHash: stuckRaw, State: lnrpc.Invoice_CANCELED},
+	}
+	s.mockLnd.payments = []*lnrpc.Payment{
+		{PaymentHash: paidHex, Status: lnrpc.Payment_SUCCEEDED, ValueMsat: 500, FeeMsat: 5},
+		{PaymentHash: undebitedHex, Status: lnrpc.Payment_SUCCEEDED, ValueMsat: 600, FeeMsat: 6},
+		{PaymentHash: failedHex, Status: lnrpc.Payment_FAILED, ValueMsat: 700},
+	}
+	recipient := func(paymentHash string) *models.Invoice {
+		return &models.Invoice{PaymentHash: paymentHash, RecipientUsername: &username, RecipientID: &walletId}
+	}
+	sender := func(paymentHash string) *models.Invoice {
+		return &models.Invoice{PaymentHash: paymentHash, SenderUsername: &username, SenderID: &walletId}
+	}
+	credit := func(paymentHash string, amount uint64) *models.Transaction {
+		return &models.Transaction{InvoiceID: &paymentHash, ToUsername: &username, ToID: &walletId, Amount: amount}
+	}
+	debit := func(paymentHash string, amount, fees uint64) *models.Transaction {
+		return &models.Transaction{InvoiceID: &paymentHash, FromUsername: &username, FromID: &walletId,
+			Amount: amount, FeesPaid: fees}
+	}
+	s.mockRepo.invoices = []*models.Invoice{
+		recipient(creditedB64), recipient(uncreditedB64), recipient(underpaidB64), recipient(stuckB64),
+		sender(paidHex), sender(undebitedHex), sender(failedHex),
+	}
+	s.mockRepo.transactions = []*models.Transaction{
+		credit(creditedB64, 1000), credit(underpaidB64, 2500),
+		debit(paidHex, 500, 5), debit(failedHex, 700, 0),
+	}
+	s.mockRepo.pendingInvoices = []*models.PendingInvoice{{PaymentHash: stuckB64}}
+	s.mockRepo.pendingPayments = []*models.PendingPayment{{PaymentHash: "in-flight", Amount: 100}}
+	s.mockRepo.walletTotal = 9000
+	s.mockRepo.mismatchedWallets = []*models.Wallet{{ID: walletId, Username: username, Balance: 42}}
+	s.mockRepo.ledgerBalance = 40
+
+	report, err := s.mgr.GetReport(false)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(10000), report.ChannelLocalBalance)
+	s.Require().Equal(uint64(9000), report.WalletBalanceTotal)
+	s.Require().Equal(uint64(100), report.PendingPaymentTotal)
+	s.Require().Equal(int64(10000-(9000-100)), report.Surplus)
+
+	kinds := make(map[string]*Mismatch)
+	for _, mismatch := range report.Mismatches {
+		kinds[mismatch.Kind] = mismatch
+	}
+	s.Require().Len(report.Mismatches, 6)
+	s.Require().Equal(uncreditedHex, kinds[MismatchSettledNotCredited].PaymentHash)
+	s.Require().Equal(int64(2000), kinds[MismatchSettledNotCredited].LndAmountMsat)
+	s.Require().Equal(underpaidHex, kinds[MismatchInvoiceAmount].PaymentHash)
+	s.Require().Equal(int64(2500), kinds[MismatchInvoiceAmount].XlnAmountMsat)
+	s.Require().Equal(stuckHex, kinds[MismatchPendingInvoice].PaymentHash)
+	s.Require().Equal(undebitedHex, kinds[MismatchPaidNotDebited].PaymentHash)
+	s.Require().Equal(int64(606), kinds[MismatchPaidNotDebited].LndAmountMsat)
+	s.Require().Equal(failedHex, kinds[MismatchDebitedNotPaid].PaymentHash)
+	s.Require().Equal(walletId, kinds[MismatchLedger].WalletID)
+	s.Require().Equal(int64(42), kinds[MismatchLedger].XlnAmountMsat)
+	s.Require().Equal(int64(40), kinds[MismatchLedger].LndAmountMsat)
+}
+
+func (s *reconciliationManagerSuite) TestReportIsCachedUntilRefreshed() {
+	_, err := s.mgr.GetReport(false)
+	s.Require().NoError(err)
+	_, err = s.mgr.GetReport(false)
+	s.Require().NoError(err)
+	s.Require().Equal(1, s.mockLnd.channelBalanceCalls)
+
+	_, err = s.mgr.GetReport(true)
+	s.Require().NoError(err)
+	s.Require().Equal(2, s.mockLnd.channelBalanceCalls)
+}
+
+func (s *reconciliationManagerSuite) TestListsAllPagesFromLnd() {
+	original := lndPageSize
+	defer func() { lndPageSize = original }()
+	lndPageSize = 2
+	for i := byte(0); i < 5; i++ {
+		_, _, raw := hash(i)
+		s.mockLnd.invoices = append(s.mockLnd.invoices, &lnrpc.Invoice{RHash: raw})
+		s.mockLnd.payments = append(s.mockLnd.payments, &lnrpc.Payment{PaymentHash: hex.EncodeToString(raw)})
+	}
+
+	invoices, err := s.mgr.listLndInvoices()
+	s.Require().NoError(err)
+	s.Require().Len(invoices, 5)
+	payments, err := s.mgr.listLndPayments()
+	s.Require().NoError(err)
+	s.Require().Len(payments, 5)
+}
+
+type mockLightningClient struct {
+	lnrpc.LightningClient
+
+	localBalance        uint64
+	invoices            []*lnrpc.Invoice
+	payments            []*lnrpc.Payment
+	channelBalanceCalls int
+}
+
+func (m *mockLightningClient) ChannelBalance(_ context.Context, _ *lnrpc.ChannelBalanceRequest, _ ...grpc.CallOption) (*lnrpc.ChannelBalanceResponse, error) {
+	m.channelBalanceCalls++
+	return &lnrpc.ChannelBalanceResponse{LocalBalance: &lnrpc.Amount{Msat: m.localBalance}}, nil
+}
+
+func (m *mockLightningClient) ListInvoices(_ context.Context, in *lnrpc.ListInvoiceRequest, _ ...grpc.CallOption) (*lnrpc.ListInvoiceResponse, error) {
+	start, end := page(in.IndexOffset, in.NumMaxInvoices, len(m.invoices))
+	return &lnrpc.ListInvoiceResponse{Invoices: m.invoices[start:end], LastIndexOffset: uint64(end)}, nil
+}
+
+func (m *mockLightningClient) ListPayments(_ context.Context, in *lnrpc.ListPaymentsRequest, _ ...grpc.CallOption) (*lnrpc.ListPaymentsResponse, error) {
+	start, end := page(in.IndexOffset, in.MaxPayments, len(m.payments))
+	return &lnrpc.ListPaymentsResponse{Payments: m.payments[start:end], LastIndexOffset: uint64(end)}, nil
+}
+
+func page(offset, max uint64, total int) (int, int) {
+	start := int(offset)
+	if start > total {
+		start = total
+	}
+	end := start + int(max)
+	if end > total {
+		end = total
+	}
+	return start, end
+}
+
+type mockRepo struct {
+	models.Repository
+
+	walletTotal       uint64
+	pendingInvoices   []*models.PendingInvoice
+	pendingPayments   []*models.PendingPayment
+	invoices          []*models.Invoice
+	transactions      []*models.Transaction
+	mismatchedWallets []*models.Wallet
+	ledgerBalance     int64
+}
+
+func (m *mockRepo) GetTotalWalletBalance(_ *gorm.DB) (uint64, error) {
+	return m.walletTotal, nil
+}
+
+func (m *mockRepo) ListPendingInvoices(_ *gorm.DB) ([]*models.PendingInvoice, error) {
+	return m.pendingInvoices, nil
+}
+
+func (m *mockRepo) ListPendingPayments(_ *gorm.DB) ([]*models.PendingPayment, error) {
+	return m.pendingPayments, nil
+}
+
+func (m *mockRepo) ListInvoices(_ *gorm.DB) ([]*models.Invoice, error) {
+	return m.invoices, nil
+}
+
+func (m *mockRepo) ListInvoiceTransactions(_ *gorm.DB) ([]*models.Transaction, error) {
+	return m.transactions, nil
+}
+
+func (m *mockRepo) ListLedgerMismatchedWallets(_ *gorm.DB) ([]*models.Wallet, error) {
+	return m.mismatchedWallets, nil
+}
+
+func (m *mockRepo) GetWalletLedgerBalance(_ *gorm.DB, _, _ string) (int64, error) {
+	return m.ledgerBalance, nil
+}
@@ -0,0 +1,96 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// shortest interval between the payments of a schedule
+const minInterval = time.Minute
+
+// Interval is the time between the payments of a schedule.
+type Interval interface {
+	// Next returns the time of the first payment after t of a schedule whose first payment is at start.
+	Next(start, t time.Time) time.Time
+}
+
+// fixedInterval is a constant duration between payments.
+type fixedInterval time.Duration
+
+func (i fixedInterval) Next(start, t time.Time) time.Time {
+	if t.Before(start) {
+		return start
+	}
+	return start.Add((t.Sub(start)/time.Duration(i) + 1) * time.Duration(i))
+}
+
+// calendarInterval is a number of calendar months and days between payments. The nth payment is made n intervals
+// after the first, on the last day of the month if the day of the first payment does not exist in it, e.g. a monthly
+// payment that starts on the 31st of January is made on the 28th of February and then on the 31st of March.
+type calendarInterval struct {
+	months, days int
+}
+
+func (i calendarInterval) Next(start, t time.Time) time.Time {
+	// starts from an estimate of the payments made until t, which is at most one too many
+	var n int
+	if i.months > 0 {
+		n = ((t.Year()-start.Year())*12 + int(t.Month()-start.Month())) / i.months
+	} else {
+		n = int(t.Sub(start) / (time.Duration(i.days) * 24 * time.Hour))
+	}
+	if n > 0 {
+		n--
+	} else {
+		n = 0
+	}
+	for !i.nth(start, n).After(t) {
+		n++
+	}
+	return i.nth(start, n)
+}
+
+// nth returns the time of the payment that is made n intervals after the first payment at start.
+func (i calendarInterval) nth(start time.Time, n int) time.Time {
+	year, month, day := start.Date()
+	months := int(month) - 1 + n*i.months
+	year, month = year+months/12, time.Month(months%12+1)
+	// the 0th day of the following month is the last day of the month
+	if last := time.Date(year, month+1, 0, 0, 0, 0, 0, start.Location()).Day(); day > last {
+		day = last
+	}
+	hour, min, sec := start.Clock()
+	return time.Date(year, month, day+n*i.days, hour, min, sec, start.Nanosecond(), start.Location())
+}
+
+// ParseInterval parses the specification of an interval, which is one of @hourly, @daily, @weekly, @monthly and
+// @yearly, or @every followed by a duration such as 36h.
+// Errors if the specification is invalid or the interval is shorter than a minute.
+func ParseInterval(spec string) (Interval, error) {
+	switch spec {
+	case "@hourly":
+		return fixedInterval(time.Hour), nil
+	case "@daily":
+		return calendarInterval{days: 1}, nil
+	case "@weekly":
+		return calendarInterval{days: 7}, nil
+	case "@monthly":
+		return calendarInterval{months: 1}, nil
+	case "@yearly", "@annually":
+		return calendarInterval{months: 12}, nil
+	}
+	if !strings.HasPrefix(spec, "@every ") {
+		return nil, fmt.Errorf("invalid interval %q", spec)
+	}
+	d, err := time.ParseDuration(strings.TrimPrefix(spec, "@every "))
+	if err != nil {
+		return nil, fmt.Errorf("invalid interval %q: %v", spec, err)
+	} else if d < minInterval {
+		return nil, fmt.Errorf("interval must be at least %s", minInterval)
+	} else if d%time.Second != 0 {
+		return nil, errors.New("interval must be a whole number of seconds")
+	}
+	return fixedInterval(d), nil
+}
@@ -0,0 +1,62 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInterval(t *testing.T) {
+	start := time.Date(2022, 1, 31, 12, 0, 0, 0, time.UTC)
+	for spec, next := range map[string]time.Time{
+		"@hourly":         start.Add(time.Hour),
+		"@daily":          time.Date(2022, 2, 1, 12, 0, 0, 0, time.UTC),
+		"@weekly":         time.Date(2022, 2, 7, 12, 0, 0, 0, time.UTC),
+		"@monthly":        time.Date(2022, 2, 28, 12, 0, 0, 0, time.UTC),
+		"@yearly":         time.Date(2023, 1, 31, 12, 0, 0, 0, time.UTC),
+		"@every 90m":      start.Add(90 * time.Minute),
+		"@every 1h30m15s": start.Add(90*time.Minute + 15*time.Second),
+	} {
+		interval, err := ParseInterval(spec)
+		require.NoError(t, err, spec)
+		require.Equal(t, next, interval.Next(start, start), spec)
+	}
+
+	for _, spec := range []string{"", "daily", "@every", "@every 59s", "@every 1m0.5s", "@every -1h", "0 * * * *"} {
+		_, err := ParseInterval(spec)
+		require.Error(t, err, spec)
+	}
+}
+
+func TestIntervalsAreCountedFromStart(t *testing.T) {
+	start := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+	monthly, err := ParseInterval("@monthly")
+	require.NoError(t, err)
+	var runs []time.Time
+	for next := start; len(runs) < 4; {
+		next = monthly.Next(start, next)
+		runs = append(runs, next)
+	}
+	require.Equal(t, []time.Time{
+		time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 30, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, 5, 31, 12, 0, 0, 0, time.UTC),
+	}, runs, "payments are made on the last day of months that are too short")
+	require.Equal(t, time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC),
+		monthly.Next(start, time.Date(2024, 12, 31, 12, 0, 0, 0, time.UTC)))
+	require.Equal(t, start, monthly.Next(start, start.Add(-time.Hour)), "the first payment is at the start")
+
+	yearly, err := ParseInterval("@yearly")
+	require.NoError(t, err)
+	leap := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), yearly.Next(leap, leap))
+	require.Equal(t, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		yearly.Next(leap, time.Date(2027, 2, 28, 0, 0, 0, 0, time.UTC)))
+
+	every, err := ParseInterval("@every 90m")
+	require.NoError(t, err)
+	require.Equal(t, start.Add(3*time.Hour), every.Next(start, start.Add(100*time.Minute)),
+		"payments stay on the times of the first payment plus whole intervals")
+}
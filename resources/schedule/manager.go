@@ -0,0 +1,310 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	golnurl "github.com/fiatjaf/go-lnurl"
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/resources/invoice"
+	"github.com/xbit-gg/xln/util"
+)
+
+// type of the keysend custom record that carries the memo of a payment
+const keysendMessageType = 34349334
+
+var (
+	// interval at which due payments are checked for
+	pollInterval = time.Minute
+	// maximum number of payments started on each poll
+	batchSize = 50
+)
+
+type Manager interface {
+	// CreateSchedule validates and adds a schedule of payments from the wallet of the schedule to the one target
+	// that it sets. The first payment is made at NextRun, or as soon as possible if it is not set.
+	CreateSchedule(schedule *models.Schedule) error
+
+	// ListSchedules lists the schedules of the wallet, including those that are no longer active.
+	ListSchedules(username, walletId string) ([]*models.Schedule, error)
+
+	// CancelSchedule stops the payments of an active schedule of the wallet.
+	CancelSchedule(username, walletId, id string) error
+}
+
+type manager struct {
+	db         *db.DB
+	invoices   invoice.Manager
+	maxPayment int64
+	// fetchInvoice requests an invoice of amount from an LNURL-pay link or lightning address
+	fetchInvoice func(lnurl string, amount int64, comment string) (string, error)
+}
+
+func NewManager(db *db.DB, invoiceManager invoice.Manager, maxPayment int64) Manager {
+	m := &manager{
+		db:           db,
+		invoices:     invoiceManager,
+		maxPayment:   maxPayment,
+		fetchInvoice: fetchLNURLInvoice,
+	}
+	m.handleMissedRuns()
+	go m.runSchedules()
+
+	return m
+}
+
+func (m *manager) CreateSchedule(schedule *models.Schedule) error {
+	if _, err := m.db.Repo.GetWallet(m.db.DB, schedule.Username, schedule.WalletID); err != nil {
+		return err
+	} else if schedule.Amount == 0 {
+		return errors.New("amount must be positive")
+	} else if schedule.Amount > uint64(m.maxPayment) {
+		return fmt.Errorf("size %d msat is greater than the maximum payment size", schedule.Amount)
+	} else if _, err := ParseInterval(schedule.Interval); err != nil {
+		return err
+	} else if err := util.ValidateMemo(schedule.Memo); err != nil {
+		return err
+	}
+	if err := m.validateTarget(schedule); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	if schedule.NextRun.IsZero() || schedule.NextRun.Before(now) {
+		schedule.NextRun = now
+	} else {
+		// times are compared for equality when payments are claimed, so they are kept to a precision that
+		// every database stores
+		schedule.NextRun = schedule.NextRun.UTC().Truncate(time.Second)
+	}
+	if schedule.EndsAt != nil && !schedule.EndsAt.After(schedule.NextRun) {
+		return errors.New("end time must be after the time of the first payment")
+	}
+	schedule.StartsAt = schedule.NextRun
+	schedule.Status = models.ScheduleActive
+	return m.db.Repo.CreateSchedule(m.db.DB, schedule)
+}
+
+// validateTarget checks that the schedule sets exactly one target, which is valid, and sets the kind of target.
+func (m *manager) validateTarget(schedule *models.Schedule) error {
+	var targets int
+	if schedule.TargetUsername != nil || schedule.TargetWalletID != nil {
+		if schedule.TargetUsername == nil || schedule.TargetWalletID == nil {
+			return errors.New("target wallet requires both a username and a wallet id")
+		} else if *schedule.TargetUsername == schedule.Username && *schedule.TargetWalletID == schedule.WalletID {
+			return errors.New("target wallet must be different from the paying wallet")
+		}
+		target, err := m.db.Repo.GetWallet(m.db.DB, *schedule.TargetUsername, *schedule.TargetWalletID)
+		if *schedule.TargetUsername != schedule.Username && (err != nil || !target.AcceptsTransfers) {
+			// the wallets of other users are only paid if they opted in
+			return models.ErrTransfersNotAccepted
+		} else if err != nil {
+			return errors.New("target wallet does not exist")
+		}
+		schedule.TargetKind = models.ScheduleTargetWallet
+		targets++
+	}
+	if schedule.TargetPubkey != nil {
+		if err := util.ValidatePubkey(*schedule.TargetPubkey); err != nil {
+			return err
+		}
+		schedule.TargetKind = models.ScheduleTargetKeysend
+		targets++
+	}
+	if schedule.TargetLNURL != nil {
+		if _, _, ok := golnurl.ParseInternetIdentifier(*schedule.TargetLNURL); !ok {
+			if lnurl, ok := golnurl.FindLNURLInText(*schedule.TargetLNURL); !ok || len(lnurl) != len(*schedule.TargetLNURL) {
+				return errors.New("target must be a bech32 encoded LNURL or a lightning address")
+			}
+		}
+		schedule.TargetKind = models.ScheduleTargetLNURL
+		targets++
+	}
+	if targets != 1 {
+		return errors.New("exactly one of a wallet, a keysend pubkey or an LNURL must be targeted")
+	}
+	return nil
+}
+
+func (m *manager) ListSchedules(username, walletId string) ([]*models.Schedule, error) {
+	return m.db.Repo.ListWalletSchedules(m.db.DB, username, walletId)
+}
+
+func (m *manager) CancelSchedule(username, walletId, id string) error {
+	return m.db.Repo.CancelSchedule(m.db.DB, username, walletId, id)
+}
+
+// handleMissedRuns starts the payments that became due while XLN was offline.
+func (m *manager) handleMissedRuns() {
+	now := time.Now().UTC()
+	schedules, err := m.db.Repo.ListDueSchedules(m.db.DB, now, batchSize)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to get due schedules from DB")
+	}
+	if len(schedules) > 0 {
+		log.WithField("total", len(schedules)).Info("Handling scheduled payments that were due while XLN was offline")
+	}
+	for _, s := range schedules {
+		log.WithField("schedule", s.ID).Debug("Handling missed scheduled payment")
+		go m.run(s, now)
+	}
+}
+
+func (m *manager) runSchedules() {
+	for {
+		time.Sleep(pollInterval)
+		now := time.Now().UTC()
+		schedules, err := m.db.Repo.ListDueSchedules(m.db.DB, now, batchSize)
+		if err != nil {
+			log.WithError(err).Warn("Failed to get due schedules")
+			continue
+		}
+		for _, s := range schedules {
+			go m.run(s, now)
+		}
+	}
+}
+
+// run makes the payment of the schedule that is due at time now. If several payments are due, because XLN was
+// offline when they were, then only the latest is made and the others are recorded as failed.
+// The schedule is advanced before paying, so that a payment is never made twice.
+func (m *manager) run(s *models.Schedule, now time.Time) {
+	interval, err := ParseInterval(s.Interval)
+	if err != nil {
+		log.WithError(err).WithField("schedule", s.ID).Error("Failed to parse interval of schedule")
+		return
+	}
+	// schedules created before their first payment was recorded are counted from their next payment
+	start := s.StartsAt
+	if start.IsZero() {
+		start = s.NextRun
+	}
+	due, next := s.NextRun, interval.Next(start, s.NextRun)
+	var missed uint64
+	for !next.After(now) && (s.EndsAt == nil || !next.After(*s.EndsAt)) {
+		missed++
+		due, next = next, interval.Next(start, next)
+	}
+	status := models.ScheduleActive
+	if s.EndsAt != nil && next.After(*s.EndsAt) {
+		status = models.ScheduleCompleted
+	}
+	if claimed, err := m.db.Repo.AdvanceSchedule(m.db.DB, s.ID, s.NextRun, next, status); err != nil || !claimed {
+		return
+	}
+	if missed > 0 {
+		m.record(&models.ScheduleRun{
+			ScheduleID:    s.ID,
+			DueAt:         s.NextRun,
+			Amount:        s.Amount,
+			FailureReason: fmt.Sprintf("%d payments were missed while XLN was offline", missed),
+		})
+	}
+
+	run := &models.ScheduleRun{ScheduleID: s.ID, DueAt: due, Amount: s.Amount}
+	payment, err := m.pay(s)
+	if err != nil {
+		run.FailureReason = err.Error()
+	} else {
+		run.Success = payment.Success
+		run.FeesPaid = payment.FeeMsat
+		run.PaymentHash = payment.PaymentHash
+		run.FailureReason = payment.FailureReason
+	}
+	if !run.Success {
+		log.WithFields(log.Fields{
+			"schedule": s.ID,
+			"reason":   run.FailureReason,
+		}).Warn("Scheduled payment failed")
+	}
+	m.record(run)
+}
+
+func (m *manager) record(run *models.ScheduleRun) {
+	if err := m.db.Repo.CreateScheduleRun(m.db.DB, run); err != nil {
+		log.WithError(err).WithField("schedule", run.ScheduleID).Error("Failed to record scheduled payment")
+	}
+}
+
+// pay makes a payment of the schedule to its target, and waits for it to complete.
+func (m *manager) pay(s *models.Schedule) (*invoice.Payment, error) {
+	if err := m.checkCredentials(s); err != nil {
+		return nil, err
+	}
+	amount := int64(s.Amount)
+	switch s.TargetKind {
+	case models.ScheduleTargetWallet:
+		if *s.TargetUsername != s.Username {
+			// the wallets of other users may have opted out since the schedule was created
+			target, err := m.db.Repo.GetWallet(m.db.DB, *s.TargetUsername, *s.TargetWalletID)
+			if err != nil || !target.AcceptsTransfers {
+				return nil, models.ErrTransfersNotAccepted
+			}
+		}
+		inv, err := m.invoices.CreateInvoice(*s.TargetUsername, *s.TargetWalletID, s.Memo, nil, amount, 0)
+		if err != nil {
+			return nil, err
+		}
+		return m.invoices.PayInvoice(s.Username, s.WalletID, inv.PaymentRequest, true, nil)
+	case models.ScheduleTargetKeysend:
+		var records map[uint64][]byte
+		if s.Memo != "" {
+			records = map[uint64][]byte{keysendMessageType: []byte(s.Memo)}
+		}
+		return m.invoices.SendKeysend(s.Username, s.WalletID, *s.TargetPubkey, amount, records, nil)
+	case models.ScheduleTargetLNURL:
+		pr, err := m.fetchInvoice(*s.TargetLNURL, amount, s.Memo)
+		if err != nil {
+			return nil, err
+		}
+		return m.invoices.PayInvoice(s.Username, s.WalletID, pr, true, nil)
+	default:
+		return nil, fmt.Errorf("unknown target kind %q", s.TargetKind)
+	}
+}
+
+// checkCredentials errors if the credentials that created the schedule were revoked or expired, after which the
+// payments of the schedule fail.
+func (m *manager) checkCredentials(s *models.Schedule) error {
+	now := time.Now().UTC()
+	if s.CredentialsExpireAt != nil && !now.Before(*s.CredentialsExpireAt) {
+		return errors.New("the credentials that created the schedule expired")
+	} else if s.ApiKeyID == nil {
+		return nil
+	}
+	key, err := m.db.Repo.GetUserApiKey(m.db.DB, s.Username, *s.ApiKeyID)
+	if err == models.ErrApiKeyNotFound {
+		return errors.New("the api key that created the schedule was revoked")
+	} else if err != nil {
+		return err
+	} else if key.Expired(now) {
+		return errors.New("the api key that created the schedule expired")
+	}
+	return nil
+}
+
+// fetchLNURLInvoice requests an invoice of amount from an LNURL-pay link or lightning address. The memo is sent
+// as a comment if it is allowed. The invoice is checked to commit to the amount and the metadata of the link.
+func fetchLNURLInvoice(lnurl string, amount int64, comment string) (string, error) {
+	_, params, err := golnurl.HandleLNURL(lnurl)
+	if err != nil {
+		return "", err
+	}
+	payParams, ok := params.(golnurl.LNURLPayParams)
+	if !ok {
+		return "", errors.New("target is not an LNURL-pay link")
+	} else if amount < payParams.MinSendable || amount > payParams.MaxSendable {
+		return "", fmt.Errorf("amount must be between %d and %d msat", payParams.MinSendable, payParams.MaxSendable)
+	}
+	if int64(len(comment)) > payParams.CommentAllowed {
+		comment = ""
+	}
+	values, err := payParams.Call(amount, comment, nil)
+	if err != nil {
+		return "", err
+	}
+	return values.PR, nil
+}
@@ -0,0 +1,363 @@
+package schedule
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/resources/invoice"
+	"gorm.io/gorm"
+)
+
+const testPubkey = "02a1633cafcc01ebfb6d78e39f687a1f0995c62fc95f51ead10a02ee0be551b5dc"
+
+type mockRepo struct {
+	models.Repository
+	wallets  map[string]*models.Wallet
+	apiKeys  map[string]*models.ApiKey
+	created  []*models.Schedule
+	advanced []*models.Schedule
+	runs     []*models.ScheduleRun
+	claimed  bool
+}
+
+func (r *mockRepo) GetWallet(tx *gorm.DB, username, walletId string) (*models.Wallet, error) {
+	if wallet, ok := r.wallets[username+"/"+walletId]; ok {
+		return wallet, nil
+	}
+	return nil, models.ErrWalletNotFound
+}
+
+func (r *mockRepo) GetUserApiKey(tx *gorm.DB, username, id string) (*models.ApiKey, error) {
+	if key, ok := r.apiKeys[id]; ok && key.Username == username {
+		return key, nil
+	}
+	return nil, models.ErrApiKeyNotFound
+}
+
+func (r *mockRepo) CreateSchedule(tx *gorm.DB, schedule *models.Schedule) error {
+	r.created = append(r.created, schedule)
+	return nil
+}
+
+func (r *mockRepo) AdvanceSchedule(tx *gorm.DB, id string, due, next time.Time, status string) (bool, error) {
+	r.advanced = append(r.advanced, &models.Schedule{ID: id, NextRun: next, Status: status})
+	return r.claimed, nil
+}
+
+func (r *mockRepo) CreateScheduleRun(tx *gorm.DB, run *models.ScheduleRun) error {
+	r.runs = append(r.runs, run)
+	return nil
+}
+
+type mockInvoices struct {
+	invoice.Manager
+	invoices  []string
+	paid      []string
+	keysends  []string
+	keysendMs map[uint64][]byte
+}
+
+func (m *mockInvoices) CreateInvoice(username, walletId, memo string, descriptionHash []byte, value int64,
+	expiry int64) (*models.Invoice, error) {
+	m.invoices = append(m.invoices, username+"/"+walletId)
+	return &models.Invoice{PaymentRequest: "lnbc-" + walletId}, nil
+}
+
+func (m *mockInvoices) PayInvoice(username, walletId, pr string, sync bool,
+	options *invoice.PaymentOptions) (*invoice.Payment, error) {
+	m.paid = append(m.paid, pr)
+	return &invoice.Payment{PaymentHash: "hash-" + pr, Success: true, FeeMsat: 1}, nil
+}
+
+func (m *mockInvoices) SendKeysend(username, walletId, destination string, amount int64,
+	customRecords map[uint64][]byte, options *invoice.PaymentOptions) (*invoice.Payment, error) {
+	m.keysends = append(m.keysends, destination)
+	m.keysendMs = customRecords
+	return &invoice.Payment{PaymentHash: "keysend-hash", FailureReason: "FAILURE_REASON_NO_ROUTE"}, nil
+}
+
+// newTestManager returns a manager that is constructed directly, so that the scheduler is not started.
+func newTestManager() (*manager, *mockRepo, *mockInvoices) {
+	repo := &mockRepo{
+		wallets: map[string]*models.Wallet{
+			"alice/wallet":   {Username: "alice", ID: "wallet"},
+			"alice/savings":  {Username: "alice", ID: "savings"},
+			"bob/wallet":     {Username: "bob", ID: "wallet"},
+			"carol/donation": {Username: "carol", ID: "donation", AcceptsTransfers: true},
+		},
+		claimed: true,
+	}
+	invoices := &mockInvoices{}
+	m := &manager{
+		db:         &db.DB{Repo: repo},
+		invoices:   invoices,
+		maxPayment: 1000000,
+		fetchInvoice: func(lnurl string, amount int64, comment string) (string, error) {
+			return "lnbc-" + lnurl, nil
+		},
+	}
+	return m, repo, invoices
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestCreateSchedule(t *testing.T) {
+	m, repo, _ := newTestManager()
+	schedule := &models.Schedule{
+		Username:     "alice",
+		WalletID:     "wallet",
+		TargetPubkey: strPtr(testPubkey),
+		Amount:       1000,
+		Interval:     "@daily",
+	}
+	require.NoError(t, m.CreateSchedule(schedule))
+	require.Len(t, repo.created, 1)
+	require.Equal(t, models.ScheduleTargetKeysend, schedule.TargetKind)
+	require.Equal(t, models.ScheduleActive, schedule.Status)
+	require.WithinDuration(t, time.Now(), schedule.NextRun, 2*time.Second, "the first payment defaults to now")
+	require.Zero(t, schedule.NextRun.Nanosecond())
+	require.Equal(t, schedule.NextRun, schedule.StartsAt)
+
+	for _, target := range []*models.Schedule{
+		{TargetUsername: strPtr("alice"), TargetWalletID: strPtr("savings")},
+		{TargetUsername: strPtr("carol"), TargetWalletID: strPtr("donation")},
+		{TargetLNURL: strPtr("alice@example.com")},
+		{TargetLNURL: strPtr("LNURL1DP68GURN8GHJ7UM9WFMXJCM99E3K7MF0V9CXJ0M385EKVCENXC6R2C35XVUKXEFCV5MKVV34X5EKZD3EV56NYD3HXQURZEPEXEJXXEPNXSCRVWFNV9NXZCN9XQ6XYEFHVGCXXCMYXYMNSERXFQ5FNS")},
+	} {
+		target.Username, target.WalletID, target.Amount, target.Interval = "alice", "wallet", 1000, "@weekly"
+		require.NoError(t, m.CreateSchedule(target))
+	}
+	require.Equal(t, models.ScheduleTargetWallet, repo.created[1].TargetKind)
+	require.Equal(t, models.ScheduleTargetLNURL, repo.created[4].TargetKind)
+}
+
+func TestCreateScheduleRejectsInvalidSchedules(t *testing.T) {
+	m, repo, _ := newTestManager()
+	valid := func() *models.Schedule {
+		return &models.Schedule{
+			Username:     "alice",
+			WalletID:     "wallet",
+			TargetPubkey: strPtr(testPubkey),
+			Amount:       1000,
+			Interval:     "@daily",
+		}
+	}
+	past := time.Now().Add(-time.Hour)
+	for name, modify := range map[string]func(s *models.Schedule){
+		"missing wallet":    func(s *models.Schedule) { s.WalletID = "missing" },
+		"zero amount":       func(s *models.Schedule) { s.Amount = 0 },
+		"amount over max":   func(s *models.Schedule) { s.Amount = 1000001 },
+		"invalid interval":  func(s *models.Schedule) { s.Interval = "@every 1s" },
+		"invalid memo":      func(s *models.Schedule) { s.Memo = "\x00" },
+		"no target":         func(s *models.Schedule) { s.TargetPubkey = nil },
+		"two targets":       func(s *models.Schedule) { s.TargetLNURL = strPtr("alice@example.com") },
+		"invalid pubkey":    func(s *models.Schedule) { s.TargetPubkey = strPtr("02ab") },
+		"invalid lnurl":     func(s *models.Schedule) { s.TargetPubkey, s.TargetLNURL = nil, strPtr("example.com") },
+		"ends before start": func(s *models.Schedule) { s.EndsAt = &past },
+		"partial wallet": func(s *models.Schedule) {
+			s.TargetPubkey, s.TargetUsername = nil, strPtr("alice")
+		},
+		"own wallet": func(s *models.Schedule) {
+			s.TargetPubkey, s.TargetUsername, s.TargetWalletID = nil, strPtr("alice"), strPtr("wallet")
+		},
+		"missing target wallet": func(s *models.Schedule) {
+			s.TargetPubkey, s.TargetUsername, s.TargetWalletID = nil, strPtr("alice"), strPtr("missing")
+		},
+	} {
+		schedule := valid()
+		modify(schedule)
+		require.Error(t, m.CreateSchedule(schedule), name)
+	}
+
+	schedule := valid()
+	schedule.TargetPubkey, schedule.TargetUsername, schedule.TargetWalletID = nil, strPtr("bob"), strPtr("wallet")
+	require.Equal(t, models.ErrTransfersNotAccepted, m.CreateSchedule(schedule))
+	require.Empty(t, repo.created)
+}
+
+func TestRunMakesLatestDuePaymentAndRecordsMissedPayments(t *testing.T) {
+	m, repo, invoices := newTestManager()
+	now := time.Date(2022, 5, 10, 12, 0, 0, 0, time.UTC)
+	schedule := &models.Schedule{
+		ID:             "schedule",
+		Username:       "alice",
+		WalletID:       "wallet",
+		TargetKind:     models.ScheduleTargetWallet,
+		TargetUsername: strPtr("alice"),
+		TargetWalletID: strPtr("savings"),
+		Amount:         1000,
+		Interval:       "@daily",
+		NextRun:        now.Add(-50 * time.Hour),
+		Status:         models.ScheduleActive,
+	}
+	m.run(schedule, now)
+
+	require.Equal(t, []*models.Schedule{{ID: "schedule", NextRun: now.Add(22 * time.Hour), Status: models.ScheduleActive}},
+		repo.advanced)
+	require.Equal(t, []string{"alice/savings"}, invoices.invoices)
+	require.Equal(t, []string{"lnbc-savings"}, invoices.paid)
+	require.Len(t, repo.runs, 2)
+	require.False(t, repo.runs[0].Success)
+	require.Equal(t, schedule.NextRun, repo.runs[0].DueAt)
+	require.True(t, strings.HasPrefix(repo.runs[0].FailureReason, "2 payments were missed"))
+	require.True(t, repo.runs[1].Success)
+	require.Equal(t, now.Add(-2*time.Hour), repo.runs[1].DueAt)
+	require.Equal(t, "hash-lnbc-savings", repo.runs[1].PaymentHash)
+	require.Equal(t, uint64(1), repo.runs[1].FeesPaid)
+}
+
+func TestRunCountsPaymentsFromStart(t *testing.T) {
+	m, repo, _ := newTestManager()
+	now := time.Date(2022, 2, 28, 12, 0, 0, 0, time.UTC)
+	schedule := &models.Schedule{
+		ID:           "schedule",
+		TargetKind:   models.ScheduleTargetKeysend,
+		TargetPubkey: strPtr(testPubkey),
+		Amount:       1000,
+		Interval:     "@monthly",
+		StartsAt:     time.Date(2022, 1, 31, 12, 0, 0, 0, time.UTC),
+		NextRun:      now,
+		Status:       models.ScheduleActive,
+	}
+	m.run(schedule, now)
+
+	require.Equal(t, []*models.Schedule{{ID: "schedule", NextRun: time.Date(2022, 3, 31, 12, 0, 0, 0, time.UTC),
+		Status: models.ScheduleActive}}, repo.advanced)
+}
+
+func TestRunCompletesScheduleAfterLastPayment(t *testing.T) {
+	m, repo, invoices := newTestManager()
+	now := time.Date(2022, 5, 10, 12, 0, 0, 0, time.UTC)
+	endsAt := now.Add(time.Hour)
+	schedule := &models.Schedule{
+		ID:           "schedule",
+		TargetKind:   models.ScheduleTargetKeysend,
+		TargetPubkey: strPtr(testPubkey),
+		Amount:       1000,
+		Memo:         "rent",
+		Interval:     "@daily",
+		NextRun:      now,
+		EndsAt:       &endsAt,
+		Status:       models.ScheduleActive,
+	}
+	m.run(schedule, now)
+
+	require.Equal(t, models.ScheduleCompleted, repo.advanced[0].Status)
+	require.Equal(t, []string{testPubkey}, invoices.keysends)
+	require.Equal(t, []byte("rent"), invoices.keysendMs[keysendMessageType])
+	require.Len(t, repo.runs, 1)
+	require.False(t, repo.runs[0].Success)
+	require.Equal(t, "FAILURE_REASON_NO_ROUTE", repo.runs[0].FailureReason)
+}
+
+func TestRunSkipsPaymentClaimedElsewhere(t *testing.T) {
+	m, repo, invoices := newTestManager()
+	repo.claimed = false
+	now := time.Now().UTC()
+	m.run(&models.Schedule{
+		ID:          "schedule",
+		TargetKind:  models.ScheduleTargetLNURL,
+		TargetLNURL: strPtr("alice@example.com"),
+		Amount:      1000,
+		Interval:    "@hourly",
+		NextRun:     now,
+		Status:      models.ScheduleActive,
+	}, now)
+
+	require.Len(t, repo.advanced, 1)
+	require.Empty(t, invoices.paid)
+	require.Empty(t, repo.runs)
+}
+
+func TestRunRecordsFailureToFetchInvoice(t *testing.T) {
+	m, repo, invoices := newTestManager()
+	m.fetchInvoice = func(lnurl string, amount int64, comment string) (string, error) {
+		return "", errors.New("amount must be between 1000 and 5000 msat")
+	}
+	now := time.Now().UTC()
+	m.run(&models.Schedule{
+		ID:          "schedule",
+		TargetKind:  models.ScheduleTargetLNURL,
+		TargetLNURL: strPtr("alice@example.com"),
+		Amount:      100,
+		Interval:    "@hourly",
+		NextRun:     now,
+		Status:      models.ScheduleActive,
+	}, now)
+
+	require.Empty(t, invoices.paid)
+	require.Len(t, repo.runs, 1)
+	require.Equal(t, "amount must be between 1000 and 5000 msat", repo.runs[0].FailureReason)
+}
+
+func TestRunFailsOnceTargetOptsOut(t *testing.T) {
+	m, repo, invoices := newTestManager()
+	now := time.Now().UTC()
+	schedule := &models.Schedule{
+		ID:             "schedule",
+		Username:       "alice",
+		WalletID:       "wallet",
+		TargetKind:     models.ScheduleTargetWallet,
+		TargetUsername: strPtr("carol"),
+		TargetWalletID: strPtr("donation"),
+		Amount:         1000,
+		Interval:       "@hourly",
+		NextRun:        now,
+		Status:         models.ScheduleActive,
+	}
+	m.run(schedule, now)
+	require.Equal(t, []string{"lnbc-donation"}, invoices.paid)
+
+	repo.wallets["carol/donation"].AcceptsTransfers = false
+	m.run(schedule, now)
+
+	require.Equal(t, []string{"carol/donation"}, invoices.invoices, "no invoice is created for wallets that opted out")
+	require.Len(t, repo.runs, 2)
+	require.True(t, repo.runs[0].Success)
+	require.False(t, repo.runs[1].Success)
+	require.Equal(t, models.ErrTransfersNotAccepted.Error(), repo.runs[1].FailureReason)
+}
+
+func TestRunFailsOnceCredentialsAreRevokedOrExpire(t *testing.T) {
+	m, repo, invoices := newTestManager()
+	now := time.Now().UTC()
+	past := now.Add(-time.Hour)
+	repo.apiKeys = map[string]*models.ApiKey{
+		"active":  {ID: "active", Username: "alice"},
+		"expired": {ID: "expired", Username: "alice", ExpiresAt: &past},
+	}
+	schedule := func(apiKeyId *string, expiresAt *time.Time) *models.Schedule {
+		return &models.Schedule{
+			ID:                  "schedule",
+			Username:            "alice",
+			WalletID:            "wallet",
+			TargetKind:          models.ScheduleTargetKeysend,
+			TargetPubkey:        strPtr(testPubkey),
+			Amount:              1000,
+			Interval:            "@hourly",
+			NextRun:             now,
+			Status:              models.ScheduleActive,
+			ApiKeyID:            apiKeyId,
+			CredentialsExpireAt: expiresAt,
+		}
+	}
+	m.run(schedule(strPtr("active"), nil), now)
+	require.Len(t, invoices.keysends, 1)
+
+	m.run(schedule(strPtr("revoked"), nil), now)
+	m.run(schedule(strPtr("expired"), nil), now)
+	m.run(schedule(nil, &past), now)
+
+	require.Len(t, invoices.keysends, 1)
+	require.Len(t, repo.runs, 4)
+	require.Equal(t, "the api key that created the schedule was revoked", repo.runs[1].FailureReason)
+	require.Equal(t, "the api key that created the schedule expired", repo.runs[2].FailureReason)
+	require.Equal(t, "the credentials that created the schedule expired", repo.runs[3].FailureReason)
+}
@@ -21,6 +21,7 @@ type Manager interface {
 	CreateUser(username string) (*models.User, error)
 
 	// DeleteUser deletes a user.
+	// Errors with models.ErrCannotDeleteRevenueWallet if service fees are credited to a wallet of the user.
 	DeleteUser(username string, isPostgres bool) error
 
 	// GetUser returns a user with corresponding username if it exists. Otherwise it errors and returns nil
@@ -41,14 +42,20 @@ type Manager interface {
 
 	// GetUserWithApiKey gets user with api key
 	GetUserWithApiKey(apiKey string) (*models.User, error)
+
+	// RotateApiKey replaces the api key of the user with a new key, which is returned. The previous key can no longer
+	// be used once the auth service forgets it.
+	RotateApiKey(username string) (string, error)
 }
 
 type manager struct {
 	db *db.DB
+	// user of the wallet that service fees are credited to, if they are credited to a wallet
+	revenueUsername string
 }
 
-func NewManager(db *db.DB) Manager {
-	return &manager{db: db}
+func NewManager(db *db.DB, revenueUsername string) Manager {
+	return &manager{db: db, revenueUsername: revenueUsername}
 }
 
 func (m *manager) CreateUser(username string) (*models.User, error) {
@@ -77,6 +84,9 @@ func (m *manager) CreateUser(username string) (*models.User, error) {
 }
 
 func (m *manager) DeleteUser(username string, isPostgres bool) error {
+	if m.revenueUsername != "" && username == m.revenueUsername {
+		return models.ErrCannotDeleteRevenueWallet
+	}
 	exists, err := m.db.Repo.UserExists(m.db.DB, username)
 	if err != nil {
 		log.WithError(err).WithFields(log.Fields{
@@ -139,3 +149,12 @@ func (m *manager) GetUserWithApiKey(apiKey string) (*models.User, error) {
 		return user, nil
 	}
 }
+
+func (m *manager) RotateApiKey(username string) (string, error) {
+	key, err := m.db.Repo.RotateUserApiKey(m.db.DB, username)
+	if err != nil {
+		return "", err
+	}
+	log.WithField("user", username).Info("user api key rotated")
+	return key, nil
+}
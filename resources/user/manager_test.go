@@ -33,7 +33,7 @@ func (s *userManagerSuite) SetupSuite() {
 		SkipInitializeWithVersion: true,
 	}), &gorm.Config{}) // open gorm db
 	s.repo = &MockRepo{}
-	s.mgr = NewManager(&db.DB{DB: mockDB, Repo: s.repo})
+	s.mgr = NewManager(&db.DB{DB: mockDB, Repo: s.repo}, "")
 	s.Require().NoError(err)
 }
 
@@ -111,3 +111,8 @@ func (m *MockRepo) CreateUser(_ *gorm.DB, user *models.User) error {
 func (m *MockRepo) CreateWallet(_ *gorm.DB, wallet *models.Wallet) error {
 	return m.MockCreateWallet(wallet)
 }
+
+func (s *userManagerSuite) TestDeleteUserKeepsRevenueWallet() {
+	mgr := NewManager(&db.DB{Repo: s.repo}, "operator")
+	s.Require().Equal(models.ErrCannotDeleteRevenueWallet, mgr.DeleteUser("operator", true))
+}
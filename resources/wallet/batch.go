@@ -0,0 +1,150 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/gofrs/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/resources/events"
+	"gorm.io/gorm"
+)
+
+// maximum number of transfers in a batch
+const maxBatchTransfers = 100
+
+// BatchTransferItem is one of the transfers of a batch.
+type BatchTransferItem struct {
+	FromWalletID string
+	ToWalletID   string
+	Amount       uint64
+}
+
+func (m *manager) BatchTransfer(username string, transfers []*BatchTransferItem) (string, []*models.Transaction, error) {
+	if len(transfers) == 0 {
+		return "", nil, errors.New("at least one transfer is required")
+	} else if len(transfers) > maxBatchTransfers {
+		return "", nil, fmt.Errorf("at most %d transfers may be made in a batch", maxBatchTransfers)
+	}
+	var walletIds []string
+	seen := make(map[string]struct{})
+	for i, transfer := range transfers {
+		if transfer.Amount == 0 {
+			return "", nil, fmt.Errorf("amount of transfer at index %d must be non-zero", i)
+		} else if transfer.FromWalletID == transfer.ToWalletID {
+			return "", nil, fmt.Errorf("transfer at index %d cannot transfer to the same wallet", i)
+		}
+		for _, walletId := range []string{transfer.FromWalletID, transfer.ToWalletID} {
+			if _, ok := seen[walletId]; !ok {
+				seen[walletId] = struct{}{}
+				walletIds = append(walletIds, walletId)
+			}
+		}
+	}
+	walletsFromSameUser, _, err := m.db.Repo.WalletsFromUser(m.db.DB, username, walletIds)
+	if err != nil {
+		return "", nil, err
+	}
+	if !walletsFromSameUser {
+		return "", nil, errors.New("either wallets are not from same user or one or more wallets do not exist")
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.WithError(err).Error("Failed to generate batch ID")
+		return "", nil, models.ErrInternal
+	}
+	batchId := id.String()
+
+	transactions := make([]models.Transaction, len(transfers))
+	err = m.db.Transaction(func(tx *gorm.DB) error {
+		// wallets are locked in the same order by every batch so that concurrent batches cannot deadlock
+		sort.Strings(walletIds)
+		for _, walletId := range walletIds {
+			if _, err := m.isUpdatable(tx, username, walletId); err != nil {
+				return err
+			}
+		}
+		// transfers count towards the spending limits of the transfers that follow them from the same wallet
+		outflows := make(map[string]models.Outflow)
+		for i, transfer := range transfers {
+			outflow := outflows[transfer.FromWalletID]
+			if err := m.checkSpendingPolicies(tx, username, transfer.FromWalletID, nil, nil,
+				transfer.Amount, outflow); err != nil {
+				return fmt.Errorf("transfer at index %d cannot be made: %w", i, err)
+			}
+			fee, err := m.ServiceFee(tx, username, transfer.FromWalletID, models.FeeKindTransfer, transfer.Amount)
+			if err != nil {
+				return err
+			}
+			outflow.Amount += transfer.Amount + fee
+			outflow.Count++
+			outflows[transfer.FromWalletID] = outflow
+			transactions[i] = models.Transaction{
+				FromID:       &transfers[i].FromWalletID,
+				FromUsername: &username,
+				ToID:         &transfers[i].ToWalletID,
+				ToUsername:   &username,
+				Amount:       transfer.Amount,
+				ServiceFee:   fee,
+				BatchID:      &batchId,
+			}
+		}
+		if err := m.db.Repo.CreateTransactions(tx, &transactions); err != nil {
+			return err
+		}
+
+		// Update sending and receiving wallet balances
+		for i := range transactions {
+			transaction := &transactions[i]
+			entries := []*models.LedgerEntry{
+				models.WalletDebit(username, *transaction.FromID, transaction.Amount+transaction.ServiceFee),
+				models.WalletCredit(username, *transaction.ToID, transaction.Amount),
+			}
+			if transaction.ServiceFee > 0 {
+				entries = append(entries, m.ServiceFeeCredit(transaction.ServiceFee))
+			}
+			if err := m.db.Repo.PostJournal(tx, &transaction.ID, entries...); err != nil {
+				log.WithError(err).WithFields(log.Fields{
+					"batch": batchId,
+					"index": i,
+					"user":  username,
+				}).Warn("Batch transfer failed")
+				return err
+			}
+		}
+		return nil
+	})
+	if err == models.ErrCannotUpdateLockedWallet {
+		return "", nil, models.ErrCannotTransactWithLockedWallet
+	} else if err != nil {
+		return "", nil, err
+	}
+
+	log.WithFields(log.Fields{
+		"batch":     batchId,
+		"user":      username,
+		"transfers": len(transactions),
+	}).Info("made batch transfer")
+	results := make([]*models.Transaction, len(transactions))
+	for i := range transactions {
+		transaction := &transactions[i]
+		m.events.Publish(&events.Event{
+			Type:        events.EventTransferSent,
+			Username:    username,
+			WalletID:    *transaction.FromID,
+			Transaction: transaction,
+			AmountMsat:  transaction.Amount,
+		})
+		m.events.Publish(&events.Event{
+			Type:        events.EventTransferReceived,
+			Username:    username,
+			WalletID:    *transaction.ToID,
+			Transaction: transaction,
+			AmountMsat:  transaction.Amount,
+		})
+		results[i] = transaction
+	}
+	return batchId, results, nil
+}
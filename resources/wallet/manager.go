@@ -2,11 +2,13 @@ package wallet
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/xbit-gg/xln/db"
 	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/resources/events"
 	"gorm.io/gorm"
 )
 
@@ -15,10 +17,12 @@ type Manager interface {
 	// Does not allow duplicate wallet names for a given user.
 	CreateWallet(username, id, name string) (*models.Wallet, error)
 
-	// DeleteWallet deletes wallet with walletId if wallet has zero balance
+	// DeleteWallet deletes wallet with walletId if wallet has zero balance.
+	// Errors with models.ErrCannotDeleteRevenueWallet if service fees are credited to the wallet.
 	DeleteWallet(username, walletId string, isPostgres bool) error
 
-	// DeleteWallet deletes wallet with walletId
+	// AdminDeleteWallet deletes wallet with walletId.
+	// Errors with models.ErrCannotDeleteRevenueWallet if service fees are credited to the wallet.
 	AdminDeleteWallet(username, walletId string, isPostgres bool) error
 
 	// UpdateWalletOptions update wallet with select wallet options
@@ -47,16 +51,64 @@ type Manager interface {
 	// Transfer transfers money from one wallet to another if they belong to the same user.
 	Transfer(username, walletId, toWalletId string, amount uint64) (*models.Transaction, error)
 
+	// TransferToUser transfers money from a wallet to a wallet of another user, which must accept transfers. The
+	// wallet is charged the service fee of transfers.
+	// The memo is recorded as the label of the transaction if it is not empty.
+	TransferToUser(username, walletId, toUsername, toWalletId string, amount uint64, memo string) (*models.Transaction,
+		error)
+
+	// BatchTransfer makes the transfers between wallets of the user in a single DB transaction, so that either all
+	// of them are made or none are. Returns the ID of the batch, which is set on each of its transactions.
+	BatchTransfer(username string, transfers []*BatchTransferItem) (string, []*models.Transaction, error)
+
 	// GetWalletWithApiKey gets wallet with api key
 	GetWalletWithApiKey(apiKey string) (*models.Wallet, error)
+
+	// RotateApiKey replaces the api key of the wallet with a new key, which is returned. The previous key can no
+	// longer be used once the auth service forgets it.
+	RotateApiKey(username, walletId string) (string, error)
+
+	// CheckSpendingPolicy errors with models.ErrSpendingPolicyViolated if sending amount from the wallet would
+	// violate the policy of the wallet or of its user. It is intended to be called within the DB transaction that
+	// records the payment, after the wallet has been locked for update.
+	// If destination is nil, then the funds are transferred to another wallet of the same user, which only the
+	// wallet's own policy limits. recipient is the user that the funds are paid to if they are paid to a wallet of
+	// another user of this node, such as by paying the wallet's invoice.
+	CheckSpendingPolicy(tx *gorm.DB, username, walletId string, destination, recipient *string, amount uint64) error
+
+	// ServiceFee returns the service fee that the wallet is charged for a payment of amount of the kind, which is
+	// one of the models.FeeKind constants. The revenue wallet is not charged.
+	ServiceFee(tx *gorm.DB, username, walletId, kind string, amount uint64) (uint64, error)
+
+	// ServiceFeeCredit returns the ledger entry that credits fee to the revenue wallet, or to the service fees
+	// account if there is no revenue wallet.
+	ServiceFeeCredit(fee uint64) *models.LedgerEntry
+
+	// GetFeeSchedule returns the fee schedule of the user, or that of the node if the user does not have one.
+	GetFeeSchedule(username string) (*models.FeeSchedule, error)
+
+	// SetFeeSchedule replaces the fee schedule of the user. The user is charged the fee schedule of the node if
+	// schedule is nil.
+	SetFeeSchedule(username string, schedule *models.FeeSchedule) error
+}
+
+// ServiceFees are the service fees that wallets are charged by the node.
+type ServiceFees struct {
+	// fee schedule of users that do not have their own
+	Schedule models.FeeSchedule
+	// wallet that fees are credited to. Fees are credited to the service fees account of the ledger if not set
+	RevenueUsername string
+	RevenueWalletID string
 }
 
 type manager struct {
-	db *db.DB
+	db          *db.DB
+	events      events.Manager
+	serviceFees ServiceFees
 }
 
-func NewManager(db *db.DB) Manager {
-	return &manager{db: db}
+func NewManager(db *db.DB, eventManager events.Manager, serviceFees ServiceFees) Manager {
+	return &manager{db: db, events: eventManager, serviceFees: serviceFees}
 }
 
 func (m *manager) CreateWallet(username, id, name string) (*models.Wallet, error) {
@@ -75,6 +127,9 @@ func (m *manager) CreateWallet(username, id, name string) (*models.Wallet, error
 }
 
 func (m *manager) DeleteWallet(username, walletId string, isPostgres bool) error {
+	if m.isRevenueWallet(username, walletId) {
+		return models.ErrCannotDeleteRevenueWallet
+	}
 	err := m.db.Transaction(func(tx *gorm.DB) error {
 		if _, err := m.isUpdatable(tx, username, walletId); err != nil {
 			return err
@@ -89,6 +144,9 @@ func (m *manager) DeleteWallet(username, walletId string, isPostgres bool) error
 			if err := m.db.Repo.NullifyInvoiceSender(tx, username, walletId); err != nil {
 				return err
 			}
+			if err := m.db.Repo.SetSpendingPolicy(tx, &models.SpendingPolicy{Username: username, WalletID: &walletId}); err != nil {
+				return err
+			}
 		}
 		return nil
 	})
@@ -96,6 +154,9 @@ func (m *manager) DeleteWallet(username, walletId string, isPostgres bool) error
 }
 
 func (m *manager) AdminDeleteWallet(username, walletId string, isPostgres bool) error {
+	if m.isRevenueWallet(username, walletId) {
+		return models.ErrCannotDeleteRevenueWallet
+	}
 	err := m.db.Transaction(func(tx *gorm.DB) error {
 		if _, err := m.isUpdatable(tx, username, walletId); err != nil {
 			return err
@@ -103,6 +164,9 @@ func (m *manager) AdminDeleteWallet(username, walletId string, isPostgres bool)
 		if err := m.db.Repo.DeleteWallet(tx, username, walletId); err != nil {
 			return err
 		}
+		if !isPostgres {
+			return m.db.Repo.SetSpendingPolicy(tx, &models.SpendingPolicy{Username: username, WalletID: &walletId})
+		}
 		return nil
 	})
 	return err
@@ -177,18 +241,13 @@ func (m *manager) Transfer(username, walletId, toWalletId string, amount uint64)
 		if err != nil {
 			return err
 		}
-		// Update sending wallet balance
-		err = m.db.Repo.DecrementWalletBalance(tx, username, walletId, amount)
-		if err != nil {
+		if err := m.CheckSpendingPolicy(tx, username, walletId, nil, nil, amount); err != nil {
 			return err
 		}
-
-		// Update receiving wallet balance
-		err = m.db.Repo.IncrementWalletBalance(tx, username, toWalletId, amount)
+		fee, err := m.ServiceFee(tx, username, walletId, models.FeeKindTransfer, amount)
 		if err != nil {
 			return err
 		}
-
 		// Record senders and receivers transaction
 		transaction = models.Transaction{
 			FromID:       &walletId,
@@ -197,6 +256,7 @@ func (m *manager) Transfer(username, walletId, toWalletId string, amount uint64)
 			ToUsername:   &username,
 			Amount:       amount,
 			FeesPaid:     0,
+			ServiceFee:   fee,
 		}
 		if err := m.db.Repo.CreateTransaction(tx, &transaction); err != nil {
 			log.WithError(err).WithFields(log.Fields{
@@ -205,19 +265,138 @@ func (m *manager) Transfer(username, walletId, toWalletId string, amount uint64)
 				"username": username,
 			}).Error("failed to write new transaction to database")
 			return err
-		} else {
-			return nil
 		}
+
+		// Update sending and receiving wallet balances
+		entries := []*models.LedgerEntry{
+			models.WalletDebit(username, walletId, amount+fee),
+			models.WalletCredit(username, toWalletId, amount),
+		}
+		if fee > 0 {
+			entries = append(entries, m.ServiceFeeCredit(fee))
+		}
+		return m.db.Repo.PostJournal(tx, &transaction.ID, entries...)
 	})
 	if err == models.ErrCannotUpdateLockedWallet {
 		return nil, models.ErrCannotTransactWithLockedWallet
 	} else if err != nil {
 		return nil, err
 	} else {
+		m.events.Publish(&events.Event{
+			Type:        events.EventTransferSent,
+			Username:    username,
+			WalletID:    walletId,
+			Transaction: &transaction,
+			AmountMsat:  amount,
+		})
+		m.events.Publish(&events.Event{
+			Type:        events.EventTransferReceived,
+			Username:    username,
+			WalletID:    toWalletId,
+			Transaction: &transaction,
+			AmountMsat:  amount,
+		})
 		return &transaction, nil
 	}
 }
 
+func (m *manager) TransferToUser(username, walletId, toUsername, toWalletId string, amount uint64,
+	memo string) (*models.Transaction, error) {
+	if amount == 0 {
+		return nil, errors.New("transfer amount must be non-zero")
+	}
+	if username == toUsername {
+		return nil, errors.New("cannot transfer to another user's wallet from the same user")
+	}
+	// wallets that do not exist are indistinguishable from those that do not accept transfers
+	if recipient, err := m.db.Repo.GetWallet(m.db.DB, toUsername, toWalletId); err == models.ErrWalletNotFound {
+		return nil, models.ErrTransfersNotAccepted
+	} else if err != nil {
+		return nil, err
+	} else if !recipient.AcceptsTransfers {
+		return nil, models.ErrTransfersNotAccepted
+	}
+	var transaction models.Transaction
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		// wallets are locked in the same order by every transfer so that opposing transfers cannot deadlock
+		var recipient *models.Wallet
+		if username < toUsername {
+			if _, err := m.isUpdatable(tx, username, walletId); err != nil {
+				return err
+			} else if recipient, err = m.isUpdatable(tx, toUsername, toWalletId); err != nil {
+				return err
+			}
+		} else {
+			var err error
+			if recipient, err = m.isUpdatable(tx, toUsername, toWalletId); err != nil {
+				return err
+			} else if _, err = m.isUpdatable(tx, username, walletId); err != nil {
+				return err
+			}
+		}
+		if !recipient.AcceptsTransfers {
+			return models.ErrTransfersNotAccepted
+		}
+		if err := m.checkSpendingPolicies(tx, username, walletId, nil, &toUsername, amount,
+			models.Outflow{}); err != nil {
+			return err
+		}
+		fee, err := m.ServiceFee(tx, username, walletId, models.FeeKindTransfer, amount)
+		if err != nil {
+			return err
+		}
+		transaction = models.Transaction{
+			FromID:       &walletId,
+			FromUsername: &username,
+			ToID:         &toWalletId,
+			ToUsername:   &toUsername,
+			Amount:       amount,
+			ServiceFee:   fee,
+		}
+		if memo != "" {
+			transaction.Label = &memo
+		}
+		if err := m.db.Repo.CreateTransaction(tx, &transaction); err != nil {
+			log.WithError(err).WithFields(log.Fields{
+				"from":   walletId,
+				"to":     toWalletId,
+				"user":   username,
+				"toUser": toUsername,
+			}).Error("failed to write new transaction to database")
+			return err
+		}
+
+		entries := []*models.LedgerEntry{
+			models.WalletDebit(username, walletId, amount+fee),
+			models.WalletCredit(toUsername, toWalletId, amount),
+		}
+		if fee > 0 {
+			entries = append(entries, m.ServiceFeeCredit(fee))
+		}
+		return m.db.Repo.PostJournal(tx, &transaction.ID, entries...)
+	})
+	if err == models.ErrCannotUpdateLockedWallet {
+		return nil, models.ErrCannotTransactWithLockedWallet
+	} else if err != nil {
+		return nil, err
+	}
+	m.events.Publish(&events.Event{
+		Type:        events.EventTransferSent,
+		Username:    username,
+		WalletID:    walletId,
+		Transaction: &transaction,
+		AmountMsat:  amount,
+	})
+	m.events.Publish(&events.Event{
+		Type:        events.EventTransferReceived,
+		Username:    toUsername,
+		WalletID:    toWalletId,
+		Transaction: &transaction,
+		AmountMsat:  amount,
+	})
+	return &transaction, nil
+}
+
 // isUpdatable returns an error if the wallet is not is not updatable. Otherwise returns the wallet
 func (m *manager) isUpdatable(tx *gorm.DB, username, walletId string) (*models.Wallet, error) {
 	wallet, err := m.db.Repo.LockWalletRecordForUpdate(tx, username, walletId)
@@ -242,3 +421,149 @@ func (m *manager) GetWalletWithApiKey(apiKey string) (*models.Wallet, error) {
 		return wallet, nil
 	}
 }
+
+func (m *manager) RotateApiKey(username, walletId string) (string, error) {
+	key, err := m.db.Repo.RotateWalletApiKey(m.db.DB, username, walletId)
+	if err != nil {
+		return "", err
+	}
+	log.WithFields(log.Fields{
+		"user":   username,
+		"wallet": walletId,
+	}).Info("wallet api key rotated")
+	return key, nil
+}
+
+func (m *manager) CheckSpendingPolicy(tx *gorm.DB, username, walletId string, destination, recipient *string,
+	amount uint64) error {
+	return m.checkSpendingPolicies(tx, username, walletId, destination, recipient, amount, models.Outflow{})
+}
+
+// checkSpendingPolicies checks the policies of the wallet and of its user as CheckSpendingPolicy does, counting batch,
+// the outflow of the payments that precede this one in a batch that has not been recorded yet, towards the limits.
+// recipient is the user that the funds are transferred to if they are transferred to another user of this node.
+func (m *manager) checkSpendingPolicies(tx *gorm.DB, username, walletId string, destination, recipient *string,
+	amount uint64, batch models.Outflow) error {
+	policies, err := m.db.Repo.GetWalletSpendingPolicies(tx, username, walletId)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	for _, policy := range policies {
+		if policy.WalletID == nil && destination == nil && recipient == nil {
+			continue
+		}
+		if err := m.checkSpendingPolicy(tx, policy, destination, recipient, amount, batch, now); err != nil {
+			log.WithError(err).WithFields(log.Fields{
+				"wallet":     walletId,
+				"user":       username,
+				"userPolicy": policy.WalletID == nil,
+				"amount":     amount,
+			}).Info("Payment rejected by spending policy")
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *manager) checkSpendingPolicy(tx *gorm.DB, policy *models.SpendingPolicy, destination, recipient *string,
+	amount uint64, batch models.Outflow, now time.Time) error {
+	if policy.MaxPayment > 0 && amount > policy.MaxPayment {
+		return fmt.Errorf("%w. Reason: amount exceeds the maximum payment of %d msat", models.ErrSpendingPolicyViolated,
+			policy.MaxPayment)
+	}
+	if destinations := policy.Destinations(); destination != nil && destinations != nil {
+		allowed := false
+		for _, pubkey := range destinations {
+			if pubkey == *destination {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("%w. Reason: destination %s is not allowed", models.ErrSpendingPolicyViolated,
+				*destination)
+		}
+	}
+	if recipients := policy.Recipients(); recipient != nil && recipients != nil {
+		allowed := false
+		for _, username := range recipients {
+			if username == *recipient {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("%w. Reason: recipient %s is not allowed", models.ErrSpendingPolicyViolated,
+				*recipient)
+		}
+	}
+	if policy.MaxHourlyPayments > 0 {
+		outflow, err := m.db.Repo.GetOutflow(tx, policy.Username, policy.WalletID, now.Add(-time.Hour))
+		if err != nil {
+			return err
+		} else if outflow.Count+batch.Count >= policy.MaxHourlyPayments {
+			return fmt.Errorf("%w. Reason: at most %d payments may be made per hour", models.ErrSpendingPolicyViolated,
+				policy.MaxHourlyPayments)
+		}
+	}
+	if policy.MaxDailyOutflow > 0 {
+		outflow, err := m.db.Repo.GetOutflow(tx, policy.Username, policy.WalletID, now.Add(-24*time.Hour))
+		if err != nil {
+			return err
+		} else if outflow.Amount+batch.Amount+amount > policy.MaxDailyOutflow {
+			return fmt.Errorf("%w. Reason: payment would exceed the daily outflow limit of %d msat",
+				models.ErrSpendingPolicyViolated, policy.MaxDailyOutflow)
+		}
+	}
+	if policy.MaxWeeklyOutflow > 0 {
+		outflow, err := m.db.Repo.GetOutflow(tx, policy.Username, policy.WalletID, now.Add(-7*24*time.Hour))
+		if err != nil {
+			return err
+		} else if outflow.Amount+batch.Amount+amount > policy.MaxWeeklyOutflow {
+			return fmt.Errorf("%w. Reason: payment would exceed the weekly outflow limit of %d msat",
+				models.ErrSpendingPolicyViolated, policy.MaxWeeklyOutflow)
+		}
+	}
+	return nil
+}
+
+func (m *manager) ServiceFee(tx *gorm.DB, username, walletId, kind string, amount uint64) (uint64, error) {
+	if m.isRevenueWallet(username, walletId) {
+		return 0, nil
+	}
+	schedule, err := m.getFeeSchedule(tx, username)
+	if err != nil {
+		return 0, err
+	}
+	return schedule.Fee(kind, amount), nil
+}
+
+func (m *manager) ServiceFeeCredit(fee uint64) *models.LedgerEntry {
+	if m.serviceFees.RevenueWalletID == "" {
+		return models.AccountCredit(models.AccountServiceFees, fee)
+	}
+	return models.WalletCredit(m.serviceFees.RevenueUsername, m.serviceFees.RevenueWalletID, fee)
+}
+
+// isRevenueWallet returns true if service fees are credited to the wallet.
+func (m *manager) isRevenueWallet(username, walletId string) bool {
+	return m.serviceFees.RevenueWalletID != "" && username == m.serviceFees.RevenueUsername &&
+		walletId == m.serviceFees.RevenueWalletID
+}
+
+func (m *manager) GetFeeSchedule(username string) (*models.FeeSchedule, error) {
+	return m.getFeeSchedule(m.db.DB, username)
+}
+
+func (m *manager) getFeeSchedule(tx *gorm.DB, username string) (*models.FeeSchedule, error) {
+	schedule, err := m.db.Repo.GetFeeSchedule(tx, username)
+	if err == models.ErrFeeScheduleNotFound {
+		return &m.serviceFees.Schedule, nil
+	}
+	return schedule, err
+}
+
+func (m *manager) SetFeeSchedule(username string, schedule *models.FeeSchedule) error {
+	return m.db.Repo.SetFeeSchedule(m.db.DB, username, schedule)
+}
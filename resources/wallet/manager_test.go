@@ -3,13 +3,16 @@ package wallet
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/xbit-gg/xln/db"
 	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/resources/events"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
@@ -20,6 +23,7 @@ type WalletManagerSuite struct {
 	mock     sqlmock.Sqlmock
 	mgr      Manager
 	mockRepo MockRepo
+	events   events.Manager
 }
 
 func (s *WalletManagerSuite) SetupSuite() {
@@ -36,7 +40,8 @@ func (s *WalletManagerSuite) SetupSuite() {
 	}), &gorm.Config{})
 	s.Require().NoError(err)
 	s.mockRepo = MockRepo{}
-	s.mgr = NewManager(&db.DB{DB: s.DB, Repo: &s.mockRepo})
+	s.events = events.NewManager()
+	s.mgr = NewManager(&db.DB{DB: s.DB, Repo: &s.mockRepo}, s.events, ServiceFees{})
 }
 
 func (s *WalletManagerSuite) AfterTest(_, _ string) {
@@ -70,15 +75,12 @@ func (s *WalletManagerSuite) TestTransfer() {
 			return true, wallets, nil
 		}
 
-		s.mockRepo.MockDecrementWalletBalance = func(username, walletId string, amount uint64) error {
-			s.Require().Equal(testFromWalletId, walletId)
-			s.Require().Equal(testAmount, amount)
-			return nil
-		}
-
-		s.mockRepo.MockIncrementWalletBalance = func(username, walletId string, amount uint64) error {
-			s.Require().Equal(testToWalletId, walletId)
-			s.Require().Equal(testAmount, amount)
+		s.mockRepo.MockPostJournal = func(transactionId *string, entries ...*models.LedgerEntry) error {
+			s.Require().Len(entries, 2)
+			s.Require().Equal(testFromWalletId, *entries[0].WalletID)
+			s.Require().Equal(testAmount, entries[0].Debit)
+			s.Require().Equal(testToWalletId, *entries[1].WalletID)
+			s.Require().Equal(testAmount, entries[1].Credit)
 			return nil
 		}
 
@@ -95,6 +97,11 @@ func (s *WalletManagerSuite) TestTransfer() {
 			return &models.Wallet{ID: walletId, Username: testUsername}, nil
 		}
 
+		sent, cancelSent := s.events.Subscribe(testUsername, testFromWalletId)
+		defer cancelSent()
+		received, cancelReceived := s.events.Subscribe(testUsername, testToWalletId)
+		defer cancelReceived()
+
 		// mock start of db transaction
 		s.mock.ExpectBegin()
 		s.mock.ExpectCommit().WillReturnError(nil)
@@ -103,6 +110,12 @@ func (s *WalletManagerSuite) TestTransfer() {
 		txn, err := s.mgr.Transfer(testUsername, testFromWalletId, testToWalletId, testAmount)
 		require.NoError(s.T(), err, "valid internal same-user wallet transfers should not error")
 		s.Require().NotNil(txn, "successful transfer should return txn")
+		s.Require().Len(sent, 1, "sending wallet should be notified of transfer")
+		s.Require().Equal(events.EventTransferSent, (<-sent).Type)
+		s.Require().Len(received, 1, "receiving wallet should be notified of transfer")
+		event := <-received
+		s.Require().Equal(events.EventTransferReceived, event.Type)
+		s.Require().Equal(txn, event.Transaction)
 	})
 	s.Run("fails when amount is zero", func() {
 		txn, err := s.mgr.Transfer("wallet-id", "to-wallet-id", "testusername", uint64(0))
@@ -188,10 +201,14 @@ func (s *WalletManagerSuite) TestTransfer() {
 
 		s.mock.ExpectBegin() // mock start of db transaction
 
+		s.mockRepo.MockCreateTransaction = func(transactions *models.Transaction) error {
+			return nil
+		}
+
 		expectedErr := errors.New("Insufficient wallet balance")
-		s.mockRepo.MockDecrementWalletBalance = func(username, walletId string, amount uint64) error {
-			s.Require().Equal(testFromWalletId, walletId)
-			s.Require().Equal(testAmount, amount)
+		s.mockRepo.MockPostJournal = func(transactionId *string, entries ...*models.LedgerEntry) error {
+			s.Require().Equal(testFromWalletId, *entries[0].WalletID)
+			s.Require().Equal(testAmount, entries[0].Debit)
 			return expectedErr
 		}
 
@@ -203,6 +220,34 @@ func (s *WalletManagerSuite) TestTransfer() {
 
 	})
 
+	s.Run("fails when the sender's spending policy is violated", func() {
+		var (
+			testFromWalletId = "wallet-id"
+			testToWalletId   = "to-wallet-id"
+			testUsername     = "testusername"
+		)
+
+		s.mockRepo.MockWalletsFromUser = func(username string, walletIds []string) (bool, []models.Wallet, error) {
+			return true, nil, nil
+		}
+		s.mockRepo.MockLockWalletRecordForUpdate = func(username, walletId string) (*models.Wallet, error) {
+			return &models.Wallet{ID: walletId, Username: testUsername}, nil
+		}
+		defer func() { s.mockRepo.MockGetSpendingPolicies = nil }()
+		s.mockRepo.MockGetSpendingPolicies = func(username, walletId string) ([]*models.SpendingPolicy, error) {
+			s.Require().Equal(testFromWalletId, walletId)
+			return []*models.SpendingPolicy{{Username: testUsername, WalletID: &testFromWalletId, MaxPayment: 5}}, nil
+		}
+
+		s.mock.ExpectBegin() // mock start of db transaction
+		s.mock.ExpectRollback()
+
+		// Validations
+		txn, err := s.mgr.Transfer(testUsername, testFromWalletId, testToWalletId, uint64(10))
+		s.Require().True(errors.Is(err, models.ErrSpendingPolicyViolated))
+		s.Require().Nil(txn, "failed transfer should not return the transaction")
+	})
+
 	s.Run("fails when creating the transfer transaction fails", func() {
 		var (
 			testFromWalletId = "wallet-id"
@@ -224,18 +269,6 @@ func (s *WalletManagerSuite) TestTransfer() {
 			return true, wallets, nil
 		}
 
-		s.mockRepo.MockDecrementWalletBalance = func(username, walletId string, amount uint64) error {
-			s.Require().Equal(testFromWalletId, walletId)
-			s.Require().Equal(testAmount, amount)
-			return nil
-		}
-
-		s.mockRepo.MockIncrementWalletBalance = func(username, walletId string, amount uint64) error {
-			s.Require().Equal(testToWalletId, walletId)
-			s.Require().Equal(testAmount, amount)
-			return nil
-		}
-
 		expectedErr := errors.New("Create wallet transaction entries failed")
 		s.mockRepo.MockCreateTransaction = func(transactions *models.Transaction) error {
 			s.Require().Equal(testAmount, transactions.Amount)
@@ -282,15 +315,12 @@ func (s *WalletManagerSuite) TestTransfer() {
 			return true, wallets, nil
 		}
 
-		s.mockRepo.MockDecrementWalletBalance = func(username, walletId string, amount uint64) error {
-			s.Require().Equal(testFromWalletId, walletId)
-			s.Require().Equal(testAmount, amount)
-			return nil
-		}
-
-		s.mockRepo.MockIncrementWalletBalance = func(username, walletId string, amount uint64) error {
-			s.Require().Equal(testToWalletId, walletId)
-			s.Require().Equal(testAmount, amount)
+		s.mockRepo.MockPostJournal = func(transactionId *string, entries ...*models.LedgerEntry) error {
+			s.Require().Len(entries, 2)
+			s.Require().Equal(testFromWalletId, *entries[0].WalletID)
+			s.Require().Equal(testAmount, entries[0].Debit)
+			s.Require().Equal(testToWalletId, *entries[1].WalletID)
+			s.Require().Equal(testAmount, entries[1].Credit)
 			return nil
 		}
 
@@ -321,32 +351,344 @@ func (s *WalletManagerSuite) TestTransfer() {
 
 }
 
+func (s *WalletManagerSuite) TestCheckSpendingPolicy() {
+	var (
+		testUsername = "testusername"
+		testWalletId = "wallet-id"
+		destination  = "test-pubkey"
+	)
+	walletPolicy := func(policy models.SpendingPolicy) func(string, string) ([]*models.SpendingPolicy, error) {
+		policy.Username = testUsername
+		policy.WalletID = &testWalletId
+		return func(_, _ string) ([]*models.SpendingPolicy, error) {
+			return []*models.SpendingPolicy{&policy}, nil
+		}
+	}
+	outflow := func(amount, count uint64) func(string, *string, time.Time) (*models.Outflow, error) {
+		return func(_ string, _ *string, _ time.Time) (*models.Outflow, error) {
+			return &models.Outflow{Amount: amount, Count: count}, nil
+		}
+	}
+
+	s.Run("allows payments when there is no policy", func() {
+		s.Require().NoError(s.mgr.CheckSpendingPolicy(s.DB, testUsername, testWalletId, &destination, nil, 1000))
+	})
+	s.Run("rejects payments larger than the maximum payment", func() {
+		s.mockRepo.MockGetSpendingPolicies = walletPolicy(models.SpendingPolicy{MaxPayment: 100})
+		s.Require().NoError(s.mgr.CheckSpendingPolicy(s.DB, testUsername, testWalletId, &destination, nil, 100))
+		err := s.mgr.CheckSpendingPolicy(s.DB, testUsername, testWalletId, &destination, nil, 101)
+		s.Require().True(errors.Is(err, models.ErrSpendingPolicyViolated))
+	})
+	s.Run("rejects destinations that are not allowed", func() {
+		s.mockRepo.MockGetSpendingPolicies = walletPolicy(models.SpendingPolicy{AllowedDestinations: "a,test-pubkey"})
+		s.Require().NoError(s.mgr.CheckSpendingPolicy(s.DB, testUsername, testWalletId, &destination, nil, 100))
+		other := "other-pubkey"
+		err := s.mgr.CheckSpendingPolicy(s.DB, testUsername, testWalletId, &other, nil, 100)
+		s.Require().True(errors.Is(err, models.ErrSpendingPolicyViolated))
+		s.Require().NoError(s.mgr.CheckSpendingPolicy(s.DB, testUsername, testWalletId, nil, nil, 100),
+			"transfers between wallets have no destination node")
+	})
+	s.Run("rejects paying the invoices of users that are not allowed", func() {
+		s.mockRepo.MockGetSpendingPolicies = func(_, _ string) ([]*models.SpendingPolicy, error) {
+			return []*models.SpendingPolicy{{Username: testUsername, AllowedRecipients: "alice"}}, nil
+		}
+		alice, bob := "alice", "bob"
+		s.Require().NoError(s.mgr.CheckSpendingPolicy(s.DB, testUsername, testWalletId, &destination, &alice, 100))
+		err := s.mgr.CheckSpendingPolicy(s.DB, testUsername, testWalletId, &destination, &bob, 100)
+		s.Require().True(errors.Is(err, models.ErrSpendingPolicyViolated))
+	})
+	s.Run("rejects payments over the hourly count", func() {
+		s.mockRepo.MockGetSpendingPolicies = walletPolicy(models.SpendingPolicy{MaxHourlyPayments: 3})
+		s.mockRepo.MockGetOutflow = func(_ string, walletId *string, since time.Time) (*models.Outflow, error) {
+			s.Require().Equal(testWalletId, *walletId)
+			s.Require().WithinDuration(time.Now().Add(-time.Hour), since, time.Minute)
+			return &models.Outflow{Count: 3}, nil
+		}
+		err := s.mgr.CheckSpendingPolicy(s.DB, testUsername, testWalletId, &destination, nil, 1)
+		s.Require().True(errors.Is(err, models.ErrSpendingPolicyViolated))
+	})
+	s.Run("rejects payments over the daily and weekly outflow", func() {
+		s.mockRepo.MockGetSpendingPolicies = walletPolicy(models.SpendingPolicy{MaxDailyOutflow: 1000})
+		s.mockRepo.MockGetOutflow = outflow(900, 1)
+		s.Require().NoError(s.mgr.CheckSpendingPolicy(s.DB, testUsername, testWalletId, &destination, nil, 100))
+		err := s.mgr.CheckSpendingPolicy(s.DB, testUsername, testWalletId, &destination, nil, 101)
+		s.Require().True(errors.Is(err, models.ErrSpendingPolicyViolated))
+
+		s.mockRepo.MockGetSpendingPolicies = walletPolicy(models.SpendingPolicy{MaxWeeklyOutflow: 1000})
+		err = s.mgr.CheckSpendingPolicy(s.DB, testUsername, testWalletId, &destination, nil, 101)
+		s.Require().True(errors.Is(err, models.ErrSpendingPolicyViolated))
+	})
+	s.Run("user policies do not limit transfers between the user's wallets", func() {
+		s.mockRepo.MockGetSpendingPolicies = func(_, _ string) ([]*models.SpendingPolicy, error) {
+			return []*models.SpendingPolicy{{Username: testUsername, MaxPayment: 1}}, nil
+		}
+		s.Require().NoError(s.mgr.CheckSpendingPolicy(s.DB, testUsername, testWalletId, nil, nil, 100))
+		err := s.mgr.CheckSpendingPolicy(s.DB, testUsername, testWalletId, &destination, nil, 100)
+		s.Require().True(errors.Is(err, models.ErrSpendingPolicyViolated))
+	})
+}
+
+func (s *WalletManagerSuite) TestTransferChargesServiceFee() {
+	mgr := NewManager(&db.DB{DB: s.DB, Repo: &s.mockRepo}, s.events, ServiceFees{
+		Schedule:        models.FeeSchedule{Transfer: models.FeeRate{BaseMsat: 10, Ppm: 10000}},
+		RevenueUsername: "operator",
+		RevenueWalletID: "revenue",
+	})
+	s.mockRepo.MockWalletsFromUser = func(username string, walletIds []string) (bool, []models.Wallet, error) {
+		return true, nil, nil
+	}
+	s.mockRepo.MockLockWalletRecordForUpdate = func(username, walletId string) (*models.Wallet, error) {
+		return &models.Wallet{ID: walletId, Username: username}, nil
+	}
+	s.mockRepo.MockCreateTransaction = func(transaction *models.Transaction) error {
+		return nil
+	}
+	var entries []*models.LedgerEntry
+	s.mockRepo.MockPostJournal = func(transactionId *string, journal ...*models.LedgerEntry) error {
+		entries = journal
+		return nil
+	}
+	s.mock.ExpectBegin()
+	s.mock.ExpectCommit()
+
+	txn, err := mgr.Transfer("testusername", "wallet-id", "to-wallet-id", 1000)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1000), txn.Amount)
+	s.Require().Equal(uint64(20), txn.ServiceFee)
+	s.Require().Len(entries, 3)
+	s.Require().Equal(uint64(1020), entries[0].Debit, "the sender pays the amount and the fee")
+	s.Require().Equal(uint64(1000), entries[1].Credit)
+	s.Require().Equal("revenue", *entries[2].WalletID)
+	s.Require().Equal(uint64(20), entries[2].Credit)
+}
+
+func (s *WalletManagerSuite) TestRevenueWalletCannotBeDeleted() {
+	mgr := NewManager(&db.DB{DB: s.DB, Repo: &s.mockRepo}, s.events, ServiceFees{
+		RevenueUsername: "operator",
+		RevenueWalletID: "revenue",
+	})
+	s.Require().Equal(models.ErrCannotDeleteRevenueWallet, mgr.DeleteWallet("operator", "revenue", true))
+	s.Require().Equal(models.ErrCannotDeleteRevenueWallet, mgr.AdminDeleteWallet("operator", "revenue", true))
+}
+
+func (s *WalletManagerSuite) TestTransferToUser() {
+	mgr := NewManager(&db.DB{DB: s.DB, Repo: &s.mockRepo}, s.events, ServiceFees{
+		Schedule: models.FeeSchedule{
+			Transfer: models.FeeRate{BaseMsat: 5},
+			Internal: models.FeeRate{BaseMsat: 50},
+		},
+	})
+	acceptsTransfers := false
+	s.mockRepo.MockGetWallet = func(username, walletId string) (*models.Wallet, error) {
+		if walletId != "to-wallet-id" {
+			return nil, models.ErrWalletNotFound
+		}
+		return &models.Wallet{ID: walletId, Username: username, AcceptsTransfers: acceptsTransfers}, nil
+	}
+	s.mockRepo.MockLockWalletRecordForUpdate = func(username, walletId string) (*models.Wallet, error) {
+		return &models.Wallet{ID: walletId, Username: username, AcceptsTransfers: acceptsTransfers}, nil
+	}
+	s.mockRepo.MockCreateTransaction = func(transaction *models.Transaction) error {
+		return nil
+	}
+	var entries []*models.LedgerEntry
+	s.mockRepo.MockPostJournal = func(transactionId *string, journal ...*models.LedgerEntry) error {
+		entries = journal
+		return nil
+	}
+
+	_, err := mgr.TransferToUser("testusername", "wallet-id", "otheruser", "to-wallet-id", 1000, "")
+	s.Require().Equal(models.ErrTransfersNotAccepted, err, "wallets must opt in to receiving transfers")
+	_, err = mgr.TransferToUser("testusername", "wallet-id", "otheruser", "missing-wallet-id", 1000, "")
+	s.Require().Equal(models.ErrTransfersNotAccepted, err)
+	_, err = mgr.TransferToUser("testusername", "wallet-id", "testusername", "to-wallet-id", 1000, "")
+	s.Require().Error(err)
+
+	acceptsTransfers = true
+	s.mock.ExpectBegin()
+	s.mock.ExpectCommit()
+	txn, err := mgr.TransferToUser("testusername", "wallet-id", "otheruser", "to-wallet-id", 1000, "lunch")
+	s.Require().NoError(err)
+	s.Require().Equal("otheruser", *txn.ToUsername)
+	s.Require().Equal("lunch", *txn.Label)
+	s.Require().Nil(txn.InvoiceID)
+	s.Require().Equal(uint64(5), txn.ServiceFee, "transfers to other users are charged the fee of transfers")
+	s.Require().Equal(uint64(1005), entries[0].Debit)
+	s.Require().Equal("otheruser", *entries[1].Username)
+	s.Require().Equal(uint64(1000), entries[1].Credit)
+
+	s.mockRepo.MockGetSpendingPolicies = func(username, _ string) ([]*models.SpendingPolicy, error) {
+		return []*models.SpendingPolicy{{Username: username, AllowedRecipients: "someoneelse"}}, nil
+	}
+	s.mock.ExpectBegin()
+	s.mock.ExpectRollback()
+	_, err = mgr.TransferToUser("testusername", "wallet-id", "otheruser", "to-wallet-id", 1000, "")
+	s.Require().True(errors.Is(err, models.ErrSpendingPolicyViolated),
+		"user policies limit transfers to other users")
+}
+
+func (s *WalletManagerSuite) TestBatchTransfer() {
+	mgr := NewManager(&db.DB{DB: s.DB, Repo: &s.mockRepo}, s.events, ServiceFees{
+		Schedule: models.FeeSchedule{Transfer: models.FeeRate{BaseMsat: 10}},
+	})
+	var locked []string
+	s.mockRepo.MockWalletsFromUser = func(username string, walletIds []string) (bool, []models.Wallet, error) {
+		s.Require().ElementsMatch([]string{"wallet-b", "wallet-a", "wallet-c"}, walletIds)
+		return true, nil, nil
+	}
+	s.mockRepo.MockLockWalletRecordForUpdate = func(username, walletId string) (*models.Wallet, error) {
+		locked = append(locked, walletId)
+		return &models.Wallet{ID: walletId, Username: username}, nil
+	}
+	s.mockRepo.MockCreateTransactions = func(transactions *[]models.Transaction) error {
+		for i := range *transactions {
+			(*transactions)[i].ID = fmt.Sprintf("txn-%d", i)
+		}
+		return nil
+	}
+	journals := make(map[string][]*models.LedgerEntry)
+	s.mockRepo.MockPostJournal = func(transactionId *string, entries ...*models.LedgerEntry) error {
+		journals[*transactionId] = entries
+		return nil
+	}
+	s.mock.ExpectBegin()
+	s.mock.ExpectCommit()
+
+	batchId, txns, err := mgr.BatchTransfer("testusername", []*BatchTransferItem{
+		{FromWalletID: "wallet-b", ToWalletID: "wallet-a", Amount: 1000},
+		{FromWalletID: "wallet-b", ToWalletID: "wallet-c", Amount: 2000},
+	})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(batchId)
+	s.Require().Equal([]string{"wallet-a", "wallet-b", "wallet-c"}, locked, "wallets are locked in a consistent order")
+	s.Require().Len(txns, 2)
+	for i, txn := range txns {
+		s.Require().Equal(fmt.Sprintf("txn-%d", i), txn.ID)
+		s.Require().Equal(batchId, *txn.BatchID)
+		s.Require().Equal(uint64(10), txn.ServiceFee)
+	}
+	s.Require().Equal("wallet-c", *txns[1].ToID)
+	s.Require().Len(journals, 2)
+	s.Require().Equal(uint64(2010), journals["txn-1"][0].Debit)
+	s.Require().Equal(uint64(2000), journals["txn-1"][1].Credit)
+}
+
+func (s *WalletManagerSuite) TestBatchTransferIsAllOrNothing() {
+	s.mockRepo.MockWalletsFromUser = func(username string, walletIds []string) (bool, []models.Wallet, error) {
+		return true, nil, nil
+	}
+	s.mockRepo.MockLockWalletRecordForUpdate = func(username, walletId string) (*models.Wallet, error) {
+		return &models.Wallet{ID: walletId, Username: username}, nil
+	}
+	walletId := "wallet-a"
+	s.mockRepo.MockGetSpendingPolicies = func(username, _ string) ([]*models.SpendingPolicy, error) {
+		return []*models.SpendingPolicy{{Username: username, WalletID: &walletId, MaxDailyOutflow: 1500}}, nil
+	}
+	s.mockRepo.MockGetOutflow = func(_ string, _ *string, _ time.Time) (*models.Outflow, error) {
+		return &models.Outflow{}, nil
+	}
+	s.mock.ExpectBegin()
+	s.mock.ExpectRollback()
+
+	_, _, err := s.mgr.BatchTransfer("testusername", []*BatchTransferItem{
+		{FromWalletID: "wallet-a", ToWalletID: "wallet-b", Amount: 1000},
+		{FromWalletID: "wallet-a", ToWalletID: "wallet-c", Amount: 1000},
+	})
+	s.Require().True(errors.Is(err, models.ErrSpendingPolicyViolated),
+		"earlier transfers of the batch count towards the limits of later ones")
+
+	for _, transfers := range [][]*BatchTransferItem{
+		{},
+		{{FromWalletID: "wallet-a", ToWalletID: "wallet-b"}},
+		{{FromWalletID: "wallet-a", ToWalletID: "wallet-a", Amount: 1}},
+	} {
+		_, _, err := s.mgr.BatchTransfer("testusername", transfers)
+		s.Require().Error(err)
+	}
+}
+
+func (s *WalletManagerSuite) TestServiceFee() {
+	fees := ServiceFees{Schedule: models.FeeSchedule{External: models.FeeRate{Ppm: 1000}}}
+	mgr := NewManager(&db.DB{DB: s.DB, Repo: &s.mockRepo}, s.events, fees)
+
+	fee, err := mgr.ServiceFee(s.DB, "testusername", "wallet-id", models.FeeKindExternal, 100000)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(100), fee)
+	fee, err = mgr.ServiceFee(s.DB, "testusername", "wallet-id", models.FeeKindInternal, 100000)
+	s.Require().NoError(err)
+	s.Require().Zero(fee)
+	s.Require().Equal(models.AccountServiceFees, mgr.ServiceFeeCredit(100).Account,
+		"fees are credited to the ledger without a revenue wallet")
+
+	s.mockRepo.MockGetFeeSchedule = func(username string) (*models.FeeSchedule, error) {
+		return &models.FeeSchedule{Username: username}, nil
+	}
+	fee, err = mgr.ServiceFee(s.DB, "testusername", "wallet-id", models.FeeKindExternal, 100000)
+	s.Require().NoError(err)
+	s.Require().Zero(fee, "the fee schedule of the user replaces that of the node")
+
+	fees.RevenueUsername, fees.RevenueWalletID = "testusername", "wallet-id"
+	s.mockRepo.MockGetFeeSchedule = nil
+	mgr = NewManager(&db.DB{DB: s.DB, Repo: &s.mockRepo}, s.events, fees)
+	fee, err = mgr.ServiceFee(s.DB, "testusername", "wallet-id", models.FeeKindExternal, 100000)
+	s.Require().NoError(err)
+	s.Require().Zero(fee, "the revenue wallet is not charged")
+}
+
 type MockRepo struct {
 	models.Repository
 
 	MockWalletsFromUser           func(username string, walletIds []string) (bool, []models.Wallet, error)
-	MockDecrementWalletBalance    func(username, walletId string, amount uint64) error
-	MockIncrementWalletBalance    func(username, walletId string, amount uint64) error
+	MockPostJournal               func(transactionId *string, entries ...*models.LedgerEntry) error
 	MockCreateTransaction         func(transactions *models.Transaction) error
+	MockCreateTransactions        func(transactions *[]models.Transaction) error
 	MockLockWalletRecordForUpdate func(username, walletId string) (*models.Wallet, error)
+	MockGetSpendingPolicies       func(username, walletId string) ([]*models.SpendingPolicy, error)
+	MockGetOutflow                func(username string, walletId *string, since time.Time) (*models.Outflow, error)
+	MockGetFeeSchedule            func(username string) (*models.FeeSchedule, error)
+	MockGetWallet                 func(username, walletId string) (*models.Wallet, error)
 }
 
-func (m *MockRepo) WalletsFromUser(_ *gorm.DB, username string, walletIds []string) (bool, []models.Wallet, error) {
-	return m.MockWalletsFromUser(username, walletIds)
+func (m *MockRepo) GetWallet(_ *gorm.DB, username, walletId string) (*models.Wallet, error) {
+	return m.MockGetWallet(username, walletId)
 }
 
-func (m *MockRepo) DecrementWalletBalance(_ *gorm.DB, username, walletId string, amount uint64) error {
-	return m.MockDecrementWalletBalance(username, walletId, amount)
+func (m *MockRepo) WalletsFromUser(_ *gorm.DB, username string, walletIds []string) (bool, []models.Wallet, error) {
+	return m.MockWalletsFromUser(username, walletIds)
 }
 
-func (m *MockRepo) IncrementWalletBalance(_ *gorm.DB, username, walletId string, amount uint64) error {
-	return m.MockIncrementWalletBalance(username, walletId, amount)
+func (m *MockRepo) PostJournal(_ *gorm.DB, transactionId *string, entries ...*models.LedgerEntry) error {
+	return m.MockPostJournal(transactionId, entries...)
 }
 
 func (m *MockRepo) CreateTransaction(_ *gorm.DB, transactions *models.Transaction) error {
 	return m.MockCreateTransaction(transactions)
 }
 
+func (m *MockRepo) CreateTransactions(_ *gorm.DB, transactions *[]models.Transaction) error {
+	return m.MockCreateTransactions(transactions)
+}
+
 func (m *MockRepo) LockWalletRecordForUpdate(_ *gorm.DB, username, walletId string) (*models.Wallet, error) {
 	return m.MockLockWalletRecordForUpdate(username, walletId)
 }
+
+// GetWalletSpendingPolicies returns no policies unless mocked
+func (m *MockRepo) GetWalletSpendingPolicies(_ *gorm.DB, username, walletId string) ([]*models.SpendingPolicy, error) {
+	if m.MockGetSpendingPolicies == nil {
+		return nil, nil
+	}
+	return m.MockGetSpendingPolicies(username, walletId)
+}
+
+func (m *MockRepo) GetOutflow(_ *gorm.DB, username string, walletId *string, since time.Time) (*models.Outflow, error) {
+	return m.MockGetOutflow(username, walletId, since)
+}
+
+// GetFeeSchedule returns no fee schedule unless mocked
+func (m *MockRepo) GetFeeSchedule(_ *gorm.DB, username string) (*models.FeeSchedule, error) {
+	if m.MockGetFeeSchedule == nil {
+		return nil, models.ErrFeeScheduleNotFound
+	}
+	return m.MockGetFeeSchedule(username)
+}
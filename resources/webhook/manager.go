@@ -0,0 +1,234 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"gorm.io/gorm"
+)
+
+const (
+	EventInvoiceSettled   = "invoice.settled"
+	EventInvoiceAccepted  = "invoice.accepted"
+	EventInvoiceCancelled = "invoice.cancelled"
+	EventPaymentSucceeded = "payment.succeeded"
+	EventPaymentFailed    = "payment.failed"
+
+	// Headers sent with every delivery
+	SignatureHeader = "x-xln-signature"
+	EventHeader     = "x-xln-event"
+	DeliveryHeader  = "x-xln-delivery"
+)
+
+var (
+	// interval at which the queue is checked for due deliveries
+	pollInterval = 5 * time.Second
+	// maximum number of deliveries attempted on each poll
+	batchSize = 50
+	// a delivery is marked dead after maxAttempts failed attempts
+	maxAttempts = uint(10)
+	// the delay before retrying a delivery doubles with each attempt, starting at baseRetryDelay
+	baseRetryDelay = 10 * time.Second
+	maxRetryDelay  = 6 * time.Hour
+)
+
+type Manager interface {
+	// CreateWebhook registers url to be notified of the events of the user's wallets.
+	// If walletId is not nil, then only the events of that wallet are delivered.
+	CreateWebhook(username string, walletId *string, url string) (*models.Webhook, error)
+
+	// ListWebhooks lists the webhooks of the user, or of a single wallet if walletId is not nil.
+	ListWebhooks(username string, walletId *string) ([]*models.Webhook, error)
+
+	// DeleteWebhook removes the webhook. If walletId is not nil, then the webhook must belong to the wallet.
+	DeleteWebhook(username string, walletId *string, id string) error
+
+	// Enqueue queues the delivery of an event of a wallet to all of its webhooks. It is intended to be called
+	// within the DB transaction that records the event, so that the deliveries are durable.
+	Enqueue(tx *gorm.DB, username, walletId, event string, data interface{}) error
+}
+
+// Payload is the JSON body posted to webhooks.
+type Payload struct {
+	Event    string      `json:"event"`
+	Username string      `json:"username"`
+	WalletID string      `json:"wallet_id"`
+	Time     time.Time   `json:"time"`
+	Data     interface{} `json:"data"`
+}
+
+// InvoiceData is the payload data of invoice events.
+type InvoiceData struct {
+	PaymentHash string `json:"payment_hash"`
+	AmountMsat  uint64 `json:"amount_msat"`
+}
+
+// PaymentData is the payload data of payment events.
+type PaymentData struct {
+	PaymentHash   string `json:"payment_hash"`
+	AmountMsat    uint64 `json:"amount_msat"`
+	FeeMsat       uint64 `json:"fee_msat"`
+	FailureReason string `json:"failure_reason,omitempty"`
+}
+
+type manager struct {
+	db     *db.DB
+	client *http.Client
+}
+
+func NewManager(db *db.DB) Manager {
+	m := &manager{
+		db:     db,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+	go m.deliverWebhooks()
+
+	return m
+}
+
+func (m *manager) CreateWebhook(username string, walletId *string, url string) (*models.Webhook, error) {
+	webhook := &models.Webhook{
+		Username: username,
+		WalletID: walletId,
+		URL:      url,
+	}
+	if err := m.db.Repo.CreateWebhook(m.db.DB, webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (m *manager) ListWebhooks(username string, walletId *string) ([]*models.Webhook, error) {
+	return m.db.Repo.ListWebhooks(m.db.DB, username, walletId)
+}
+
+func (m *manager) DeleteWebhook(username string, walletId *string, id string) error {
+	return m.db.Repo.DeleteWebhook(m.db.DB, username, walletId, id)
+}
+
+func (m *manager) Enqueue(tx *gorm.DB, username, walletId, event string, data interface{}) error {
+	webhooks, err := m.db.Repo.ListWalletEventWebhooks(tx, username, walletId)
+	if err != nil {
+		return err
+	} else if len(webhooks) == 0 {
+		return nil
+	}
+	now := time.Now().UTC()
+	payload, err := json.Marshal(Payload{
+		Event:    event,
+		Username: username,
+		WalletID: walletId,
+		Time:     now,
+		Data:     data,
+	})
+	if err != nil {
+		return err
+	}
+	deliveries := make([]*models.WebhookDelivery, len(webhooks))
+	for i, webhook := range webhooks {
+		deliveries[i] = &models.WebhookDelivery{
+			WebhookID:   webhook.ID,
+			WalletID:    walletId,
+			Username:    username,
+			Event:       event,
+			Payload:     string(payload),
+			NextAttempt: now,
+		}
+	}
+	return m.db.Repo.CreateWebhookDeliveries(tx, deliveries)
+}
+
+func (m *manager) deliverWebhooks() {
+	for {
+		time.Sleep(pollInterval)
+		deliveries, err := m.db.Repo.ListDueWebhookDeliveries(m.db.DB, time.Now().UTC(), batchSize)
+		if err != nil {
+			log.WithError(err).Warn("Failed to get due webhook deliveries")
+			continue
+		}
+		for _, delivery := range deliveries {
+			m.attemptDelivery(delivery)
+		}
+	}
+}
+
+func (m *manager) attemptDelivery(delivery *models.WebhookDelivery) {
+	err := m.post(delivery)
+	if err == nil {
+		if err := m.db.Repo.DeleteWebhookDelivery(m.db.DB, delivery.ID); err != nil {
+			log.WithError(err).WithField("delivery", delivery.ID).Warn("Failed to dequeue delivered webhook")
+		}
+		return
+	}
+
+	delivery.Attempts++
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= maxAttempts {
+		delivery.Dead = true
+		log.WithError(err).WithFields(log.Fields{
+			"delivery": delivery.ID,
+			"webhook":  delivery.WebhookID,
+			"event":    delivery.Event,
+		}).Warn("Giving up on webhook delivery")
+	} else {
+		delivery.NextAttempt = time.Now().UTC().Add(retryDelay(delivery.Attempts))
+		log.WithError(err).WithFields(log.Fields{
+			"delivery": delivery.ID,
+			"webhook":  delivery.WebhookID,
+			"attempts": delivery.Attempts,
+		}).Debug("Webhook delivery failed")
+	}
+	if err := m.db.Repo.UpdateWebhookDeliveryAttempt(m.db.DB, delivery); err != nil {
+		log.WithError(err).WithField("delivery", delivery.ID).Warn("Failed to record webhook delivery attempt")
+	}
+}
+
+func (m *manager) post(delivery *models.WebhookDelivery) error {
+	wallet, err := m.db.Repo.GetWallet(m.db.DB, delivery.Username, delivery.WalletID)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, delivery.Webhook.URL, bytes.NewBufferString(delivery.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(wallet.WebhookSecret, []byte(delivery.Payload)))
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	res, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of body keyed by the webhook secret of the wallet.
+// Receivers verify deliveries by comparing it to the signature header.
+func Sign(key string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func retryDelay(attempts uint) time.Duration {
+	delay := time.Duration(float64(baseRetryDelay) * math.Pow(2, float64(attempts-1)))
+	if delay > maxRetryDelay || delay <= 0 {
+		return maxRetryDelay
+	}
+	return delay
+}
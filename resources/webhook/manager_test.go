@@ -0,0 +1,207 @@
+package webhook
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"github.com/xbit-gg/xln/db"
+	"github.com/xbit-gg/xln/models"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func TestWebhookManager(t *testing.T) {
+	suite.Run(t, new(webhookManagerSuite))
+}
+
+type webhookManagerSuite struct {
+	suite.Suite
+	mgr      *manager
+	mockRepo mockRepo
+	mock     sqlmock.Sqlmock
+}
+
+func (s *webhookManagerSuite) SetupSuite() {
+	var (
+		err   error
+		sqlDB *sql.DB
+	)
+	sqlDB, s.mock, err = sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+	sDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	s.Require().NoError(err)
+	s.mockRepo = mockRepo{}
+	// constructed directly so that the delivery worker is not started
+	s.mgr = &manager{
+		db:     &db.DB{DB: sDB, Repo: &s.mockRepo},
+		client: &http.Client{Timeout: time.Second},
+	}
+}
+
+func (s *webhookManagerSuite) AfterTest(_, _ string) {
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+	s.mockRepo = mockRepo{}
+}
+
+func (s *webhookManagerSuite) TestEnqueueQueuesDeliveryForEachWebhook() {
+	s.mockRepo.mockListWalletEventWebhooks = func(tx *gorm.DB, username, walletId string) ([]*models.Webhook, error) {
+		s.Require().Equal("test-username", username)
+		s.Require().Equal("test-walletid", walletId)
+		return []*models.Webhook{{ID: "webhook-1"}, {ID: "webhook-2"}}, nil
+	}
+	var queued []*models.WebhookDelivery
+	s.mockRepo.mockCreateWebhookDeliveries = func(tx *gorm.DB, deliveries []*models.WebhookDelivery) error {
+		queued = deliveries
+		return nil
+	}
+
+	err := s.mgr.Enqueue(s.mgr.db.DB, "test-username", "test-walletid", EventInvoiceSettled,
+		&InvoiceData{PaymentHash: "test-hash", AmountMsat: 1000})
+	s.Require().NoError(err)
+	s.Require().Len(queued, 2)
+	s.Require().Equal("webhook-1", queued[0].WebhookID)
+	s.Require().Equal("webhook-2", queued[1].WebhookID)
+	for _, delivery := range queued {
+		s.Require().Equal(EventInvoiceSettled, delivery.Event)
+		s.Require().Equal("test-username", delivery.Username)
+		s.Require().Equal("test-walletid", delivery.WalletID)
+		s.Require().False(delivery.NextAttempt.IsZero())
+
+		var payload map[string]interface{}
+		s.Require().NoError(json.Unmarshal([]byte(delivery.Payload), &payload))
+		s.Require().Equal(EventInvoiceSettled, payload["event"])
+		s.Require().Equal("test-walletid", payload["wallet_id"])
+		s.Require().Equal("test-hash", payload["data"].(map[string]interface{})["payment_hash"])
+	}
+}
+
+func (s *webhookManagerSuite) TestEnqueueSkipsWalletsWithoutWebhooks() {
+	s.mockRepo.mockListWalletEventWebhooks = func(tx *gorm.DB, username, walletId string) ([]*models.Webhook, error) {
+		return nil, nil
+	}
+	s.mockRepo.mockCreateWebhookDeliveries = func(tx *gorm.DB, deliveries []*models.WebhookDelivery) error {
+		s.FailNow("should not queue deliveries")
+		return nil
+	}
+
+	err := s.mgr.Enqueue(s.mgr.db.DB, "test-username", "test-walletid", EventPaymentFailed, &PaymentData{})
+	s.Require().NoError(err)
+}
+
+func (s *webhookManagerSuite) TestAttemptDeliverySignsBodyAndDequeuesOnSuccess() {
+	payload := `{"event":"payment.succeeded"}`
+	secret := "test-webhook-secret"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		s.Require().NoError(err)
+		s.Require().Equal(payload, string(body))
+		s.Require().Equal(Sign(secret, body), r.Header.Get(SignatureHeader))
+		s.Require().Equal(EventPaymentSucceeded, r.Header.Get(EventHeader))
+		s.Require().Equal("test-delivery", r.Header.Get(DeliveryHeader))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	s.mockRepo.mockGetWallet = func(tx *gorm.DB, username, walletId string) (*models.Wallet, error) {
+		return &models.Wallet{ID: walletId, Username: username, WebhookSecret: secret}, nil
+	}
+	deleted := false
+	s.mockRepo.mockDeleteWebhookDelivery = func(tx *gorm.DB, id string) error {
+		s.Require().Equal("test-delivery", id)
+		deleted = true
+		return nil
+	}
+
+	s.mgr.attemptDelivery(&models.WebhookDelivery{
+		ID:       "test-delivery",
+		Webhook:  models.Webhook{URL: server.URL},
+		Username: "test-username",
+		WalletID: "test-walletid",
+		Event:    EventPaymentSucceeded,
+		Payload:  payload,
+	})
+	s.Require().True(deleted)
+}
+
+func (s *webhookManagerSuite) TestAttemptDeliveryBacksOffOnFailure() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	s.mockRepo.mockGetWallet = func(tx *gorm.DB, username, walletId string) (*models.Wallet, error) {
+		return &models.Wallet{ID: walletId, Username: username}, nil
+	}
+	var updated *models.WebhookDelivery
+	s.mockRepo.mockUpdateWebhookDeliveryAttempt = func(tx *gorm.DB, delivery *models.WebhookDelivery) error {
+		updated = delivery
+		return nil
+	}
+
+	before := time.Now().UTC()
+	s.mgr.attemptDelivery(&models.WebhookDelivery{
+		ID:       "test-delivery",
+		Webhook:  models.Webhook{URL: server.URL},
+		Attempts: 2,
+	})
+	s.Require().NotNil(updated)
+	s.Require().Equal(uint(3), updated.Attempts)
+	s.Require().False(updated.Dead)
+	s.Require().NotEmpty(updated.LastError)
+	s.Require().True(updated.NextAttempt.After(before.Add(retryDelay(3) - time.Second)))
+
+	updated = nil
+	s.mgr.attemptDelivery(&models.WebhookDelivery{
+		ID:       "test-delivery",
+		Webhook:  models.Webhook{URL: server.URL},
+		Attempts: maxAttempts - 1,
+	})
+	s.Require().NotNil(updated)
+	s.Require().True(updated.Dead)
+}
+
+func (s *webhookManagerSuite) TestRetryDelayIsBounded() {
+	s.Require().Equal(baseRetryDelay, retryDelay(1))
+	s.Require().Equal(2*baseRetryDelay, retryDelay(2))
+	s.Require().Equal(maxRetryDelay, retryDelay(100))
+}
+
+type mockRepo struct {
+	models.Repository
+
+	mockListWalletEventWebhooks      func(tx *gorm.DB, username, walletId string) ([]*models.Webhook, error)
+	mockCreateWebhookDeliveries      func(tx *gorm.DB, deliveries []*models.WebhookDelivery) error
+	mockDeleteWebhookDelivery        func(tx *gorm.DB, id string) error
+	mockUpdateWebhookDeliveryAttempt func(tx *gorm.DB, delivery *models.WebhookDelivery) error
+	mockGetWallet                    func(tx *gorm.DB, username, walletId string) (*models.Wallet, error)
+}
+
+func (m *mockRepo) ListWalletEventWebhooks(tx *gorm.DB, username, walletId string) ([]*models.Webhook, error) {
+	return m.mockListWalletEventWebhooks(tx, username, walletId)
+}
+
+func (m *mockRepo) CreateWebhookDeliveries(tx *gorm.DB, deliveries []*models.WebhookDelivery) error {
+	return m.mockCreateWebhookDeliveries(tx, deliveries)
+}
+
+func (m *mockRepo) DeleteWebhookDelivery(tx *gorm.DB, id string) error {
+	return m.mockDeleteWebhookDelivery(tx, id)
+}
+
+func (m *mockRepo) UpdateWebhookDeliveryAttempt(tx *gorm.DB, delivery *models.WebhookDelivery) error {
+	return m.mockUpdateWebhookDeliveryAttempt(tx, delivery)
+}
+
+func (m *mockRepo) GetWallet(tx *gorm.DB, username, walletId string) (*models.Wallet, error) {
+	return m.mockGetWallet(tx, username, walletId)
+}
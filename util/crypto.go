@@ -1,14 +1,21 @@
 package util
 
 import (
+	crand "crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
-	"math/rand"
+	"encoding/hex"
+	"strings"
 )
 
+// KeyPrefixLength is the number of leading characters of a key that are stored in plaintext to look up its hash
+const KeyPrefixLength = 8
+
 // GenKey returns a base64 encoded 256 bit key
 func GenKey() (string, error) {
 	key := make([]byte, 32)
-	_, err := rand.Read(key)
+	_, err := crand.Read(key)
 	if err != nil {
 		return "", err
 	}
@@ -19,10 +26,60 @@ func GenKey() (string, error) {
 // GenURLRandStr returns a base64 encoded string
 func GenURLRandStr(length int) (string, error) {
 	key := make([]byte, length)
-	_, err := rand.Read(key)
+	_, err := crand.Read(key)
 	if err != nil {
 		return "", err
 	}
 
 	return base64.URLEncoding.EncodeToString(key), nil
 }
+
+// GenRoutingTag returns a hex encoded 128 bit tag that identifies a wallet to senders of spontaneous payments
+func GenRoutingTag() (string, error) {
+	tag := make([]byte, 16)
+	_, err := crand.Read(tag)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(tag), nil
+}
+
+// KeyPrefix returns the leading characters of key that its hash is looked up by
+func KeyPrefix(key string) string {
+	if len(key) < KeyPrefixLength {
+		return key
+	}
+	return key[:KeyPrefixLength]
+}
+
+// HashKey returns the hex encoded 128 bit salt and SHA-256 hash of the salted key, separated by a colon.
+// Keys are random 256 bit values, so a fast hash is enough to keep them secret.
+func HashKey(key string) (string, error) {
+	salt := make([]byte, 16)
+	_, err := crand.Read(salt)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(append(salt, key...))
+	return hex.EncodeToString(salt) + ":" + hex.EncodeToString(hash[:]), nil
+}
+
+// KeyMatchesHash returns true if hash is the result of HashKey for key
+func KeyMatchesHash(key, hash string) bool {
+	parts := strings.SplitN(hash, ":", 2)
+	if len(parts) != 2 {
+		return false
+	}
+	salt, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return false
+	}
+	expected, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return false
+	}
+	actual := sha256.Sum256(append(salt, key...))
+	return subtle.ConstantTimeCompare(expected, actual[:]) == 1
+}
@@ -0,0 +1,28 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashKey(t *testing.T) {
+	key, err := GenKey()
+	require.NoError(t, err)
+	hash, err := HashKey(key)
+	require.NoError(t, err)
+	other, err := HashKey(key)
+	require.NoError(t, err)
+
+	require.NotEqual(t, hash, other, "hashes are salted")
+	require.True(t, KeyMatchesHash(key, hash))
+	require.True(t, KeyMatchesHash(key, other))
+	require.False(t, KeyMatchesHash(key[1:], hash))
+	require.False(t, KeyMatchesHash(key, ""))
+	require.False(t, KeyMatchesHash(key, "not:hex"))
+}
+
+func TestKeyPrefix(t *testing.T) {
+	require.Equal(t, "abcdefgh", KeyPrefix("abcdefghijkl"))
+	require.Equal(t, "abc", KeyPrefix("abc"))
+}
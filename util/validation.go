@@ -2,6 +2,7 @@ package util
 
 import (
 	"errors"
+	"net/url"
 	"regexp"
 )
 
@@ -13,6 +14,12 @@ var (
 	// any latin character or arabic digit, with the option of hyphens and periods if
 	// surrounded by latin character or arabic digits.
 	usernameRegex = regexp.MustCompile(`^[a-zA-Z\d]+((.|-)[a-zA-Z\d]+)*$`)
+	// any non-empty combination of printable ascii characters other than space
+	idempotencyKeyRegex = regexp.MustCompile(`^[\x21-\x7e]+$`)
+	// hex encoded compressed public key
+	pubkeyRegex = regexp.MustCompile(`^0[23][0-9a-f]{64}$`)
+	// any printable characters, including spaces
+	memoRegex = regexp.MustCompile(`^[^\p{C}]*$`)
 )
 
 func ValidateWalletID(id string) error {
@@ -50,3 +57,77 @@ func ValidateUsername(name string) error {
 			"If it contains hyphens or periods it must be surrounded by alphanumeric characters")
 	}
 }
+
+func ValidateWebhookURL(rawURL string) error {
+	if rawURL == "" {
+		return errors.New("url cannot be blank")
+	} else if len(rawURL) > 2048 {
+		return errors.New("url must be at most 2048 characters")
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.New("url is malformed")
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.New("url scheme must be http or https")
+	} else if u.Host == "" {
+		return errors.New("url must contain a host")
+	} else {
+		return nil
+	}
+}
+
+func ValidateIdempotencyKey(key string) error {
+	if len(key) > 255 {
+		return errors.New("idempotency key must be at most 255 characters")
+	} else if idempotencyKeyRegex.MatchString(key) {
+		return nil
+	} else {
+		return errors.New("idempotency key must contain only printable ASCII characters other than space")
+	}
+}
+
+func ValidatePubkey(pubkey string) error {
+	if len(pubkey) != 66 {
+		return errors.New("pubkey must be 66 characters")
+	} else if pubkeyRegex.MatchString(pubkey) {
+		return nil
+	} else {
+		return errors.New("pubkey must be a hex encoded compressed public key")
+	}
+}
+
+func ValidateMemo(memo string) error {
+	if len(memo) > 256 {
+		return errors.New("memo must be at most 256 bytes")
+	} else if memoRegex.MatchString(memo) {
+		return nil
+	} else {
+		return errors.New("memo must contain only printable characters")
+	}
+}
+
+func ValidateApiKeyName(name string) error {
+	if name == "" {
+		return errors.New("api key name cannot be blank")
+	} else if len(name) > 64 {
+		return errors.New("api key name must be at most 64 characters")
+	} else if walletNameRegex.MatchString(name) {
+		return nil
+	} else {
+		return errors.New("api key name must contain only spaces and non-whitespace characters. " +
+			"Each space must be surrounded by non-whitespaces.")
+	}
+}
+
+func ValidateAdminName(name string) error {
+	if name == "" {
+		return errors.New("admin name cannot be blank")
+	} else if len(name) > 64 {
+		return errors.New("admin name must be at most 64 characters")
+	} else if usernameRegex.MatchString(name) {
+		return nil
+	} else {
+		return errors.New("admin name must be alphanumeric. " +
+			"If it contains hyphens or periods it must be surrounded by alphanumeric characters")
+	}
+}
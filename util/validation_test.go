@@ -99,3 +99,68 @@ func TestValidateUsername(t *testing.T) {
 	require.Error(t, ValidateUsername(strings.Repeat("a", 65)), "username that is too long should error")
 	require.Error(t, ValidateUsername(strings.Repeat("a", 1000)), "username that is too long should error")
 }
+
+func TestValidateWebhookURL(t *testing.T) {
+	require.NoError(t, ValidateWebhookURL("https://example.com/hook"))
+	require.NoError(t, ValidateWebhookURL("http://localhost:8080"))
+	require.NoError(t, ValidateWebhookURL("https://example.com/hook?token=abc"))
+
+	require.Error(t, ValidateWebhookURL(""), "url cannot be blank")
+	require.Error(t, ValidateWebhookURL("example.com/hook"), "url must have a scheme")
+	require.Error(t, ValidateWebhookURL("ftp://example.com"), "url scheme must be http or https")
+	require.Error(t, ValidateWebhookURL("https://"), "url must have a host")
+	require.Error(t, ValidateWebhookURL("https://example.com/"+strings.Repeat("a", 2048)), "url is too long")
+}
+
+func TestValidateIdempotencyKey(t *testing.T) {
+	require.NoError(t, ValidateIdempotencyKey("a"))
+	require.NoError(t, ValidateIdempotencyKey("3f1c9b0e-4a7d-4f7a-9c1e-2b8d6f0a5e41"))
+	require.NoError(t, ValidateIdempotencyKey("order:1234/retry#1"))
+	require.NoError(t, ValidateIdempotencyKey(strings.Repeat("a", 255)))
+
+	require.Error(t, ValidateIdempotencyKey(""), "key cannot be blank")
+	require.Error(t, ValidateIdempotencyKey("a a"), "key cannot contain spaces")
+	require.Error(t, ValidateIdempotencyKey("a\n"), "key cannot contain whitespace")
+	require.Error(t, ValidateIdempotencyKey("ключ"), "key must be ascii")
+	require.Error(t, ValidateIdempotencyKey(strings.Repeat("a", 256)), "key is too long")
+}
+
+func TestValidatePubkey(t *testing.T) {
+	require.NoError(t, ValidatePubkey("02"+strings.Repeat("a", 64)))
+	require.NoError(t, ValidatePubkey("03"+strings.Repeat("0", 64)))
+
+	require.Error(t, ValidatePubkey(""), "pubkey cannot be blank")
+	require.Error(t, ValidatePubkey("04"+strings.Repeat("a", 64)), "pubkey must be compressed")
+	require.Error(t, ValidatePubkey("02"+strings.Repeat("A", 64)), "pubkey must be lowercase hex")
+	require.Error(t, ValidatePubkey("02"+strings.Repeat("g", 64)), "pubkey must be hex")
+	require.Error(t, ValidatePubkey("02"+strings.Repeat("a", 63)), "pubkey is too short")
+}
+
+func TestValidateMemo(t *testing.T) {
+	require.NoError(t, ValidateMemo(""))
+	require.NoError(t, ValidateMemo("rent for march 🏠"))
+	require.NoError(t, ValidateMemo(strings.Repeat("a", 256)))
+
+	require.Error(t, ValidateMemo("line\nbreak"), "memo cannot contain control characters")
+	require.Error(t, ValidateMemo(strings.Repeat("a", 257)), "memo is too long")
+}
+
+func TestValidateApiKeyName(t *testing.T) {
+	require.NoError(t, ValidateApiKeyName("point of sale"))
+	require.NoError(t, ValidateApiKeyName(strings.Repeat("a", 64)))
+
+	require.Error(t, ValidateApiKeyName(""), "name cannot be blank")
+	require.Error(t, ValidateApiKeyName(" pos"), "name cannot start with a space")
+	require.Error(t, ValidateApiKeyName("point  of sale"), "spaces must be surrounded by non-whitespace")
+	require.Error(t, ValidateApiKeyName(strings.Repeat("a", 65)), "name is too long")
+}
+
+func TestValidateAdminName(t *testing.T) {
+	require.NoError(t, ValidateAdminName("support.alice"))
+	require.NoError(t, ValidateAdminName(strings.Repeat("a", 64)))
+
+	require.Error(t, ValidateAdminName(""), "name cannot be blank")
+	require.Error(t, ValidateAdminName("alice--bob"), "hyphens must be surrounded by alphanumeric characters")
+	require.Error(t, ValidateAdminName("alice."), "periods must be surrounded by alphanumeric characters")
+	require.Error(t, ValidateAdminName(strings.Repeat("a", 65)), "name is too long")
+}
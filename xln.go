@@ -17,12 +17,22 @@ import (
 	"github.com/xbit-gg/xln/db"
 	"github.com/xbit-gg/xln/lnd"
 	lnAuth "github.com/xbit-gg/xln/lnurl/auth"
+	"github.com/xbit-gg/xln/lnurl/pay"
 	"github.com/xbit-gg/xln/lnurl/withdraw"
+	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/resources/admin"
+	"github.com/xbit-gg/xln/resources/apikey"
+	"github.com/xbit-gg/xln/resources/events"
+	"github.com/xbit-gg/xln/resources/idempotency"
 	"github.com/xbit-gg/xln/resources/invoice"
+	"github.com/xbit-gg/xln/resources/macaroon"
 	"github.com/xbit-gg/xln/resources/pendinginvoices"
 	"github.com/xbit-gg/xln/resources/pendingpayments"
+	"github.com/xbit-gg/xln/resources/reconciliation"
+	"github.com/xbit-gg/xln/resources/schedule"
 	"github.com/xbit-gg/xln/resources/user"
 	"github.com/xbit-gg/xln/resources/wallet"
+	"github.com/xbit-gg/xln/resources/webhook"
 	"github.com/xbit-gg/xln/util"
 	"github.com/xbit-gg/xln/xlnrpc"
 	"google.golang.org/grpc"
@@ -38,6 +48,7 @@ var xlnHeaders = map[string]struct{}{
 	auth.AdminApiKeyHeader:  {},
 	auth.WalletApiKeyHeader: {},
 	auth.UserApiKeyHeader:   {},
+	auth.MacaroonHeader:     {},
 }
 
 // XLN stores the data associated with an XLN instance.
@@ -50,11 +61,20 @@ type XLN struct {
 
 	Users           user.Manager
 	Wallets         wallet.Manager
+	Events          events.Manager
+	Webhooks        webhook.Manager
 	Invoices        invoice.Manager
 	PendingInvoices pendinginvoices.Manager
 	PendingPayments pendingpayments.Manager
 	LNURLAuths      lnAuth.Manager
 	LNURLWithdraw   withdraw.Manager
+	LNURLPay        pay.Manager
+	Reconciliation  reconciliation.Manager
+	Idempotency     idempotency.Manager
+	Schedules       schedule.Manager
+	ApiKeys         apikey.Manager
+	Macaroons       macaroon.Manager
+	Admins          admin.Manager
 
 	AuthService auth.Service
 }
@@ -69,6 +89,9 @@ func NewXLN(config *cfg.Config) (*XLN, error) {
 	if config.ShowVersion {
 		log.Fatal("XLN version: ", version)
 	}
+	if config.LoginSessionLifetime <= 0 {
+		return nil, fmt.Errorf("login session lifetime must be positive")
+	}
 
 	// Create data directory
 	if !util.FileExists(config.XLNDir) {
@@ -93,16 +116,57 @@ func NewXLN(config *cfg.Config) (*XLN, error) {
 	}
 
 	// Setup Managers
-	xln.Users = user.NewManager(xln.DB)
-	xln.Wallets = wallet.NewManager(xln.DB)
-	xln.Invoices = invoice.NewManager(xln.LndClient, xln.Wallets, xln.DB, xln.Config.MaxPayment)
+	fees := xln.Config.Fees
+	var revenueUser string
+	if fees.RevenueWallet != "" {
+		revenueUser = fees.RevenueUser
+	}
+	xln.Users = user.NewManager(xln.DB, revenueUser)
+	xln.Events = events.NewManager()
+	xln.Wallets = wallet.NewManager(xln.DB, xln.Events, wallet.ServiceFees{
+		Schedule: models.FeeSchedule{
+			Transfer: models.FeeRate{BaseMsat: fees.TransferBaseMsat, Ppm: fees.TransferPpm},
+			Internal: models.FeeRate{BaseMsat: fees.InternalBaseMsat, Ppm: fees.InternalPpm},
+			External: models.FeeRate{BaseMsat: fees.ExternalBaseMsat, Ppm: fees.ExternalPpm},
+		},
+		RevenueUsername: fees.RevenueUser,
+		RevenueWalletID: fees.RevenueWallet,
+	})
+	if fees.RevenueWallet != "" {
+		if _, err := xln.Wallets.GetWallet(fees.RevenueUser, fees.RevenueWallet); err != nil {
+			return nil, fmt.Errorf("failed to get revenue wallet: %v", err)
+		}
+	}
+	xln.Webhooks = webhook.NewManager(xln.DB)
+	xln.Invoices = invoice.NewManager(xln.LndClient, xln.Wallets, xln.Webhooks, xln.Events, xln.DB, xln.Config.MaxPayment,
+		invoice.PaymentBounds{
+			MaxFeePpm:      xln.Config.MaxPaymentFeePpm,
+			TimeoutSeconds: uint32(xln.Config.MaxPaymentTimeout.Seconds()),
+			MaxParts:       xln.Config.MaxPaymentParts,
+		})
 	xln.PendingInvoices = pendinginvoices.NewManager(xln.DB)
 	xln.PendingPayments = pendingpayments.NewManager(xln.DB)
 	xln.LNURLAuths = lnAuth.NewManager(xln.Config.Serving.Hostname, xln.DB)
 	xln.LNURLWithdraw = withdraw.NewManager(xln.Config.Serving.Hostname, xln.DB)
+	xln.LNURLPay = pay.NewManager(xln.Config.Serving.Hostname, xln.DB, xln.Invoices, xln.Config.MaxPayment)
+	xln.Reconciliation = reconciliation.NewManager(xln.LndClient, xln.DB, xln.Config.ReconcileInterval)
+	xln.Idempotency = idempotency.NewManager(xln.DB)
+	xln.Schedules = schedule.NewManager(xln.DB, xln.Invoices, xln.Config.MaxPayment)
+	xln.ApiKeys = apikey.NewManager(xln.DB)
+	xln.Macaroons = macaroon.NewManager(xln.DB)
+	xln.Admins = admin.NewManager(xln.DB, xln.Config.DisableBootstrapKey)
+	if xln.Config.DisableBootstrapKey {
+		if admins, err := xln.Admins.CountAdmins(models.AdminRoleAdmin); err != nil {
+			return nil, err
+		} else if admins == 0 {
+			log.Warn("The bootstrap api key is disabled, but no admin accounts have the admin role. " +
+				"Enable the bootstrap api key to manage admins")
+		}
+	}
 
 	// Initialize Services
-	xln.AuthService = auth.NewService(xln.Config.XLNApiKey, &xln.Users, &xln.Wallets)
+	xln.AuthService = auth.NewService(xln.Config.XLNApiKey, xln.Config.DisableBootstrapKey, &xln.Users, &xln.Wallets,
+		&xln.ApiKeys, &xln.Macaroons, &xln.Admins)
 
 	return xln, nil
 }
@@ -130,6 +194,10 @@ func (xln *XLN) startGrpc() error {
 	if err != nil {
 		return err
 	}
+	// every RPC is authorized as its policy requires before it is called
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(xln.authUnaryInterceptor),
+		grpc.ChainStreamInterceptor(xln.authStreamInterceptor))
 	grpcServer := grpc.NewServer(opts...)
 
 	grpcPort := xln.Config.Serving.GrpcPort
@@ -223,11 +291,36 @@ func (xln *XLN) startRestProxy() error {
 func proxyServeOptions() []proxy.ServeMuxOption {
 	var opts []proxy.ServeMuxOption
 	opts = append(opts, proxy.WithMarshalerOption(proxy.MIMEWildcard, &proxy.JSONPb{EmitDefaults: true}))
+	opts = append(opts, proxy.WithMarshalerOption(eventStreamMIME, &eventStreamMarshaler{proxy.JSONPb{EmitDefaults: true}}))
 	opts = append(opts, proxy.WithIncomingHeaderMatcher(matchXlnHeaders))
 
 	return opts
 }
 
+const eventStreamMIME = "text/event-stream"
+
+// eventStreamMarshaler writes the messages of streaming RPCs as server-sent events.
+// It is used by requests that accept text/event-stream.
+type eventStreamMarshaler struct {
+	proxy.JSONPb
+}
+
+func (m *eventStreamMarshaler) ContentType() string {
+	return eventStreamMIME
+}
+
+func (m *eventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("data: "), data...), nil
+}
+
+func (m *eventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
+
 func matchXlnHeaders(header string) (string, bool) {
 	header = strings.ToLower(header)
 	_, contains := xlnHeaders[header]
@@ -6,11 +6,15 @@ import (
 	"strings"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/xbit-gg/xln/auth"
 	"github.com/xbit-gg/xln/models"
+	adminManager "github.com/xbit-gg/xln/resources/admin"
+	"github.com/xbit-gg/xln/resources/macaroon"
 	"github.com/xbit-gg/xln/util"
 	"github.com/xbit-gg/xln/xlnrpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -29,12 +33,6 @@ var _ xlnrpc.XlnAdminServer = (*xlnAdminServer)(nil)
 
 func (x xlnAdminServer) GetInfo(ctx context.Context, request *xlnrpc.GetAdminInfoRequest) (*xlnrpc.GetAdminInfoResponse, error) {
 	log.WithField("req", request).Debug("XlnAdmin.GetInfo called")
-	err := x.xln.AuthService.ValidateAdminCredentials(ctx, "XlnAdmin.GetInfo")
-	if err != nil {
-		st := status.New(codes.Unauthenticated, fmt.Sprintf("invalid authentication for Admin GetInfo"))
-		log.WithError(err).Warn("XlnAdmin.GetInfo request failed authentication")
-		return nil, st.Err()
-	}
 	users, err := x.xln.Users.ListUsers()
 	if err != nil {
 		st := status.New(codes.Internal, fmt.Sprintf("unable to list users: %v", err))
@@ -50,13 +48,7 @@ func (x xlnAdminServer) GetInfo(ctx context.Context, request *xlnrpc.GetAdminInf
 
 func (x xlnAdminServer) CreateUser(ctx context.Context, request *xlnrpc.CreateUserRequest) (*xlnrpc.CreateUserResponse, error) {
 	log.WithField("req", request).Debug("XlnAdmin.CreateUser called")
-	err := x.xln.AuthService.ValidateAdminCredentials(ctx, "XlnAdmin.CreateUser")
-	if err != nil {
-		st := status.New(codes.Unauthenticated, fmt.Sprintf("invalid authentication for CreateUser"))
-		log.WithError(err).Warn("CreateUser request failed authentication")
-		return nil, st.Err()
-	}
-	err = util.ValidateUsername(request.Username)
+	err := util.ValidateUsername(request.Username)
 	if err != nil {
 		st := status.New(codes.InvalidArgument, fmt.Sprintf("Invalid user name: %v", err))
 		log.WithError(err).Warn("CreateUser requested with invalid user name")
@@ -87,16 +79,13 @@ func (x xlnAdminServer) CreateUser(ctx context.Context, request *xlnrpc.CreateUs
 
 func (x xlnAdminServer) DeleteUser(ctx context.Context, request *xlnrpc.DeleteUserRequest) (*xlnrpc.DeleteUserResponse, error) {
 	log.WithField("req", request).Debug("XlnAdmin.DeleteUser called")
-	err := x.xln.AuthService.ValidateAdminCredentials(ctx, "XlnAdmin.DeleteUser")
-	if err != nil {
-		st := status.New(codes.Unauthenticated, "invalid authentication for DeleteUser")
-		log.WithError(err).Warn("DeleteUser request failed authentication")
-		return nil, st.Err()
-	}
-	err = x.xln.Users.DeleteUser(request.Username, strings.HasPrefix(x.xln.Config.DatabaseConnectionString, "postgres"))
+	err := x.xln.Users.DeleteUser(request.Username, strings.HasPrefix(x.xln.Config.DatabaseConnectionString, "postgres"))
 	if err == models.ErrUserNotFound {
 		st := status.New(codes.NotFound, err.Error())
 		return nil, st.Err()
+	} else if err == models.ErrCannotDeleteRevenueWallet {
+		st := status.New(codes.FailedPrecondition, err.Error())
+		return nil, st.Err()
 	} else if err != nil {
 		st := status.New(codes.InvalidArgument, err.Error())
 		return nil, st.Err()
@@ -107,12 +96,7 @@ func (x xlnAdminServer) DeleteUser(ctx context.Context, request *xlnrpc.DeleteUs
 
 func (x xlnAdminServer) UpdateWallet(ctx context.Context, request *xlnrpc.UpdateWalletRequest) (*xlnrpc.UpdateWalletResponse, error) {
 	log.WithField("req", request).Debug("XlnAdmin.UpdateWallet called")
-	err := x.xln.AuthService.ValidateAdminCredentials(ctx, "XlnAdmin.UpdateWallet")
-	if err != nil {
-		st := status.New(codes.Unauthenticated, fmt.Sprintf("Invalid authentication for UpdateWallet. Reason: %v", err))
-		log.WithError(err).Warn("UpdateWallet request failed authentication")
-		return nil, st.Err()
-	}
+	var err error
 	walletOptions := models.WalletOptions{}
 	if request.Lock && request.Unlock {
 		st := status.New(codes.InvalidArgument, "Invalid wallet options. "+
@@ -120,6 +104,18 @@ func (x xlnAdminServer) UpdateWallet(ctx context.Context, request *xlnrpc.Update
 		log.Warn("Invalid wallet options")
 		return nil, st.Err()
 	}
+	// the support role fails closed, so that options added later are not allowed to it by default
+	lockOnly := &xlnrpc.UpdateWalletRequest{
+		Username: request.Username,
+		WalletId: request.WalletId,
+		Lock:     request.Lock,
+		Unlock:   request.Unlock,
+	}
+	if auth.IdentityFromContext(ctx).Role == models.AdminRoleSupport && !proto.Equal(request, lockOnly) {
+		st := status.New(codes.PermissionDenied, "Admins with the support role may only lock and unlock wallets")
+		log.Warn("UpdateWallet requested with options that the role of the admin does not allow")
+		return nil, st.Err()
+	}
 	if request.UpdateBalance {
 		newBalance := request.Balance
 		walletOptions.Balance = &newBalance
@@ -144,6 +140,18 @@ func (x xlnAdminServer) UpdateWallet(ctx context.Context, request *xlnrpc.Update
 		}
 		walletOptions.Name = &request.WalletName
 	}
+	if request.SpendingPolicy != nil {
+		if walletOptions.SpendingPolicy, err = convertSpendingPolicy(request.SpendingPolicy); err != nil {
+			st := status.New(codes.InvalidArgument, fmt.Sprintf("Invalid spending policy. Reason: %v", err))
+			return nil, st.Err()
+		}
+	}
+	if request.UserSpendingPolicy != nil {
+		if walletOptions.UserSpendingPolicy, err = convertSpendingPolicy(request.UserSpendingPolicy); err != nil {
+			st := status.New(codes.InvalidArgument, fmt.Sprintf("Invalid user spending policy. Reason: %v", err))
+			return nil, st.Err()
+		}
+	}
 	err = x.xln.Wallets.UpdateWalletOptions(request.Username, request.WalletId, &walletOptions)
 	if err == models.ErrWalletNotFound {
 		st := status.New(codes.NotFound, err.Error())
@@ -164,12 +172,6 @@ func (x xlnAdminServer) UpdateWallet(ctx context.Context, request *xlnrpc.Update
 
 func (x xlnAdminServer) ListUsers(ctx context.Context, request *xlnrpc.ListUsersRequest) (*xlnrpc.ListUsersResponse, error) {
 	log.WithField("req", request).Debug("XlnAdmin.ListUsers called")
-	err := x.xln.AuthService.ValidateAdminCredentials(ctx, "XlnAdmin.ListUsers")
-	if err != nil {
-		st := status.New(codes.Unauthenticated, fmt.Sprintf("invalid authentication for ListUsers"))
-		log.WithError(err).Warn("ListUsers request failed authentication")
-		return nil, st.Err()
-	}
 	users, err := x.xln.Users.ListUsers()
 	if err != nil {
 		st := status.New(codes.InvalidArgument, fmt.Sprintf("unable to list users: %v", err))
@@ -187,16 +189,13 @@ func (x xlnAdminServer) ListUsers(ctx context.Context, request *xlnrpc.ListUsers
 
 func (x xlnAdminServer) AdminDeleteWallet(ctx context.Context, request *xlnrpc.AdminDeleteWalletRequest) (*xlnrpc.AdminDeleteWalletResponse, error) {
 	log.WithField("req", request).Debug("XlnAdmin.DeleteUser called")
-	err := x.xln.AuthService.ValidateAdminCredentials(ctx, "XlnAdmin.DeleteUser")
-	if err != nil {
-		st := status.New(codes.Unauthenticated, "invalid authentication for DeleteUser")
-		log.WithError(err).Warn("DeleteUser request failed authentication")
-		return nil, st.Err()
-	}
-	err = x.xln.Wallets.AdminDeleteWallet(request.Username, request.WalletId, strings.HasPrefix(x.xln.Config.DatabaseConnectionString, "postgres"))
+	err := x.xln.Wallets.AdminDeleteWallet(request.Username, request.WalletId, strings.HasPrefix(x.xln.Config.DatabaseConnectionString, "postgres"))
 	if err == models.ErrWalletNotFound {
 		st := status.New(codes.NotFound, err.Error())
 		return nil, st.Err()
+	} else if err == models.ErrCannotDeleteRevenueWallet {
+		st := status.New(codes.FailedPrecondition, err.Error())
+		return nil, st.Err()
 	} else if err != nil {
 		st := status.New(codes.Internal, fmt.Sprintf("Failed to delete wallet. Reason: %v", err.Error()))
 		return nil, st.Err()
@@ -207,12 +206,6 @@ func (x xlnAdminServer) AdminDeleteWallet(ctx context.Context, request *xlnrpc.A
 
 func (x xlnAdminServer) GetInvoice(ctx context.Context, request *xlnrpc.GetInvoiceRequest) (*xlnrpc.GetInvoiceResponse, error) {
 	log.WithField("req", request).Debug("XlnAdmin.GetInvoice called")
-	err := x.xln.AuthService.ValidateAdminCredentials(ctx, "XlnAdmin.GetInvoice")
-	if err != nil {
-		st := status.New(codes.Unauthenticated, fmt.Sprintf("Invalid authentication for GetInvoice. Reason: %v", err))
-		log.WithError(err).Warn("GetInvoice request failed authentication")
-		return nil, st.Err()
-	}
 	invoice, err := x.xln.Invoices.GetInvoice(request.PaymentHash)
 	if err == models.ErrInvoiceNotFound {
 		st := status.New(codes.NotFound, err.Error())
@@ -248,12 +241,6 @@ func (x xlnAdminServer) GetInvoice(ctx context.Context, request *xlnrpc.GetInvoi
 
 func (x xlnAdminServer) ListPendingInvoices(ctx context.Context, request *xlnrpc.ListPendingInvoicesRequest) (*xlnrpc.ListPendingInvoicesResponse, error) {
 	log.WithField("req", request).Debug("XlnAdmin.ListPendingInvoices called")
-	err := x.xln.AuthService.ValidateAdminCredentials(ctx, "XlnAdmin.ListPendingInvoices")
-	if err != nil {
-		st := status.New(codes.Unauthenticated, fmt.Sprintf("Invalid authentication for ListPendingInvoices. Reason: %v", err))
-		log.WithError(err).Warn("ListPendingInvoices request failed authentication")
-		return nil, st.Err()
-	}
 	pendingInvoices, err := x.xln.PendingInvoices.ListPendingInvoices()
 	if err != nil {
 		st := status.New(codes.Internal, fmt.Sprintf("Failed to list pending invoices. Reason: %v", err))
@@ -275,12 +262,6 @@ func (x xlnAdminServer) ListPendingInvoices(ctx context.Context, request *xlnrpc
 
 func (x xlnAdminServer) ListPendingPayments(ctx context.Context, request *xlnrpc.ListPendingPaymentsRequest) (*xlnrpc.ListPendingPaymentsResponse, error) {
 	log.WithField("req", request).Debug("Xln.ListPendingPayments called")
-	err := x.xln.AuthService.ValidateAdminCredentials(ctx, "XlnAdmin.ListPendingPayments")
-	if err != nil {
-		st := status.New(codes.Unauthenticated, fmt.Sprintf("Invalid authentication for ListPendingPayments. Reason: %v", err))
-		log.WithError(err).Warn("ListPendingPayments request failed authentication")
-		return nil, st.Err()
-	}
 	pendingPayments, err := x.xln.PendingPayments.ListPendingPayments()
 	if err != nil {
 		st := status.New(codes.Internal, fmt.Sprintf("Failed to list pending invoices. Reason: %v", err))
@@ -299,3 +280,232 @@ func (x xlnAdminServer) ListPendingPayments(ctx context.Context, request *xlnrpc
 		return &res, nil
 	}
 }
+
+func (x xlnAdminServer) GetReconciliationReport(ctx context.Context, request *xlnrpc.GetReconciliationReportRequest) (*xlnrpc.GetReconciliationReportResponse, error) {
+	log.WithField("req", request).Debug("XlnAdmin.GetReconciliationReport called")
+	report, err := x.xln.Reconciliation.GetReport(request.Refresh)
+	if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to reconcile wallets. Reason: %v", err))
+		log.WithError(err).Warn("GetReconciliationReport request failed")
+		return nil, st.Err()
+	}
+	res := &xlnrpc.GetReconciliationReportResponse{
+		Time:                timestamppb.New(report.Time),
+		WalletBalanceTotal:  report.WalletBalanceTotal,
+		PendingPaymentTotal: report.PendingPaymentTotal,
+		ChannelLocalBalance: report.ChannelLocalBalance,
+		Surplus:             report.Surplus,
+	}
+	for _, mismatch := range report.Mismatches {
+		res.Mismatches = append(res.Mismatches, &xlnrpc.ReconciliationMismatch{
+			Kind:          mismatch.Kind,
+			PaymentHash:   mismatch.PaymentHash,
+			Username:      mismatch.Username,
+			WalletId:      mismatch.WalletID,
+			XlnAmountMsat: mismatch.XlnAmountMsat,
+			LndAmountMsat: mismatch.LndAmountMsat,
+		})
+	}
+	return res, nil
+}
+
+func (x xlnAdminServer) GetUserFeeSchedule(ctx context.Context, request *xlnrpc.GetUserFeeScheduleRequest) (*xlnrpc.GetUserFeeScheduleResponse, error) {
+	log.WithField("req", request).Debug("XlnAdmin.GetUserFeeSchedule called")
+	if _, err := x.xln.Users.GetUser(request.Username); err == models.ErrUserNotFound {
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	} else if err != nil {
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
+	schedule, err := x.xln.Wallets.GetFeeSchedule(request.Username)
+	if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to get fee schedule. Reason: %v", err))
+		log.WithError(err).Warn("GetUserFeeSchedule request failed")
+		return nil, st.Err()
+	}
+	return &xlnrpc.GetUserFeeScheduleResponse{
+		FeeSchedule: &xlnrpc.FeeSchedule{
+			Transfer: &xlnrpc.FeeRate{BaseMsat: schedule.Transfer.BaseMsat, Ppm: schedule.Transfer.Ppm},
+			Internal: &xlnrpc.FeeRate{BaseMsat: schedule.Internal.BaseMsat, Ppm: schedule.Internal.Ppm},
+			External: &xlnrpc.FeeRate{BaseMsat: schedule.External.BaseMsat, Ppm: schedule.External.Ppm},
+		},
+		Custom: schedule.Username != "",
+	}, nil
+}
+
+func (x xlnAdminServer) SetUserFeeSchedule(ctx context.Context, request *xlnrpc.SetUserFeeScheduleRequest) (*xlnrpc.SetUserFeeScheduleResponse, error) {
+	log.WithField("req", request).Debug("XlnAdmin.SetUserFeeSchedule called")
+	if _, err := x.xln.Users.GetUser(request.Username); err == models.ErrUserNotFound {
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	} else if err != nil {
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
+	var schedule *models.FeeSchedule
+	if request.FeeSchedule != nil {
+		schedule = &models.FeeSchedule{
+			Transfer: convertFeeRate(request.FeeSchedule.Transfer),
+			Internal: convertFeeRate(request.FeeSchedule.Internal),
+			External: convertFeeRate(request.FeeSchedule.External),
+		}
+	}
+	if err := x.xln.Wallets.SetFeeSchedule(request.Username, schedule); err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to set fee schedule. Reason: %v", err))
+		log.WithError(err).Warn("SetUserFeeSchedule request failed")
+		return nil, st.Err()
+	}
+	log.WithField("user", request.Username).Info("Fee schedule updated")
+	return &xlnrpc.SetUserFeeScheduleResponse{}, nil
+}
+
+func (x xlnAdminServer) BakeMacaroon(ctx context.Context, request *xlnrpc.BakeMacaroonRequest) (*xlnrpc.BakeMacaroonResponse, error) {
+	log.WithField("req", request).Debug("XlnAdmin.BakeMacaroon called")
+	for _, method := range request.Methods {
+		if !isXlnMethod(method) {
+			return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Unknown method: %s", method)).Err()
+		}
+	}
+	caveats := &macaroon.Caveats{Methods: request.Methods}
+	if request.WalletId != "" {
+		caveats.WalletID = &request.WalletId
+	}
+	if request.MaxAmountMsat > 0 {
+		caveats.MaxAmountMsat = &request.MaxAmountMsat
+	}
+	if request.ExpiryTime != nil {
+		expiresAt := request.ExpiryTime.AsTime().UTC()
+		caveats.ExpiresAt = &expiresAt
+	}
+	if request.IpAddress != "" {
+		caveats.IPAddress = &request.IpAddress
+	}
+	mac, err := x.xln.Macaroons.Bake(request.Username, caveats)
+	if err == models.ErrUserNotFound || err == models.ErrWalletNotFound {
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	} else if err != nil {
+		st := status.New(codes.InvalidArgument, fmt.Sprintf("Failed to bake macaroon. Reason: %v", err))
+		log.WithError(err).Warn("BakeMacaroon request failed")
+		return nil, st.Err()
+	}
+	return &xlnrpc.BakeMacaroonResponse{Macaroon: mac}, nil
+}
+
+func (x xlnAdminServer) CreateAdmin(ctx context.Context, request *xlnrpc.CreateAdminRequest) (*xlnrpc.CreateAdminResponse, error) {
+	log.WithField("req", request).Debug("XlnAdmin.CreateAdmin called")
+	admin, err := x.xln.Admins.CreateAdmin(request.Name, request.Role)
+	if err != nil && strings.Contains(err.Error(), models.MsgDuplicateAdminName) {
+		return nil, status.New(codes.AlreadyExists, fmt.Sprintf("Failed to create admin. Reason: %v", err)).Err()
+	} else if err != nil {
+		st := status.New(codes.InvalidArgument, fmt.Sprintf("Failed to create admin. Reason: %v", err))
+		log.WithError(err).Warn("CreateAdmin request failed")
+		return nil, st.Err()
+	}
+	return &xlnrpc.CreateAdminResponse{Admin: convertAdmin(admin), ApiKey: admin.Key}, nil
+}
+
+func (x xlnAdminServer) ListAdmins(ctx context.Context, request *xlnrpc.ListAdminsRequest) (*xlnrpc.ListAdminsResponse, error) {
+	log.WithField("req", request).Debug("XlnAdmin.ListAdmins called")
+	admins, err := x.xln.Admins.ListAdmins()
+	if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to list admins. Reason: %v", err))
+		log.WithError(err).Error("ListAdmins request failed")
+		return nil, st.Err()
+	}
+	res := &xlnrpc.ListAdminsResponse{Admins: make([]*xlnrpc.Admin, len(admins))}
+	for i, admin := range admins {
+		res.Admins[i] = convertAdmin(admin)
+	}
+	return res, nil
+}
+
+func (x xlnAdminServer) UpdateAdminRole(ctx context.Context, request *xlnrpc.UpdateAdminRoleRequest) (*xlnrpc.UpdateAdminRoleResponse, error) {
+	log.WithField("req", request).Debug("XlnAdmin.UpdateAdminRole called")
+	admin, err := x.xln.Admins.SetRole(request.Name, request.Role)
+	if err == models.ErrAdminNotFound {
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	} else if err == adminManager.ErrLastAdmin {
+		return nil, status.New(codes.FailedPrecondition, err.Error()).Err()
+	} else if err != nil {
+		st := status.New(codes.InvalidArgument, fmt.Sprintf("Failed to update admin. Reason: %v", err))
+		log.WithError(err).Warn("UpdateAdminRole request failed")
+		return nil, st.Err()
+	}
+	return &xlnrpc.UpdateAdminRoleResponse{Admin: convertAdmin(admin)}, nil
+}
+
+func (x xlnAdminServer) RotateAdminKey(ctx context.Context, request *xlnrpc.RotateAdminKeyRequest) (*xlnrpc.RotateAdminKeyResponse, error) {
+	log.WithField("req", request).Debug("XlnAdmin.RotateAdminKey called")
+	key, err := x.xln.Admins.RotateKey(request.Name)
+	if err == models.ErrAdminNotFound {
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	} else if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to rotate admin key. Reason: %v", err))
+		log.WithError(err).Error("RotateAdminKey request failed")
+		return nil, st.Err()
+	}
+	return &xlnrpc.RotateAdminKeyResponse{ApiKey: key}, nil
+}
+
+func (x xlnAdminServer) DeleteAdmin(ctx context.Context, request *xlnrpc.DeleteAdminRequest) (*xlnrpc.DeleteAdminResponse, error) {
+	log.WithField("req", request).Debug("XlnAdmin.DeleteAdmin called")
+	err := x.xln.Admins.DeleteAdmin(request.Name)
+	if err == models.ErrAdminNotFound {
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	} else if err == adminManager.ErrLastAdmin {
+		return nil, status.New(codes.FailedPrecondition, err.Error()).Err()
+	} else if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to delete admin. Reason: %v", err))
+		log.WithError(err).Error("DeleteAdmin request failed")
+		return nil, st.Err()
+	}
+	return &xlnrpc.DeleteAdminResponse{}, nil
+}
+
+func convertAdmin(admin *models.Admin) *xlnrpc.Admin {
+	return &xlnrpc.Admin{
+		Name:         admin.Name,
+		Role:         admin.Role,
+		CreationTime: timestamppb.New(admin.CreatedAt),
+	}
+}
+
+// isXlnMethod returns true if selector is the selector of an RPC of the Xln service, such as Xln.PayInvoice.
+func isXlnMethod(selector string) bool {
+	for _, method := range xlnrpc.Xln_ServiceDesc.Methods {
+		if selector == "Xln."+method.MethodName {
+			return true
+		}
+	}
+	for _, stream := range xlnrpc.Xln_ServiceDesc.Streams {
+		if selector == "Xln."+stream.StreamName {
+			return true
+		}
+	}
+	return false
+}
+
+func convertFeeRate(rate *xlnrpc.FeeRate) models.FeeRate {
+	if rate == nil {
+		return models.FeeRate{}
+	}
+	return models.FeeRate{BaseMsat: rate.BaseMsat, Ppm: rate.Ppm}
+}
+
+func convertSpendingPolicy(policy *xlnrpc.SpendingPolicy) (*models.SpendingPolicy, error) {
+	for _, pubkey := range policy.AllowedDestinations {
+		if err := util.ValidatePubkey(pubkey); err != nil {
+			return nil, err
+		}
+	}
+	for _, username := range policy.AllowedRecipients {
+		if err := util.ValidateUsername(username); err != nil {
+			return nil, err
+		}
+	}
+	return &models.SpendingPolicy{
+		MaxPayment:          policy.MaxPayment,
+		MaxDailyOutflow:     policy.MaxDailyOutflow,
+		MaxWeeklyOutflow:    policy.MaxWeeklyOutflow,
+		MaxHourlyPayments:   policy.MaxHourlyPayments,
+		AllowedDestinations: strings.Join(policy.AllowedDestinations, ","),
+		AllowedRecipients:   strings.Join(policy.AllowedRecipients, ","),
+	}, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/xbit-gg/xln/models"
 	"github.com/xbit-gg/xln/resources/user"
 	"github.com/xbit-gg/xln/xlnrpc"
+	"google.golang.org/grpc"
 )
 
 func init() {
@@ -67,7 +68,9 @@ func TestGetInfoFailsWhenUnauthorized(t *testing.T) {
 	authService, _, ctx, x := setupMocks()
 	authService.MockValidateAdminCredentials = func() error { return errors.New("test-error") }
 	request := xlnrpc.GetAdminInfoRequest{}
-	info, err := x.GetInfo(ctx, &request)
+	info, err := callAdmin(x, ctx, "GetInfo", &request, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return x.GetInfo(ctx, req.(*xlnrpc.GetAdminInfoRequest))
+	})
 	if err == nil {
 		t.Error("Unauthorized request should have errored")
 	}
@@ -157,7 +160,9 @@ func TestCreateUserFailsWhenUnauthorized(t *testing.T) {
 	authService, _, ctx, x := setupMocks()
 	authService.MockValidateAdminCredentials = func() error { return errors.New("test-error") }
 	request := xlnrpc.CreateUserRequest{}
-	user, err := x.CreateUser(ctx, &request)
+	user, err := callAdmin(x, ctx, "CreateUser", &request, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return x.CreateUser(ctx, req.(*xlnrpc.CreateUserRequest))
+	})
 	if err == nil {
 		t.Error("Unauthorized request should have errored")
 	}
@@ -208,7 +213,9 @@ func TestListUsersFailsWhenUnauthorized(t *testing.T) {
 	authService, _, ctx, x := setupMocks()
 	authService.MockValidateAdminCredentials = func() error { return errors.New("test-error") }
 	request := xlnrpc.ListUsersRequest{}
-	users, err := x.ListUsers(ctx, &request)
+	users, err := callAdmin(x, ctx, "ListUsers", &request, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return x.ListUsers(ctx, req.(*xlnrpc.ListUsersRequest))
+	})
 	if err == nil {
 		t.Error("Unauthorized request should have errored")
 	}
@@ -249,6 +256,10 @@ func (m *mockAuthService) ValidateAdminCredentials(_ context.Context, _ string)
 	return m.MockValidateAdminCredentials()
 }
 
+func (m *mockAuthService) Authorize(ctx context.Context, _ string, _ interface{}) (context.Context, error) {
+	return ctx, m.MockValidateAdminCredentials()
+}
+
 func (m *mockAuthService) ValidateUserCredentials(_ context.Context, _ string) (string, error) {
 	return "testusername", nil
 }
@@ -264,3 +275,10 @@ func setupMocks() (*mockAuthService, *mockUserManager, context.Context, xlnAdmin
 	xln := XLN{Version: version, AuthService: authService, Users: userManager}
 	return authService, userManager, context.Background(), xlnAdminServer{xln: &xln}
 }
+
+// callAdmin calls the handler of an XlnAdmin RPC through the interceptors of the gRPC server
+func callAdmin(x xlnAdminServer, ctx context.Context, method string, request interface{},
+	handler grpc.UnaryHandler) (interface{}, error) {
+	return x.xln.authUnaryInterceptor(ctx, request, &grpc.UnaryServerInfo{FullMethod: "/xlnrpc.XlnAdmin/" + method},
+		handler)
+}
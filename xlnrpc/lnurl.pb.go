@@ -343,6 +343,376 @@ func (x *WithdrawRequest) GetPr() string {
 	return ""
 }
 
+type RequestPayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	K1 string `protobuf:"bytes,1,opt,name=k1,proto3" json:"k1,omitempty"`
+}
+
+func (x *RequestPayRequest) Reset() {
+	*x = RequestPayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lnurl_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestPayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPayRequest) ProtoMessage() {}
+
+func (x *RequestPayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lnurl_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPayRequest.ProtoReflect.Descriptor instead.
+func (*RequestPayRequest) Descriptor() ([]byte, []int) {
+	return file_lnurl_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RequestPayRequest) GetK1() string {
+	if x != nil {
+		return x.K1
+	}
+	return ""
+}
+
+type RequestPayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Reason      string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Tag         string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Callback    string `protobuf:"bytes,4,opt,name=callback,proto3" json:"callback,omitempty"`
+	MinSendable uint64 `protobuf:"varint,5,opt,name=minSendable,proto3" json:"minSendable,omitempty"`
+	MaxSendable uint64 `protobuf:"varint,6,opt,name=maxSendable,proto3" json:"maxSendable,omitempty"`
+	Metadata    string `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *RequestPayResponse) Reset() {
+	*x = RequestPayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lnurl_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestPayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPayResponse) ProtoMessage() {}
+
+func (x *RequestPayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lnurl_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPayResponse.ProtoReflect.Descriptor instead.
+func (*RequestPayResponse) Descriptor() ([]byte, []int) {
+	return file_lnurl_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RequestPayResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RequestPayResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RequestPayResponse) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *RequestPayResponse) GetCallback() string {
+	if x != nil {
+		return x.Callback
+	}
+	return ""
+}
+
+func (x *RequestPayResponse) GetMinSendable() uint64 {
+	if x != nil {
+		return x.MinSendable
+	}
+	return 0
+}
+
+func (x *RequestPayResponse) GetMaxSendable() uint64 {
+	if x != nil {
+		return x.MaxSendable
+	}
+	return 0
+}
+
+func (x *RequestPayResponse) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+type PayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	K1     string `protobuf:"bytes,1,opt,name=k1,proto3" json:"k1,omitempty"`
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *PayRequest) Reset() {
+	*x = PayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lnurl_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayRequest) ProtoMessage() {}
+
+func (x *PayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lnurl_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayRequest.ProtoReflect.Descriptor instead.
+func (*PayRequest) Descriptor() ([]byte, []int) {
+	return file_lnurl_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PayRequest) GetK1() string {
+	if x != nil {
+		return x.K1
+	}
+	return ""
+}
+
+func (x *PayRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Reason string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Pr     string   `protobuf:"bytes,3,opt,name=pr,proto3" json:"pr,omitempty"`
+	Routes []string `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *PayResponse) Reset() {
+	*x = PayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lnurl_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayResponse) ProtoMessage() {}
+
+func (x *PayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lnurl_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayResponse.ProtoReflect.Descriptor instead.
+func (*PayResponse) Descriptor() ([]byte, []int) {
+	return file_lnurl_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PayResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PayResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PayResponse) GetPr() string {
+	if x != nil {
+		return x.Pr
+	}
+	return ""
+}
+
+func (x *PayResponse) GetRoutes() []string {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type RequestAddressPayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RequestAddressPayRequest) Reset() {
+	*x = RequestAddressPayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lnurl_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestAddressPayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAddressPayRequest) ProtoMessage() {}
+
+func (x *RequestAddressPayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lnurl_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestAddressPayRequest.ProtoReflect.Descriptor instead.
+func (*RequestAddressPayRequest) Descriptor() ([]byte, []int) {
+	return file_lnurl_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RequestAddressPayRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AddressPayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *AddressPayRequest) Reset() {
+	*x = AddressPayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lnurl_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressPayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressPayRequest) ProtoMessage() {}
+
+func (x *AddressPayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lnurl_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressPayRequest.ProtoReflect.Descriptor instead.
+func (*AddressPayRequest) Descriptor() ([]byte, []int) {
+	return file_lnurl_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddressPayRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddressPayRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
 var File_lnurl_proto protoreflect.FileDescriptor
 
 var file_lnurl_proto_rawDesc = []byte{
@@ -378,22 +748,71 @@ var file_lnurl_proto_rawDesc = []byte{
 	0x65, 0x22, 0x31, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6b, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x02, 0x6b, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x70, 0x72, 0x32, 0xcb, 0x01, 0x0a, 0x05, 0x4c, 0x4e, 0x55, 0x52, 0x4c, 0x12, 0x32,
-	0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x13, 0x2e, 0x78, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x78, 0x6c,
-	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x4e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1e, 0x2e, 0x78, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x78, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x12, 0x17, 0x2e, 0x78, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x78, 0x6c,
-	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x4e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x78, 0x62, 0x69, 0x74, 0x2d, 0x67, 0x67, 0x2f, 0x78, 0x6c, 0x6e, 0x2f, 0x78, 0x6c, 0x6e,
-	0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x02, 0x70, 0x72, 0x22, 0x23, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6b, 0x31, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6b, 0x31, 0x22, 0xd2, 0x01, 0x0a, 0x12, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
+	0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x20,
+	0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x6e, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x34,
+	0x0a, 0x0a, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x6b, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6b, 0x31, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x65, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x70, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x18, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x11, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0xd1, 0x03, 0x0a,
+	0x05, 0x4c, 0x4e, 0x55, 0x52, 0x4c, 0x12, 0x32, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x13,
+	0x2e, 0x78, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x78, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x4e, 0x55,
+	0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1e, 0x2e,
+	0x78, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x78, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a,
+	0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x17, 0x2e, 0x78, 0x6c, 0x6e,
+	0x72, 0x70, 0x63, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x78, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x4e, 0x55,
+	0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x12, 0x19, 0x2e, 0x78, 0x6c, 0x6e, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x78, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x03, 0x50, 0x61, 0x79, 0x12, 0x12, 0x2e, 0x78, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x78, 0x6c, 0x6e,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x51, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x50, 0x61, 0x79, 0x12, 0x20, 0x2e, 0x78, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x78, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79,
+	0x12, 0x19, 0x2e, 0x78, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x78, 0x6c,
+	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78,
+	0x62, 0x69, 0x74, 0x2d, 0x67, 0x67, 0x2f, 0x78, 0x6c, 0x6e, 0x2f, 0x78, 0x6c, 0x6e, 0x72, 0x70,
+	0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -408,26 +827,40 @@ func file_lnurl_proto_rawDescGZIP() []byte {
 	return file_lnurl_proto_rawDescData
 }
 
-var file_lnurl_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_lnurl_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_lnurl_proto_goTypes = []interface{}{
-	(*AuthRequest)(nil),             // 0: xlnrpc.AuthRequest
-	(*LNURLResponse)(nil),           // 1: xlnrpc.LNURLResponse
-	(*RequestWithdrawRequest)(nil),  // 2: xlnrpc.RequestWithdrawRequest
-	(*RequestWithdrawResponse)(nil), // 3: xlnrpc.RequestWithdrawResponse
-	(*WithdrawRequest)(nil),         // 4: xlnrpc.WithdrawRequest
+	(*AuthRequest)(nil),              // 0: xlnrpc.AuthRequest
+	(*LNURLResponse)(nil),            // 1: xlnrpc.LNURLResponse
+	(*RequestWithdrawRequest)(nil),   // 2: xlnrpc.RequestWithdrawRequest
+	(*RequestWithdrawResponse)(nil),  // 3: xlnrpc.RequestWithdrawResponse
+	(*WithdrawRequest)(nil),          // 4: xlnrpc.WithdrawRequest
+	(*RequestPayRequest)(nil),        // 5: xlnrpc.RequestPayRequest
+	(*RequestPayResponse)(nil),       // 6: xlnrpc.RequestPayResponse
+	(*PayRequest)(nil),               // 7: xlnrpc.PayRequest
+	(*PayResponse)(nil),              // 8: xlnrpc.PayResponse
+	(*RequestAddressPayRequest)(nil), // 9: xlnrpc.RequestAddressPayRequest
+	(*AddressPayRequest)(nil),        // 10: xlnrpc.AddressPayRequest
 }
 var file_lnurl_proto_depIdxs = []int32{
-	0, // 0: xlnrpc.LNURL.Auth:input_type -> xlnrpc.AuthRequest
-	2, // 1: xlnrpc.LNURL.RequestWithdraw:input_type -> xlnrpc.RequestWithdrawRequest
-	4, // 2: xlnrpc.LNURL.Withdraw:input_type -> xlnrpc.WithdrawRequest
-	1, // 3: xlnrpc.LNURL.Auth:output_type -> xlnrpc.LNURLResponse
-	3, // 4: xlnrpc.LNURL.RequestWithdraw:output_type -> xlnrpc.RequestWithdrawResponse
-	1, // 5: xlnrpc.LNURL.Withdraw:output_type -> xlnrpc.LNURLResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: xlnrpc.LNURL.Auth:input_type -> xlnrpc.AuthRequest
+	2,  // 1: xlnrpc.LNURL.RequestWithdraw:input_type -> xlnrpc.RequestWithdrawRequest
+	4,  // 2: xlnrpc.LNURL.Withdraw:input_type -> xlnrpc.WithdrawRequest
+	5,  // 3: xlnrpc.LNURL.RequestPay:input_type -> xlnrpc.RequestPayRequest
+	7,  // 4: xlnrpc.LNURL.Pay:input_type -> xlnrpc.PayRequest
+	9,  // 5: xlnrpc.LNURL.RequestAddressPay:input_type -> xlnrpc.RequestAddressPayRequest
+	10, // 6: xlnrpc.LNURL.AddressPay:input_type -> xlnrpc.AddressPayRequest
+	1,  // 7: xlnrpc.LNURL.Auth:output_type -> xlnrpc.LNURLResponse
+	3,  // 8: xlnrpc.LNURL.RequestWithdraw:output_type -> xlnrpc.RequestWithdrawResponse
+	1,  // 9: xlnrpc.LNURL.Withdraw:output_type -> xlnrpc.LNURLResponse
+	6,  // 10: xlnrpc.LNURL.RequestPay:output_type -> xlnrpc.RequestPayResponse
+	8,  // 11: xlnrpc.LNURL.Pay:output_type -> xlnrpc.PayResponse
+	6,  // 12: xlnrpc.LNURL.RequestAddressPay:output_type -> xlnrpc.RequestPayResponse
+	8,  // 13: xlnrpc.LNURL.AddressPay:output_type -> xlnrpc.PayResponse
+	7,  // [7:14] is the sub-list for method output_type
+	0,  // [0:7] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_lnurl_proto_init() }
@@ -496,6 +929,78 @@ func file_lnurl_proto_init() {
 				return nil
 			}
 		}
+		file_lnurl_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lnurl_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPayResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lnurl_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lnurl_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lnurl_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestAddressPayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lnurl_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressPayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -503,7 +1008,7 @@ func file_lnurl_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_lnurl_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,12 @@ type LNURLClient interface {
 	Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*LNURLResponse, error)
 	RequestWithdraw(ctx context.Context, in *RequestWithdrawRequest, opts ...grpc.CallOption) (*RequestWithdrawResponse, error)
 	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*LNURLResponse, error)
+	// LUD-06: payRequest
+	RequestPay(ctx context.Context, in *RequestPayRequest, opts ...grpc.CallOption) (*RequestPayResponse, error)
+	Pay(ctx context.Context, in *PayRequest, opts ...grpc.CallOption) (*PayResponse, error)
+	// LUD-16: lightning address
+	RequestAddressPay(ctx context.Context, in *RequestAddressPayRequest, opts ...grpc.CallOption) (*RequestPayResponse, error)
+	AddressPay(ctx context.Context, in *AddressPayRequest, opts ...grpc.CallOption) (*PayResponse, error)
 }
 
 type lNURLClient struct {
@@ -63,6 +69,42 @@ func (c *lNURLClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ..
 	return out, nil
 }
 
+func (c *lNURLClient) RequestPay(ctx context.Context, in *RequestPayRequest, opts ...grpc.CallOption) (*RequestPayResponse, error) {
+	out := new(RequestPayResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.LNURL/RequestPay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lNURLClient) Pay(ctx context.Context, in *PayRequest, opts ...grpc.CallOption) (*PayResponse, error) {
+	out := new(PayResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.LNURL/Pay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lNURLClient) RequestAddressPay(ctx context.Context, in *RequestAddressPayRequest, opts ...grpc.CallOption) (*RequestPayResponse, error) {
+	out := new(RequestPayResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.LNURL/RequestAddressPay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lNURLClient) AddressPay(ctx context.Context, in *AddressPayRequest, opts ...grpc.CallOption) (*PayResponse, error) {
+	out := new(PayResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.LNURL/AddressPay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LNURLServer is the server API for LNURL service.
 // All implementations must embed UnimplementedLNURLServer
 // for forward compatibility
@@ -71,6 +113,12 @@ type LNURLServer interface {
 	Auth(context.Context, *AuthRequest) (*LNURLResponse, error)
 	RequestWithdraw(context.Context, *RequestWithdrawRequest) (*RequestWithdrawResponse, error)
 	Withdraw(context.Context, *WithdrawRequest) (*LNURLResponse, error)
+	// LUD-06: payRequest
+	RequestPay(context.Context, *RequestPayRequest) (*RequestPayResponse, error)
+	Pay(context.Context, *PayRequest) (*PayResponse, error)
+	// LUD-16: lightning address
+	RequestAddressPay(context.Context, *RequestAddressPayRequest) (*RequestPayResponse, error)
+	AddressPay(context.Context, *AddressPayRequest) (*PayResponse, error)
 	mustEmbedUnimplementedLNURLServer()
 }
 
@@ -87,6 +135,18 @@ func (UnimplementedLNURLServer) RequestWithdraw(context.Context, *RequestWithdra
 func (UnimplementedLNURLServer) Withdraw(context.Context, *WithdrawRequest) (*LNURLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
+func (UnimplementedLNURLServer) RequestPay(context.Context, *RequestPayRequest) (*RequestPayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPay not implemented")
+}
+func (UnimplementedLNURLServer) Pay(context.Context, *PayRequest) (*PayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pay not implemented")
+}
+func (UnimplementedLNURLServer) RequestAddressPay(context.Context, *RequestAddressPayRequest) (*RequestPayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAddressPay not implemented")
+}
+func (UnimplementedLNURLServer) AddressPay(context.Context, *AddressPayRequest) (*PayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressPay not implemented")
+}
 func (UnimplementedLNURLServer) mustEmbedUnimplementedLNURLServer() {}
 
 // UnsafeLNURLServer may be embedded to opt out of forward compatibility for this service.
@@ -154,6 +214,78 @@ func _LNURL_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LNURL_RequestPay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LNURLServer).RequestPay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.LNURL/RequestPay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LNURLServer).RequestPay(ctx, req.(*RequestPayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LNURL_Pay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LNURLServer).Pay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.LNURL/Pay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LNURLServer).Pay(ctx, req.(*PayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LNURL_RequestAddressPay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAddressPayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LNURLServer).RequestAddressPay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.LNURL/RequestAddressPay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LNURLServer).RequestAddressPay(ctx, req.(*RequestAddressPayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LNURL_AddressPay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressPayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LNURLServer).AddressPay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.LNURL/AddressPay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LNURLServer).AddressPay(ctx, req.(*AddressPayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LNURL_ServiceDesc is the grpc.ServiceDesc for LNURL service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -173,6 +305,22 @@ var LNURL_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Withdraw",
 			Handler:    _LNURL_Withdraw_Handler,
 		},
+		{
+			MethodName: "RequestPay",
+			Handler:    _LNURL_RequestPay_Handler,
+		},
+		{
+			MethodName: "Pay",
+			Handler:    _LNURL_Pay_Handler,
+		},
+		{
+			MethodName: "RequestAddressPay",
+			Handler:    _LNURL_RequestAddressPay_Handler,
+		},
+		{
+			MethodName: "AddressPay",
+			Handler:    _LNURL_AddressPay_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lnurl.proto",
@@ -35,14 +35,28 @@ type XlnClient interface {
 	CreateInvoice(ctx context.Context, in *CreateInvoiceRequest, opts ...grpc.CallOption) (*CreateInvoiceResponse, error)
 	ListWalletInvoices(ctx context.Context, in *ListWalletInvoicesRequest, opts ...grpc.CallOption) (*ListWalletInvoicesResponse, error)
 	GetWalletInvoice(ctx context.Context, in *GetWalletInvoiceRequest, opts ...grpc.CallOption) (*GetWalletInvoiceResponse, error)
+	CreateHoldInvoice(ctx context.Context, in *CreateHoldInvoiceRequest, opts ...grpc.CallOption) (*CreateHoldInvoiceResponse, error)
+	SettleHoldInvoice(ctx context.Context, in *SettleHoldInvoiceRequest, opts ...grpc.CallOption) (*SettleHoldInvoiceResponse, error)
+	CancelHoldInvoice(ctx context.Context, in *CancelHoldInvoiceRequest, opts ...grpc.CallOption) (*CancelHoldInvoiceResponse, error)
 	PayInvoice(ctx context.Context, in *PayInvoiceRequest, opts ...grpc.CallOption) (*PayInvoiceResponse, error)
 	PayInvoiceSync(ctx context.Context, in *PayInvoiceRequest, opts ...grpc.CallOption) (*PayInvoiceSyncResponse, error)
+	SendKeysend(ctx context.Context, in *SendKeysendRequest, opts ...grpc.CallOption) (*SendKeysendResponse, error)
+	QuotePayment(ctx context.Context, in *QuotePaymentRequest, opts ...grpc.CallOption) (*QuotePaymentResponse, error)
+	//
+	// Pay many invoices from a wallet in one request. Nothing is paid unless the confirmed balance of the wallet
+	// covers the amounts of all of the invoices. Invoices of wallets on this node are paid before the request returns,
+	// and the amounts and routing fees of the others are reserved until they complete. The result of each payment is
+	// streamed once it completes.
+	BatchPayInvoices(ctx context.Context, in *BatchPayInvoicesRequest, opts ...grpc.CallOption) (Xln_BatchPayInvoicesClient, error)
 	ListWalletPendingInvoices(ctx context.Context, in *ListWalletPendingInvoicesRequest, opts ...grpc.CallOption) (*ListWalletPendingInvoicesResponse, error)
 	ListWalletPendingPayments(ctx context.Context, in *ListWalletPendingPaymentsRequest, opts ...grpc.CallOption) (*ListWalletPendingPaymentsResponse, error)
 	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+	BatchTransfer(ctx context.Context, in *BatchTransferRequest, opts ...grpc.CallOption) (*BatchTransferResponse, error)
+	TransferToUser(ctx context.Context, in *TransferToUserRequest, opts ...grpc.CallOption) (*TransferToUserResponse, error)
 	ListUserTransactions(ctx context.Context, in *ListUserTransactionsRequest, opts ...grpc.CallOption) (*ListUserTransactionsResponse, error)
 	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	SetPaymentDefaults(ctx context.Context, in *SetPaymentDefaultsRequest, opts ...grpc.CallOption) (*SetPaymentDefaultsResponse, error)
 	UserLinkWallet(ctx context.Context, in *UserLinkWalletRequest, opts ...grpc.CallOption) (*UserLinkWalletResponse, error)
 	LinkWallet(ctx context.Context, in *LinkWalletRequest, opts ...grpc.CallOption) (*LinkWalletResponse, error)
 	UserLogin(ctx context.Context, in *UserLoginRequest, opts ...grpc.CallOption) (*UserLoginResponse, error)
@@ -50,6 +64,44 @@ type XlnClient interface {
 	LoginStatus(ctx context.Context, in *LoginStatusRequest, opts ...grpc.CallOption) (*LoginStatusResponse, error)
 	CreateLNURLW(ctx context.Context, in *CreateLNURLWRequest, opts ...grpc.CallOption) (*CreateLNURLWResponse, error)
 	GetLNURLW(ctx context.Context, in *GetLNURLWRequest, opts ...grpc.CallOption) (*GetLNURLWResponse, error)
+	CreateLNURLP(ctx context.Context, in *CreateLNURLPRequest, opts ...grpc.CallOption) (*CreateLNURLPResponse, error)
+	GetLNURLP(ctx context.Context, in *GetLNURLPRequest, opts ...grpc.CallOption) (*GetLNURLPResponse, error)
+	//
+	// Register a webhook that is notified of wallet events. If wallet_id is empty,
+	// then the webhook is notified of the events of all the user's wallets.
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	//
+	// Schedule recurring payments from a wallet to another wallet on this node,
+	// a node by keysend, or an LNURL-pay link or lightning address. Payments that
+	// were due while XLN was offline are not made, except for the latest.
+	// Payments fail once the wallet of another user stops accepting transfers,
+	// or once the scoped API key or macaroon that created the schedule is
+	// revoked or expires.
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error)
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	CancelSchedule(ctx context.Context, in *CancelScheduleRequest, opts ...grpc.CallOption) (*CancelScheduleResponse, error)
+	//
+	// Create a named API key of the user, or of one of the user's wallets if
+	// wallet_id is set, that may only call the RPCs of its scopes: read, invoice,
+	// pay, transfer, and admin, which only keys of users may have. Keys of users
+	// apply to all of the user's wallets. Requires the admin scope.
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	//
+	// Replace the API key of the user, or of one of the user's wallets if
+	// wallet_id is set, with a new key. Only hashes of keys are stored, so the
+	// new key is only returned once, and the previous key stops working
+	// immediately. Requires the admin scope.
+	RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*RotateApiKeyResponse, error)
+	//
+	// Stream the events of a wallet as they happen: settled and cancelled invoices,
+	// resolved payments, and transfers. Each event carries the wallet's balance after
+	// the event. Over REST, events are sent as server-sent events when the request
+	// accepts text/event-stream.
+	SubscribeWalletEvents(ctx context.Context, in *SubscribeWalletEventsRequest, opts ...grpc.CallOption) (Xln_SubscribeWalletEventsClient, error)
 }
 
 type xlnClient struct {
@@ -159,6 +211,33 @@ func (c *xlnClient) GetWalletInvoice(ctx context.Context, in *GetWalletInvoiceRe
 	return out, nil
 }
 
+func (c *xlnClient) CreateHoldInvoice(ctx context.Context, in *CreateHoldInvoiceRequest, opts ...grpc.CallOption) (*CreateHoldInvoiceResponse, error) {
+	out := new(CreateHoldInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/CreateHoldInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) SettleHoldInvoice(ctx context.Context, in *SettleHoldInvoiceRequest, opts ...grpc.CallOption) (*SettleHoldInvoiceResponse, error) {
+	out := new(SettleHoldInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/SettleHoldInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) CancelHoldInvoice(ctx context.Context, in *CancelHoldInvoiceRequest, opts ...grpc.CallOption) (*CancelHoldInvoiceResponse, error) {
+	out := new(CancelHoldInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/CancelHoldInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xlnClient) PayInvoice(ctx context.Context, in *PayInvoiceRequest, opts ...grpc.CallOption) (*PayInvoiceResponse, error) {
 	out := new(PayInvoiceResponse)
 	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/PayInvoice", in, out, opts...)
@@ -177,6 +256,56 @@ func (c *xlnClient) PayInvoiceSync(ctx context.Context, in *PayInvoiceRequest, o
 	return out, nil
 }
 
+func (c *xlnClient) SendKeysend(ctx context.Context, in *SendKeysendRequest, opts ...grpc.CallOption) (*SendKeysendResponse, error) {
+	out := new(SendKeysendResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/SendKeysend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) QuotePayment(ctx context.Context, in *QuotePaymentRequest, opts ...grpc.CallOption) (*QuotePaymentResponse, error) {
+	out := new(QuotePaymentResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/QuotePayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) BatchPayInvoices(ctx context.Context, in *BatchPayInvoicesRequest, opts ...grpc.CallOption) (Xln_BatchPayInvoicesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Xln_ServiceDesc.Streams[0], "/xlnrpc.Xln/BatchPayInvoices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &xlnBatchPayInvoicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Xln_BatchPayInvoicesClient interface {
+	Recv() (*BatchPaymentResult, error)
+	grpc.ClientStream
+}
+
+type xlnBatchPayInvoicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *xlnBatchPayInvoicesClient) Recv() (*BatchPaymentResult, error) {
+	m := new(BatchPaymentResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *xlnClient) ListWalletPendingInvoices(ctx context.Context, in *ListWalletPendingInvoicesRequest, opts ...grpc.CallOption) (*ListWalletPendingInvoicesResponse, error) {
 	out := new(ListWalletPendingInvoicesResponse)
 	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/ListWalletPendingInvoices", in, out, opts...)
@@ -204,6 +333,24 @@ func (c *xlnClient) Transfer(ctx context.Context, in *TransferRequest, opts ...g
 	return out, nil
 }
 
+func (c *xlnClient) BatchTransfer(ctx context.Context, in *BatchTransferRequest, opts ...grpc.CallOption) (*BatchTransferResponse, error) {
+	out := new(BatchTransferResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/BatchTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) TransferToUser(ctx context.Context, in *TransferToUserRequest, opts ...grpc.CallOption) (*TransferToUserResponse, error) {
+	out := new(TransferToUserResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/TransferToUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xlnClient) ListUserTransactions(ctx context.Context, in *ListUserTransactionsRequest, opts ...grpc.CallOption) (*ListUserTransactionsResponse, error) {
 	out := new(ListUserTransactionsResponse)
 	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/ListUserTransactions", in, out, opts...)
@@ -231,6 +378,15 @@ func (c *xlnClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grp
 	return out, nil
 }
 
+func (c *xlnClient) SetPaymentDefaults(ctx context.Context, in *SetPaymentDefaultsRequest, opts ...grpc.CallOption) (*SetPaymentDefaultsResponse, error) {
+	out := new(SetPaymentDefaultsResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/SetPaymentDefaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xlnClient) UserLinkWallet(ctx context.Context, in *UserLinkWalletRequest, opts ...grpc.CallOption) (*UserLinkWalletResponse, error) {
 	out := new(UserLinkWalletResponse)
 	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/UserLinkWallet", in, out, opts...)
@@ -294,6 +450,146 @@ func (c *xlnClient) GetLNURLW(ctx context.Context, in *GetLNURLWRequest, opts ..
 	return out, nil
 }
 
+func (c *xlnClient) CreateLNURLP(ctx context.Context, in *CreateLNURLPRequest, opts ...grpc.CallOption) (*CreateLNURLPResponse, error) {
+	out := new(CreateLNURLPResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/CreateLNURLP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) GetLNURLP(ctx context.Context, in *GetLNURLPRequest, opts ...grpc.CallOption) (*GetLNURLPResponse, error) {
+	out := new(GetLNURLPResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/GetLNURLP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/CreateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error) {
+	out := new(CreateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/ListSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) CancelSchedule(ctx context.Context, in *CancelScheduleRequest, opts ...grpc.CallOption) (*CancelScheduleResponse, error) {
+	out := new(CancelScheduleResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/CancelSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/CreateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/ListApiKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/RevokeApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*RotateApiKeyResponse, error) {
+	out := new(RotateApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.Xln/RotateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnClient) SubscribeWalletEvents(ctx context.Context, in *SubscribeWalletEventsRequest, opts ...grpc.CallOption) (Xln_SubscribeWalletEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Xln_ServiceDesc.Streams[1], "/xlnrpc.Xln/SubscribeWalletEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &xlnSubscribeWalletEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Xln_SubscribeWalletEventsClient interface {
+	Recv() (*WalletEvent, error)
+	grpc.ClientStream
+}
+
+type xlnSubscribeWalletEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *xlnSubscribeWalletEventsClient) Recv() (*WalletEvent, error) {
+	m := new(WalletEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // XlnServer is the server API for Xln service.
 // All implementations must embed UnimplementedXlnServer
 // for forward compatibility
@@ -311,14 +607,28 @@ type XlnServer interface {
 	CreateInvoice(context.Context, *CreateInvoiceRequest) (*CreateInvoiceResponse, error)
 	ListWalletInvoices(context.Context, *ListWalletInvoicesRequest) (*ListWalletInvoicesResponse, error)
 	GetWalletInvoice(context.Context, *GetWalletInvoiceRequest) (*GetWalletInvoiceResponse, error)
+	CreateHoldInvoice(context.Context, *CreateHoldInvoiceRequest) (*CreateHoldInvoiceResponse, error)
+	SettleHoldInvoice(context.Context, *SettleHoldInvoiceRequest) (*SettleHoldInvoiceResponse, error)
+	CancelHoldInvoice(context.Context, *CancelHoldInvoiceRequest) (*CancelHoldInvoiceResponse, error)
 	PayInvoice(context.Context, *PayInvoiceRequest) (*PayInvoiceResponse, error)
 	PayInvoiceSync(context.Context, *PayInvoiceRequest) (*PayInvoiceSyncResponse, error)
+	SendKeysend(context.Context, *SendKeysendRequest) (*SendKeysendResponse, error)
+	QuotePayment(context.Context, *QuotePaymentRequest) (*QuotePaymentResponse, error)
+	//
+	// Pay many invoices from a wallet in one request. Nothing is paid unless the confirmed balance of the wallet
+	// covers the amounts of all of the invoices. Invoices of wallets on this node are paid before the request returns,
+	// and the amounts and routing fees of the others are reserved until they complete. The result of each payment is
+	// streamed once it completes.
+	BatchPayInvoices(*BatchPayInvoicesRequest, Xln_BatchPayInvoicesServer) error
 	ListWalletPendingInvoices(context.Context, *ListWalletPendingInvoicesRequest) (*ListWalletPendingInvoicesResponse, error)
 	ListWalletPendingPayments(context.Context, *ListWalletPendingPaymentsRequest) (*ListWalletPendingPaymentsResponse, error)
 	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+	BatchTransfer(context.Context, *BatchTransferRequest) (*BatchTransferResponse, error)
+	TransferToUser(context.Context, *TransferToUserRequest) (*TransferToUserResponse, error)
 	ListUserTransactions(context.Context, *ListUserTransactionsRequest) (*ListUserTransactionsResponse, error)
 	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	SetPaymentDefaults(context.Context, *SetPaymentDefaultsRequest) (*SetPaymentDefaultsResponse, error)
 	UserLinkWallet(context.Context, *UserLinkWalletRequest) (*UserLinkWalletResponse, error)
 	LinkWallet(context.Context, *LinkWalletRequest) (*LinkWalletResponse, error)
 	UserLogin(context.Context, *UserLoginRequest) (*UserLoginResponse, error)
@@ -326,6 +636,44 @@ type XlnServer interface {
 	LoginStatus(context.Context, *LoginStatusRequest) (*LoginStatusResponse, error)
 	CreateLNURLW(context.Context, *CreateLNURLWRequest) (*CreateLNURLWResponse, error)
 	GetLNURLW(context.Context, *GetLNURLWRequest) (*GetLNURLWResponse, error)
+	CreateLNURLP(context.Context, *CreateLNURLPRequest) (*CreateLNURLPResponse, error)
+	GetLNURLP(context.Context, *GetLNURLPRequest) (*GetLNURLPResponse, error)
+	//
+	// Register a webhook that is notified of wallet events. If wallet_id is empty,
+	// then the webhook is notified of the events of all the user's wallets.
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	//
+	// Schedule recurring payments from a wallet to another wallet on this node,
+	// a node by keysend, or an LNURL-pay link or lightning address. Payments that
+	// were due while XLN was offline are not made, except for the latest.
+	// Payments fail once the wallet of another user stops accepting transfers,
+	// or once the scoped API key or macaroon that created the schedule is
+	// revoked or expires.
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error)
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	CancelSchedule(context.Context, *CancelScheduleRequest) (*CancelScheduleResponse, error)
+	//
+	// Create a named API key of the user, or of one of the user's wallets if
+	// wallet_id is set, that may only call the RPCs of its scopes: read, invoice,
+	// pay, transfer, and admin, which only keys of users may have. Keys of users
+	// apply to all of the user's wallets. Requires the admin scope.
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	//
+	// Replace the API key of the user, or of one of the user's wallets if
+	// wallet_id is set, with a new key. Only hashes of keys are stored, so the
+	// new key is only returned once, and the previous key stops working
+	// immediately. Requires the admin scope.
+	RotateApiKey(context.Context, *RotateApiKeyRequest) (*RotateApiKeyResponse, error)
+	//
+	// Stream the events of a wallet as they happen: settled and cancelled invoices,
+	// resolved payments, and transfers. Each event carries the wallet's balance after
+	// the event. Over REST, events are sent as server-sent events when the request
+	// accepts text/event-stream.
+	SubscribeWalletEvents(*SubscribeWalletEventsRequest, Xln_SubscribeWalletEventsServer) error
 	mustEmbedUnimplementedXlnServer()
 }
 
@@ -366,12 +714,30 @@ func (UnimplementedXlnServer) ListWalletInvoices(context.Context, *ListWalletInv
 func (UnimplementedXlnServer) GetWalletInvoice(context.Context, *GetWalletInvoiceRequest) (*GetWalletInvoiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWalletInvoice not implemented")
 }
+func (UnimplementedXlnServer) CreateHoldInvoice(context.Context, *CreateHoldInvoiceRequest) (*CreateHoldInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHoldInvoice not implemented")
+}
+func (UnimplementedXlnServer) SettleHoldInvoice(context.Context, *SettleHoldInvoiceRequest) (*SettleHoldInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleHoldInvoice not implemented")
+}
+func (UnimplementedXlnServer) CancelHoldInvoice(context.Context, *CancelHoldInvoiceRequest) (*CancelHoldInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelHoldInvoice not implemented")
+}
 func (UnimplementedXlnServer) PayInvoice(context.Context, *PayInvoiceRequest) (*PayInvoiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayInvoice not implemented")
 }
 func (UnimplementedXlnServer) PayInvoiceSync(context.Context, *PayInvoiceRequest) (*PayInvoiceSyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayInvoiceSync not implemented")
 }
+func (UnimplementedXlnServer) SendKeysend(context.Context, *SendKeysendRequest) (*SendKeysendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendKeysend not implemented")
+}
+func (UnimplementedXlnServer) QuotePayment(context.Context, *QuotePaymentRequest) (*QuotePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotePayment not implemented")
+}
+func (UnimplementedXlnServer) BatchPayInvoices(*BatchPayInvoicesRequest, Xln_BatchPayInvoicesServer) error {
+	return status.Errorf(codes.Unimplemented, "method BatchPayInvoices not implemented")
+}
 func (UnimplementedXlnServer) ListWalletPendingInvoices(context.Context, *ListWalletPendingInvoicesRequest) (*ListWalletPendingInvoicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWalletPendingInvoices not implemented")
 }
@@ -381,6 +747,12 @@ func (UnimplementedXlnServer) ListWalletPendingPayments(context.Context, *ListWa
 func (UnimplementedXlnServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
 }
+func (UnimplementedXlnServer) BatchTransfer(context.Context, *BatchTransferRequest) (*BatchTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTransfer not implemented")
+}
+func (UnimplementedXlnServer) TransferToUser(context.Context, *TransferToUserRequest) (*TransferToUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferToUser not implemented")
+}
 func (UnimplementedXlnServer) ListUserTransactions(context.Context, *ListUserTransactionsRequest) (*ListUserTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUserTransactions not implemented")
 }
@@ -390,6 +762,9 @@ func (UnimplementedXlnServer) Validate(context.Context, *ValidateRequest) (*Vali
 func (UnimplementedXlnServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
+func (UnimplementedXlnServer) SetPaymentDefaults(context.Context, *SetPaymentDefaultsRequest) (*SetPaymentDefaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaymentDefaults not implemented")
+}
 func (UnimplementedXlnServer) UserLinkWallet(context.Context, *UserLinkWalletRequest) (*UserLinkWalletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserLinkWallet not implemented")
 }
@@ -411,6 +786,45 @@ func (UnimplementedXlnServer) CreateLNURLW(context.Context, *CreateLNURLWRequest
 func (UnimplementedXlnServer) GetLNURLW(context.Context, *GetLNURLWRequest) (*GetLNURLWResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLNURLW not implemented")
 }
+func (UnimplementedXlnServer) CreateLNURLP(context.Context, *CreateLNURLPRequest) (*CreateLNURLPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLNURLP not implemented")
+}
+func (UnimplementedXlnServer) GetLNURLP(context.Context, *GetLNURLPRequest) (*GetLNURLPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLNURLP not implemented")
+}
+func (UnimplementedXlnServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedXlnServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedXlnServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedXlnServer) CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedXlnServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedXlnServer) CancelSchedule(context.Context, *CancelScheduleRequest) (*CancelScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSchedule not implemented")
+}
+func (UnimplementedXlnServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedXlnServer) ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedXlnServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedXlnServer) RotateApiKey(context.Context, *RotateApiKeyRequest) (*RotateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateApiKey not implemented")
+}
+func (UnimplementedXlnServer) SubscribeWalletEvents(*SubscribeWalletEventsRequest, Xln_SubscribeWalletEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeWalletEvents not implemented")
+}
 func (UnimplementedXlnServer) mustEmbedUnimplementedXlnServer() {}
 
 // UnsafeXlnServer may be embedded to opt out of forward compatibility for this service.
@@ -622,6 +1036,60 @@ func _Xln_GetWalletInvoice_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xln_CreateHoldInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHoldInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).CreateHoldInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/CreateHoldInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).CreateHoldInvoice(ctx, req.(*CreateHoldInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_SettleHoldInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleHoldInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).SettleHoldInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/SettleHoldInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).SettleHoldInvoice(ctx, req.(*SettleHoldInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_CancelHoldInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelHoldInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).CancelHoldInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/CancelHoldInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).CancelHoldInvoice(ctx, req.(*CancelHoldInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xln_PayInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PayInvoiceRequest)
 	if err := dec(in); err != nil {
@@ -658,6 +1126,63 @@ func _Xln_PayInvoiceSync_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xln_SendKeysend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendKeysendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).SendKeysend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/SendKeysend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).SendKeysend(ctx, req.(*SendKeysendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_QuotePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).QuotePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/QuotePayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).QuotePayment(ctx, req.(*QuotePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_BatchPayInvoices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BatchPayInvoicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(XlnServer).BatchPayInvoices(m, &xlnBatchPayInvoicesServer{stream})
+}
+
+type Xln_BatchPayInvoicesServer interface {
+	Send(*BatchPaymentResult) error
+	grpc.ServerStream
+}
+
+type xlnBatchPayInvoicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *xlnBatchPayInvoicesServer) Send(m *BatchPaymentResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Xln_ListWalletPendingInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListWalletPendingInvoicesRequest)
 	if err := dec(in); err != nil {
@@ -712,6 +1237,42 @@ func _Xln_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xln_BatchTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).BatchTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/BatchTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).BatchTransfer(ctx, req.(*BatchTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_TransferToUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferToUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).TransferToUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/TransferToUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).TransferToUser(ctx, req.(*TransferToUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xln_ListUserTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUserTransactionsRequest)
 	if err := dec(in); err != nil {
@@ -766,6 +1327,24 @@ func _Xln_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xln_SetPaymentDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPaymentDefaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).SetPaymentDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/SetPaymentDefaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).SetPaymentDefaults(ctx, req.(*SetPaymentDefaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xln_UserLinkWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserLinkWalletRequest)
 	if err := dec(in); err != nil {
@@ -892,6 +1471,243 @@ func _Xln_GetLNURLW_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xln_CreateLNURLP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLNURLPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).CreateLNURLP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/CreateLNURLP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).CreateLNURLP(ctx, req.(*CreateLNURLPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_GetLNURLP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLNURLPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).GetLNURLP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/GetLNURLP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).GetLNURLP(ctx, req.(*GetLNURLPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/CreateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/ListWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/CreateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/ListSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_CancelSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).CancelSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/CancelSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).CancelSchedule(ctx, req.(*CancelScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/CreateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/ListApiKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/RevokeApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_RotateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnServer).RotateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.Xln/RotateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnServer).RotateApiKey(ctx, req.(*RotateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xln_SubscribeWalletEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeWalletEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(XlnServer).SubscribeWalletEvents(m, &xlnSubscribeWalletEventsServer{stream})
+}
+
+type Xln_SubscribeWalletEventsServer interface {
+	Send(*WalletEvent) error
+	grpc.ServerStream
+}
+
+type xlnSubscribeWalletEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *xlnSubscribeWalletEventsServer) Send(m *WalletEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Xln_ServiceDesc is the grpc.ServiceDesc for Xln service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -943,6 +1759,18 @@ var Xln_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWalletInvoice",
 			Handler:    _Xln_GetWalletInvoice_Handler,
 		},
+		{
+			MethodName: "CreateHoldInvoice",
+			Handler:    _Xln_CreateHoldInvoice_Handler,
+		},
+		{
+			MethodName: "SettleHoldInvoice",
+			Handler:    _Xln_SettleHoldInvoice_Handler,
+		},
+		{
+			MethodName: "CancelHoldInvoice",
+			Handler:    _Xln_CancelHoldInvoice_Handler,
+		},
 		{
 			MethodName: "PayInvoice",
 			Handler:    _Xln_PayInvoice_Handler,
@@ -951,6 +1779,14 @@ var Xln_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PayInvoiceSync",
 			Handler:    _Xln_PayInvoiceSync_Handler,
 		},
+		{
+			MethodName: "SendKeysend",
+			Handler:    _Xln_SendKeysend_Handler,
+		},
+		{
+			MethodName: "QuotePayment",
+			Handler:    _Xln_QuotePayment_Handler,
+		},
 		{
 			MethodName: "ListWalletPendingInvoices",
 			Handler:    _Xln_ListWalletPendingInvoices_Handler,
@@ -963,6 +1799,14 @@ var Xln_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Transfer",
 			Handler:    _Xln_Transfer_Handler,
 		},
+		{
+			MethodName: "BatchTransfer",
+			Handler:    _Xln_BatchTransfer_Handler,
+		},
+		{
+			MethodName: "TransferToUser",
+			Handler:    _Xln_TransferToUser_Handler,
+		},
 		{
 			MethodName: "ListUserTransactions",
 			Handler:    _Xln_ListUserTransactions_Handler,
@@ -975,6 +1819,10 @@ var Xln_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUser",
 			Handler:    _Xln_GetUser_Handler,
 		},
+		{
+			MethodName: "SetPaymentDefaults",
+			Handler:    _Xln_SetPaymentDefaults_Handler,
+		},
 		{
 			MethodName: "UserLinkWallet",
 			Handler:    _Xln_UserLinkWallet_Handler,
@@ -1003,7 +1851,66 @@ var Xln_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLNURLW",
 			Handler:    _Xln_GetLNURLW_Handler,
 		},
+		{
+			MethodName: "CreateLNURLP",
+			Handler:    _Xln_CreateLNURLP_Handler,
+		},
+		{
+			MethodName: "GetLNURLP",
+			Handler:    _Xln_GetLNURLP_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Xln_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Xln_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Xln_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _Xln_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _Xln_ListSchedules_Handler,
+		},
+		{
+			MethodName: "CancelSchedule",
+			Handler:    _Xln_CancelSchedule_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _Xln_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _Xln_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _Xln_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "RotateApiKey",
+			Handler:    _Xln_RotateApiKey_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BatchPayInvoices",
+			Handler:       _Xln_BatchPayInvoices_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeWalletEvents",
+			Handler:       _Xln_SubscribeWalletEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "xln.proto",
 }
@@ -33,6 +33,38 @@ type XlnAdminClient interface {
 	GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*GetInvoiceResponse, error)
 	ListPendingInvoices(ctx context.Context, in *ListPendingInvoicesRequest, opts ...grpc.CallOption) (*ListPendingInvoicesResponse, error)
 	ListPendingPayments(ctx context.Context, in *ListPendingPaymentsRequest, opts ...grpc.CallOption) (*ListPendingPaymentsResponse, error)
+	//
+	// Get the latest report of reconciling wallet balances and invoices against LND.
+	// Set refresh to reconcile before reporting.
+	GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*GetReconciliationReportResponse, error)
+	//
+	// Get the service fees that the wallets of a user are charged, which are those of the node unless the user has
+	// their own.
+	GetUserFeeSchedule(ctx context.Context, in *GetUserFeeScheduleRequest, opts ...grpc.CallOption) (*GetUserFeeScheduleResponse, error)
+	//
+	// Replace the service fees that the wallets of a user are charged. The user is charged the fees of the node if
+	// no fee schedule is set.
+	SetUserFeeSchedule(ctx context.Context, in *SetUserFeeScheduleRequest, opts ...grpc.CallOption) (*SetUserFeeScheduleResponse, error)
+	//
+	// Bake a macaroon of a user, or of one of the user's wallets, that is restricted by caveats. Macaroons are sent
+	// hex encoded in the x-xln-macaroon header along with x-username.
+	BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error)
+	//
+	// Create an admin account with a role, which determines the RPCs that the admin may call. The key of the admin is
+	// only returned now, and is sent in the x-admin-api-key header.
+	CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*CreateAdminResponse, error)
+	ListAdmins(ctx context.Context, in *ListAdminsRequest, opts ...grpc.CallOption) (*ListAdminsResponse, error)
+	//
+	// Change the role of an admin. The last admin with the admin role cannot be demoted while the bootstrap key is
+	// disabled.
+	UpdateAdminRole(ctx context.Context, in *UpdateAdminRoleRequest, opts ...grpc.CallOption) (*UpdateAdminRoleResponse, error)
+	//
+	// Replace the key of an admin. The old key can no longer be used.
+	RotateAdminKey(ctx context.Context, in *RotateAdminKeyRequest, opts ...grpc.CallOption) (*RotateAdminKeyResponse, error)
+	//
+	// Delete an admin account. The last admin with the admin role cannot be deleted while the bootstrap key is
+	// disabled.
+	DeleteAdmin(ctx context.Context, in *DeleteAdminRequest, opts ...grpc.CallOption) (*DeleteAdminResponse, error)
 }
 
 type xlnAdminClient struct {
@@ -124,6 +156,87 @@ func (c *xlnAdminClient) ListPendingPayments(ctx context.Context, in *ListPendin
 	return out, nil
 }
 
+func (c *xlnAdminClient) GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*GetReconciliationReportResponse, error) {
+	out := new(GetReconciliationReportResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.XlnAdmin/GetReconciliationReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnAdminClient) GetUserFeeSchedule(ctx context.Context, in *GetUserFeeScheduleRequest, opts ...grpc.CallOption) (*GetUserFeeScheduleResponse, error) {
+	out := new(GetUserFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.XlnAdmin/GetUserFeeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnAdminClient) SetUserFeeSchedule(ctx context.Context, in *SetUserFeeScheduleRequest, opts ...grpc.CallOption) (*SetUserFeeScheduleResponse, error) {
+	out := new(SetUserFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.XlnAdmin/SetUserFeeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnAdminClient) BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error) {
+	out := new(BakeMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.XlnAdmin/BakeMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnAdminClient) CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*CreateAdminResponse, error) {
+	out := new(CreateAdminResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.XlnAdmin/CreateAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnAdminClient) ListAdmins(ctx context.Context, in *ListAdminsRequest, opts ...grpc.CallOption) (*ListAdminsResponse, error) {
+	out := new(ListAdminsResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.XlnAdmin/ListAdmins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnAdminClient) UpdateAdminRole(ctx context.Context, in *UpdateAdminRoleRequest, opts ...grpc.CallOption) (*UpdateAdminRoleResponse, error) {
+	out := new(UpdateAdminRoleResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.XlnAdmin/UpdateAdminRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnAdminClient) RotateAdminKey(ctx context.Context, in *RotateAdminKeyRequest, opts ...grpc.CallOption) (*RotateAdminKeyResponse, error) {
+	out := new(RotateAdminKeyResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.XlnAdmin/RotateAdminKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xlnAdminClient) DeleteAdmin(ctx context.Context, in *DeleteAdminRequest, opts ...grpc.CallOption) (*DeleteAdminResponse, error) {
+	out := new(DeleteAdminResponse)
+	err := c.cc.Invoke(ctx, "/xlnrpc.XlnAdmin/DeleteAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // XlnAdminServer is the server API for XlnAdmin service.
 // All implementations must embed UnimplementedXlnAdminServer
 // for forward compatibility
@@ -139,6 +252,38 @@ type XlnAdminServer interface {
 	GetInvoice(context.Context, *GetInvoiceRequest) (*GetInvoiceResponse, error)
 	ListPendingInvoices(context.Context, *ListPendingInvoicesRequest) (*ListPendingInvoicesResponse, error)
 	ListPendingPayments(context.Context, *ListPendingPaymentsRequest) (*ListPendingPaymentsResponse, error)
+	//
+	// Get the latest report of reconciling wallet balances and invoices against LND.
+	// Set refresh to reconcile before reporting.
+	GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*GetReconciliationReportResponse, error)
+	//
+	// Get the service fees that the wallets of a user are charged, which are those of the node unless the user has
+	// their own.
+	GetUserFeeSchedule(context.Context, *GetUserFeeScheduleRequest) (*GetUserFeeScheduleResponse, error)
+	//
+	// Replace the service fees that the wallets of a user are charged. The user is charged the fees of the node if
+	// no fee schedule is set.
+	SetUserFeeSchedule(context.Context, *SetUserFeeScheduleRequest) (*SetUserFeeScheduleResponse, error)
+	//
+	// Bake a macaroon of a user, or of one of the user's wallets, that is restricted by caveats. Macaroons are sent
+	// hex encoded in the x-xln-macaroon header along with x-username.
+	BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error)
+	//
+	// Create an admin account with a role, which determines the RPCs that the admin may call. The key of the admin is
+	// only returned now, and is sent in the x-admin-api-key header.
+	CreateAdmin(context.Context, *CreateAdminRequest) (*CreateAdminResponse, error)
+	ListAdmins(context.Context, *ListAdminsRequest) (*ListAdminsResponse, error)
+	//
+	// Change the role of an admin. The last admin with the admin role cannot be demoted while the bootstrap key is
+	// disabled.
+	UpdateAdminRole(context.Context, *UpdateAdminRoleRequest) (*UpdateAdminRoleResponse, error)
+	//
+	// Replace the key of an admin. The old key can no longer be used.
+	RotateAdminKey(context.Context, *RotateAdminKeyRequest) (*RotateAdminKeyResponse, error)
+	//
+	// Delete an admin account. The last admin with the admin role cannot be deleted while the bootstrap key is
+	// disabled.
+	DeleteAdmin(context.Context, *DeleteAdminRequest) (*DeleteAdminResponse, error)
 	mustEmbedUnimplementedXlnAdminServer()
 }
 
@@ -173,6 +318,33 @@ func (UnimplementedXlnAdminServer) ListPendingInvoices(context.Context, *ListPen
 func (UnimplementedXlnAdminServer) ListPendingPayments(context.Context, *ListPendingPaymentsRequest) (*ListPendingPaymentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPendingPayments not implemented")
 }
+func (UnimplementedXlnAdminServer) GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*GetReconciliationReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliationReport not implemented")
+}
+func (UnimplementedXlnAdminServer) GetUserFeeSchedule(context.Context, *GetUserFeeScheduleRequest) (*GetUserFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFeeSchedule not implemented")
+}
+func (UnimplementedXlnAdminServer) SetUserFeeSchedule(context.Context, *SetUserFeeScheduleRequest) (*SetUserFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserFeeSchedule not implemented")
+}
+func (UnimplementedXlnAdminServer) BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BakeMacaroon not implemented")
+}
+func (UnimplementedXlnAdminServer) CreateAdmin(context.Context, *CreateAdminRequest) (*CreateAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAdmin not implemented")
+}
+func (UnimplementedXlnAdminServer) ListAdmins(context.Context, *ListAdminsRequest) (*ListAdminsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAdmins not implemented")
+}
+func (UnimplementedXlnAdminServer) UpdateAdminRole(context.Context, *UpdateAdminRoleRequest) (*UpdateAdminRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAdminRole not implemented")
+}
+func (UnimplementedXlnAdminServer) RotateAdminKey(context.Context, *RotateAdminKeyRequest) (*RotateAdminKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateAdminKey not implemented")
+}
+func (UnimplementedXlnAdminServer) DeleteAdmin(context.Context, *DeleteAdminRequest) (*DeleteAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAdmin not implemented")
+}
 func (UnimplementedXlnAdminServer) mustEmbedUnimplementedXlnAdminServer() {}
 
 // UnsafeXlnAdminServer may be embedded to opt out of forward compatibility for this service.
@@ -348,6 +520,168 @@ func _XlnAdmin_ListPendingPayments_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _XlnAdmin_GetReconciliationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnAdminServer).GetReconciliationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.XlnAdmin/GetReconciliationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnAdminServer).GetReconciliationReport(ctx, req.(*GetReconciliationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XlnAdmin_GetUserFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFeeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnAdminServer).GetUserFeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.XlnAdmin/GetUserFeeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnAdminServer).GetUserFeeSchedule(ctx, req.(*GetUserFeeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XlnAdmin_SetUserFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserFeeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnAdminServer).SetUserFeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.XlnAdmin/SetUserFeeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnAdminServer).SetUserFeeSchedule(ctx, req.(*SetUserFeeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XlnAdmin_BakeMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BakeMacaroonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnAdminServer).BakeMacaroon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.XlnAdmin/BakeMacaroon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnAdminServer).BakeMacaroon(ctx, req.(*BakeMacaroonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XlnAdmin_CreateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnAdminServer).CreateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.XlnAdmin/CreateAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnAdminServer).CreateAdmin(ctx, req.(*CreateAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XlnAdmin_ListAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdminsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnAdminServer).ListAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.XlnAdmin/ListAdmins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnAdminServer).ListAdmins(ctx, req.(*ListAdminsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XlnAdmin_UpdateAdminRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAdminRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnAdminServer).UpdateAdminRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.XlnAdmin/UpdateAdminRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnAdminServer).UpdateAdminRole(ctx, req.(*UpdateAdminRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XlnAdmin_RotateAdminKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAdminKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnAdminServer).RotateAdminKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.XlnAdmin/RotateAdminKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnAdminServer).RotateAdminKey(ctx, req.(*RotateAdminKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XlnAdmin_DeleteAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XlnAdminServer).DeleteAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xlnrpc.XlnAdmin/DeleteAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XlnAdminServer).DeleteAdmin(ctx, req.(*DeleteAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // XlnAdmin_ServiceDesc is the grpc.ServiceDesc for XlnAdmin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -391,6 +725,42 @@ var XlnAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPendingPayments",
 			Handler:    _XlnAdmin_ListPendingPayments_Handler,
 		},
+		{
+			MethodName: "GetReconciliationReport",
+			Handler:    _XlnAdmin_GetReconciliationReport_Handler,
+		},
+		{
+			MethodName: "GetUserFeeSchedule",
+			Handler:    _XlnAdmin_GetUserFeeSchedule_Handler,
+		},
+		{
+			MethodName: "SetUserFeeSchedule",
+			Handler:    _XlnAdmin_SetUserFeeSchedule_Handler,
+		},
+		{
+			MethodName: "BakeMacaroon",
+			Handler:    _XlnAdmin_BakeMacaroon_Handler,
+		},
+		{
+			MethodName: "CreateAdmin",
+			Handler:    _XlnAdmin_CreateAdmin_Handler,
+		},
+		{
+			MethodName: "ListAdmins",
+			Handler:    _XlnAdmin_ListAdmins_Handler,
+		},
+		{
+			MethodName: "UpdateAdminRole",
+			Handler:    _XlnAdmin_UpdateAdminRole_Handler,
+		},
+		{
+			MethodName: "RotateAdminKey",
+			Handler:    _XlnAdmin_RotateAdminKey_Handler,
+		},
+		{
+			MethodName: "DeleteAdmin",
+			Handler:    _XlnAdmin_DeleteAdmin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xlnadmin.proto",
@@ -2,6 +2,7 @@ package xln
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -9,11 +10,14 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/xbit-gg/xln/auth"
 	"github.com/xbit-gg/xln/models"
+	"github.com/xbit-gg/xln/resources/events"
 	"github.com/xbit-gg/xln/resources/invoice"
+	"github.com/xbit-gg/xln/resources/wallet"
 	"github.com/xbit-gg/xln/util"
 	"github.com/xbit-gg/xln/xlnrpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -42,8 +46,10 @@ func (x xlnServer) GetInfo(ctx context.Context, request *xlnrpc.GetInfoRequest)
 	} else {
 		return &xlnrpc.GetInfoResponse{Version: x.xln.Version, Identity: &xlnrpc.GetInfoResponse_IdentityType{
 			Admin:  identity.Admin,
+			Role:   identity.Role,
 			User:   identity.User,
 			Wallet: identity.Wallet,
+			Scopes: identity.Scopes,
 		}}, nil
 	}
 
@@ -51,12 +57,9 @@ func (x xlnServer) GetInfo(ctx context.Context, request *xlnrpc.GetInfoRequest)
 
 func (x xlnServer) CreateWallet(ctx context.Context, request *xlnrpc.CreateWalletRequest) (*xlnrpc.CreateWalletResponse, error) {
 	log.WithField("req", request).Debugf("%s called", "Xln.CreateWallet")
-	username, err := x.xln.AuthService.ValidateUserCredentials(ctx, "Xln.CreateWallet")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 
-	err = util.ValidateWalletID(request.WalletId)
+	err := util.ValidateWalletID(request.WalletId)
 	if err != nil {
 		st := status.New(codes.InvalidArgument, fmt.Sprintf("Invalid wallet id. Reason: %v", err))
 		return nil, st.Err()
@@ -93,12 +96,9 @@ func (x xlnServer) CreateWallet(ctx context.Context, request *xlnrpc.CreateWalle
 
 func (x xlnServer) DeleteWallet(ctx context.Context, request *xlnrpc.DeleteWalletRequest) (*xlnrpc.DeleteWalletResponse, error) {
 	log.WithField("req", request).Debug("Xln.DeleteWallet called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.DeleteWallet")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 
-	err = x.xln.Wallets.DeleteWallet(username, request.WalletId, strings.HasPrefix(x.xln.Config.DatabaseConnectionString, "postgres"))
+	err := x.xln.Wallets.DeleteWallet(username, request.WalletId, strings.HasPrefix(x.xln.Config.DatabaseConnectionString, "postgres"))
 	if err == nil {
 		log.WithFields(log.Fields{
 			"wallet": request.WalletId,
@@ -115,10 +115,7 @@ func (x xlnServer) DeleteWallet(ctx context.Context, request *xlnrpc.DeleteWalle
 
 func (x xlnServer) UpdateWalletOptions(ctx context.Context, request *xlnrpc.UpdateWalletOptionsRequest) (*xlnrpc.UpdateWalletOptionsResponse, error) {
 	log.WithField("req", request).Debug("Xln.UpdateWalletOptions called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.UpdateWalletOptions")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 
 	walletOptions := models.WalletOptions{}
 	if request.Lock && request.Unlock {
@@ -135,6 +132,15 @@ func (x xlnServer) UpdateWalletOptions(ctx context.Context, request *xlnrpc.Upda
 		locked := false
 		walletOptions.Locked = &locked
 	}
+	if request.AcceptTransfers && request.RejectTransfers {
+		st := status.New(codes.InvalidArgument, "Invalid wallet options. "+
+			"Reason: cannot set both `accept_transfers` and `reject_transfers` to `true`")
+		log.Warn("Invalid wallet options")
+		return nil, st.Err()
+	}
+	if request.AcceptTransfers || request.RejectTransfers {
+		walletOptions.AcceptsTransfers = &request.AcceptTransfers
+	}
 	if request.WalletName != "" {
 		err := util.ValidateWalletName(request.WalletName)
 		if err != nil {
@@ -143,7 +149,26 @@ func (x xlnServer) UpdateWalletOptions(ctx context.Context, request *xlnrpc.Upda
 		}
 		walletOptions.Name = &request.WalletName
 	}
-	err = x.xln.Wallets.UpdateWalletOptions(username, request.WalletId, &walletOptions)
+	if request.AddressAlias != "" && request.RemoveAddressAlias {
+		st := status.New(codes.InvalidArgument, "Invalid wallet options. "+
+			"Reason: cannot set both `address_alias` and `remove_address_alias`")
+		log.Warn("Invalid wallet options")
+		return nil, st.Err()
+	}
+	if request.AddressAlias != "" {
+		err := util.ValidateWalletID(request.AddressAlias)
+		if err != nil {
+			st := status.New(codes.InvalidArgument, fmt.Sprintf("Invalid address alias. Reason: %v", err))
+			return nil, st.Err()
+		}
+		alias := strings.ToLower(request.AddressAlias)
+		walletOptions.AddressAlias = &alias
+	}
+	if request.RemoveAddressAlias {
+		alias := ""
+		walletOptions.AddressAlias = &alias
+	}
+	err := x.xln.Wallets.UpdateWalletOptions(username, request.WalletId, &walletOptions)
 	if err == nil {
 		log.WithFields(log.Fields{
 			"wallet": request.WalletId,
@@ -160,10 +185,7 @@ func (x xlnServer) UpdateWalletOptions(ctx context.Context, request *xlnrpc.Upda
 
 func (x xlnServer) ListWallets(ctx context.Context, request *xlnrpc.ListWalletsRequest) (*xlnrpc.ListWalletsResponse, error) {
 	log.WithField("req", request).Debug("Xln.ListWallets called")
-	username, err := x.xln.AuthService.ValidateUserCredentials(ctx, "Xln.ListWallets")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 
 	wallets, err := x.xln.Wallets.ListWallets(username)
 	if err != nil {
@@ -191,10 +213,7 @@ func (x xlnServer) ListWallets(ctx context.Context, request *xlnrpc.ListWalletsR
 
 func (x xlnServer) GetWallet(ctx context.Context, request *xlnrpc.GetWalletRequest) (*xlnrpc.GetWalletResponse, error) {
 	log.WithField("req", request).Debug("Xln.GetWallet called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.GetWallet")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 
 	wallet, err := x.xln.Wallets.GetWallet(username, request.WalletId)
 	if err == models.ErrWalletNotFound {
@@ -212,12 +231,21 @@ func (x xlnServer) GetWallet(ctx context.Context, request *xlnrpc.GetWalletReque
 	}
 
 	res := xlnrpc.GetWalletResponse{
-		ApiKey:       wallet.ApiKey,
-		WalletId:     wallet.ID,
-		WalletName:   *wallet.Name,
-		Balance:      wallet.Balance,
-		CreationTime: timestamppb.New(wallet.CreatedAt),
-		Locked:       wallet.Locked,
+		WalletId:         wallet.ID,
+		WalletName:       *wallet.Name,
+		Balance:          wallet.Balance,
+		CreationTime:     timestamppb.New(wallet.CreatedAt),
+		Locked:           wallet.Locked,
+		AcceptsTransfers: wallet.AcceptsTransfers,
+	}
+	if x.xln.AuthService.HasFullAccess(ctx) {
+		res.WebhookSecret = wallet.WebhookSecret
+	}
+	if wallet.AddressAlias != nil {
+		res.AddressAlias = *wallet.AddressAlias
+	}
+	if wallet.RoutingTag != nil {
+		res.RoutingTag = *wallet.RoutingTag
 	}
 	if wallet.LinkKey != nil {
 		res.LinkKey = *wallet.LinkKey
@@ -234,10 +262,7 @@ func (x xlnServer) GetWallet(ctx context.Context, request *xlnrpc.GetWalletReque
 
 func (x xlnServer) ListWalletPendingInvoices(ctx context.Context, request *xlnrpc.ListWalletPendingInvoicesRequest) (*xlnrpc.ListWalletPendingInvoicesResponse, error) {
 	log.WithField("req", request).Debug("Xln.ListWalletPendingInvoices called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.ListWalletPendingInvoices")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 
 	pendingInvoices, err := x.xln.PendingInvoices.ListWalletPendingInvoices(username, request.WalletId)
 	if err != nil {
@@ -251,6 +276,8 @@ func (x xlnServer) ListWalletPendingInvoices(ctx context.Context, request *xlnrp
 				Amount:      invoice.Amount,
 				CreatedAt:   timestamppb.New(invoice.CreatedAt),
 				PaymentHash: invoice.PaymentHash,
+				Hold:        invoice.Hold,
+				Accepted:    invoice.Accepted,
 			})
 			res.TotalAmount += invoice.Amount
 		}
@@ -260,10 +287,7 @@ func (x xlnServer) ListWalletPendingInvoices(ctx context.Context, request *xlnrp
 
 func (x xlnServer) ListWalletPendingPayments(ctx context.Context, request *xlnrpc.ListWalletPendingPaymentsRequest) (*xlnrpc.ListWalletPendingPaymentsResponse, error) {
 	log.WithField("req", request).Debug("Xln.ListWalletPendingPayments called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.ListWalletPendingPayments")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 	pendingPayments, err := x.xln.PendingPayments.ListWalletPendingPayments(username, request.WalletId)
 	if err != nil {
 		st := status.New(codes.Internal, fmt.Sprintf("Failed to list pending invoices. Reason: %v", err))
@@ -285,10 +309,7 @@ func (x xlnServer) ListWalletPendingPayments(ctx context.Context, request *xlnrp
 
 func (x xlnServer) ListWalletTransactions(ctx context.Context, request *xlnrpc.ListWalletTransactionsRequest) (*xlnrpc.ListWalletTransactionsResponse, error) {
 	log.WithField("req", request).Debug("Xln.ListWalletTransactions called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.ListWalletTransactions")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 
 	var startTime, endTime time.Time
 	if request.FromTime != nil {
@@ -315,10 +336,7 @@ func (x xlnServer) ListWalletTransactions(ctx context.Context, request *xlnrpc.L
 
 func (x xlnServer) GetWalletTransaction(ctx context.Context, request *xlnrpc.GetWalletTransactionRequest) (*xlnrpc.GetWalletTransactionResponse, error) {
 	log.WithField("req", request).Debug("Xln.GetWalletTransaction called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.GetWalletTransaction")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 
 	transaction, err := x.xln.Wallets.GetTransaction(username, request.WalletId, request.TxId)
 	if err != nil {
@@ -327,54 +345,141 @@ func (x xlnServer) GetWalletTransaction(ctx context.Context, request *xlnrpc.Get
 		return nil, st.Err()
 	}
 
-	return convertFullTransaction(transaction), nil
+	return convertFullTransaction(transaction, username, request.WalletId), nil
 }
 
 func (x xlnServer) CreateInvoice(ctx context.Context, request *xlnrpc.CreateInvoiceRequest) (*xlnrpc.CreateInvoiceResponse, error) {
 	log.WithField("req", request).Debug("Xln.CreateInvoice called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.CreateInvoice")
+	username := auth.IdentityFromContext(ctx).User
+
+	res := &xlnrpc.CreateInvoiceResponse{}
+	err := x.idempotent(username, request.WalletId, request.IdempotencyKey, "Xln.CreateInvoice", request, res, func() error {
+		inv, err := x.xln.Invoices.CreateInvoice(username, request.WalletId, request.Memo, nil, request.Value, request.Expiry)
+		if err != nil {
+			log.WithError(err).Warn("CreateInvoice request failed")
+			st := status.New(codes.InvalidArgument, fmt.Sprintf("Failed to create invoice. Reason: %v", err))
+			return st.Err()
+		}
+		res.PaymentHash = inv.PaymentHash
+		res.PaymentRequest = inv.PaymentRequest
+		return nil
+	})
 	if err != nil {
-		return nil, handleAuthErr(err)
+		return nil, err
 	}
+	return res, nil
+}
+
+func (x xlnServer) CreateHoldInvoice(ctx context.Context, request *xlnrpc.CreateHoldInvoiceRequest) (*xlnrpc.CreateHoldInvoiceResponse, error) {
+	log.WithField("req", request).Debug("Xln.CreateHoldInvoice called")
+	username := auth.IdentityFromContext(ctx).User
 
-	inv, err := x.xln.Invoices.CreateInvoice(username, request.WalletId, request.Memo, request.Value, request.Expiry)
+	res := &xlnrpc.CreateHoldInvoiceResponse{}
+	err := x.idempotent(username, request.WalletId, request.IdempotencyKey, "Xln.CreateHoldInvoice", request, res, func() error {
+		inv, err := x.xln.Invoices.CreateHoldInvoice(username, request.WalletId, request.Memo, request.Value,
+			request.Expiry, request.CltvExpiry)
+		if err != nil {
+			log.WithError(err).Warn("CreateHoldInvoice request failed")
+			st := status.New(codes.InvalidArgument, fmt.Sprintf("Failed to create hold invoice. Reason: %v", err))
+			return st.Err()
+		}
+		res.PaymentHash = inv.PaymentHash
+		res.PaymentRequest = inv.PaymentRequest
+		return nil
+	})
 	if err != nil {
-		log.WithError(err).Warn("CreateInvoice request failed")
-		st := status.New(codes.InvalidArgument, fmt.Sprintf("Failed to create invoice. Reason: %v", err))
-		return nil, st.Err()
+		return nil, err
 	}
-	return &xlnrpc.CreateInvoiceResponse{
-		PaymentHash:    inv.PaymentHash,
-		PaymentRequest: inv.PaymentRequest,
-	}, nil
+	return res, nil
+}
+
+func (x xlnServer) SettleHoldInvoice(ctx context.Context, request *xlnrpc.SettleHoldInvoiceRequest) (*xlnrpc.SettleHoldInvoiceResponse, error) {
+	log.WithField("req", request).Debug("Xln.SettleHoldInvoice called")
+	username := auth.IdentityFromContext(ctx).User
+
+	err := x.xln.Invoices.SettleHoldInvoice(username, request.WalletId, request.PaymentHash)
+	if err != nil {
+		return nil, holdInvoiceErr("Failed to settle hold invoice", err)
+	}
+	return &xlnrpc.SettleHoldInvoiceResponse{}, nil
+}
+
+func (x xlnServer) CancelHoldInvoice(ctx context.Context, request *xlnrpc.CancelHoldInvoiceRequest) (*xlnrpc.CancelHoldInvoiceResponse, error) {
+	log.WithField("req", request).Debug("Xln.CancelHoldInvoice called")
+	username := auth.IdentityFromContext(ctx).User
+
+	err := x.xln.Invoices.CancelHoldInvoice(username, request.WalletId, request.PaymentHash)
+	if err != nil {
+		return nil, holdInvoiceErr("Failed to cancel hold invoice", err)
+	}
+	return &xlnrpc.CancelHoldInvoiceResponse{}, nil
+}
+
+// holdInvoiceErr converts an error from settling or cancelling a hold invoice into a status error
+func holdInvoiceErr(msg string, err error) error {
+	if err == models.ErrPendingInvoiceNotFound {
+		return status.New(codes.NotFound, fmt.Sprintf("%s. Reason: %v", msg, err)).Err()
+	} else if err == models.ErrNotHoldInvoice || err == models.ErrHoldInvoiceNotAccepted {
+		return status.New(codes.FailedPrecondition, fmt.Sprintf("%s. Reason: %v", msg, err)).Err()
+	}
+	log.WithError(err).Warn(msg)
+	return status.New(codes.Internal, fmt.Sprintf("%s. Reason: %v", msg, err)).Err()
 }
 
 func (x xlnServer) ListWalletInvoices(ctx context.Context, request *xlnrpc.ListWalletInvoicesRequest) (*xlnrpc.ListWalletInvoicesResponse, error) {
 	log.WithField("req", request).Debug("Xln.ListWalletInvoices called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.ListWalletInvoices")
-	if err != nil {
-		return nil, handleAuthErr(err)
+	username := auth.IdentityFromContext(ctx).User
+
+	switch request.Status {
+	case "", models.InvoiceStatusPending, models.InvoiceStatusSettled, models.InvoiceStatusExpired,
+		models.InvoiceStatusCancelled:
+	default:
+		return nil, status.New(codes.InvalidArgument, fmt.Sprintf("invalid invoice status: %s", request.Status)).Err()
+	}
+	switch request.Direction {
+	case "", models.InvoiceDirectionIncoming, models.InvoiceDirectionOutgoing:
+	default:
+		return nil, status.New(codes.InvalidArgument,
+			fmt.Sprintf("invalid invoice direction: %s", request.Direction)).Err()
+	}
+	filter := &models.InvoiceFilter{
+		Status:    request.Status,
+		Direction: request.Direction,
+		MinAmount: request.MinAmount,
+		MaxAmount: request.MaxAmount,
+		Memo:      request.Memo,
+	}
+	if request.FromTime != nil {
+		filter.StartTime = request.FromTime.AsTime().UTC()
+	}
+	if request.ToTime != nil {
+		filter.EndTime = request.ToTime.AsTime().UTC()
+	} else {
+		filter.EndTime = time.Now().UTC()
+	}
+	if filter.StartTime.Unix() >= filter.EndTime.Unix() {
+		return nil, status.New(codes.InvalidArgument, "invalid time range").Err()
+	} else if filter.MaxAmount > 0 && filter.MinAmount > filter.MaxAmount {
+		return nil, status.New(codes.InvalidArgument, "invalid amount range").Err()
 	}
-	invoices, err := x.xln.Invoices.ListWalletInvoices(username, request.WalletId)
+	invoices, nextOffset, total, err := x.xln.Invoices.ListWalletInvoices(username, request.WalletId, filter,
+		uint(request.Offset), uint(request.Limit), request.Descending)
 	if err != nil {
-		st := status.New(codes.Internal, fmt.Sprintf("Failed to list  invoices. Reason: %v", err))
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to list invoices. Reason: %v", err))
 		log.WithError(err).Warn("ListWalletInvoices request failed")
 		return nil, st.Err()
-	} else {
-		var res xlnrpc.ListWalletInvoicesResponse
-		for _, invoice := range invoices {
-			res.Invoices = append(res.Invoices, invoice.PaymentHash)
-		}
-		return &res, nil
 	}
+	res := xlnrpc.ListWalletInvoicesResponse{NextOffset: int32(nextOffset), TotalRecords: total}
+	now := time.Now().UTC()
+	for _, invoice := range invoices {
+		res.Invoices = append(res.Invoices, convertInvoice(invoice, username, request.WalletId, now))
+	}
+	return &res, nil
 }
 
 func (x xlnServer) GetWalletInvoice(ctx context.Context, request *xlnrpc.GetWalletInvoiceRequest) (*xlnrpc.GetWalletInvoiceResponse, error) {
 	log.WithField("req", request).Debug("Xln.GetWalletInvoice called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.GetWalletInvoice")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 	invoice, err := x.xln.Invoices.GetWalletInvoice(username, request.WalletId, request.PaymentHash)
 	if err != nil {
 		st := status.New(codes.Internal, fmt.Sprintf("Failed to get invoice. Reason: %v", err))
@@ -389,10 +494,14 @@ func (x xlnServer) GetWalletInvoice(ctx context.Context, request *xlnrpc.GetWall
 			Pubkey:         invoice.Pubkey,
 			SettledAt:      timestamppb.New(invoice.Settled),
 			Timestamp:      timestamppb.New(invoice.Timestamp),
+			Status:         invoice.Status(time.Now().UTC()),
 		}
 		if invoice.Preimage != nil {
 			res.Preimage = *invoice.Preimage
 		}
+		if invoice.ExpiresAt != nil {
+			res.ExpiresAt = timestamppb.New(*invoice.ExpiresAt)
+		}
 		if invoice.RecipientID != nil && invoice.RecipientUsername != nil {
 			res.RecipientId = *invoice.RecipientID
 			res.RecipientUsername = *invoice.RecipientUsername
@@ -407,97 +516,323 @@ func (x xlnServer) GetWalletInvoice(ctx context.Context, request *xlnrpc.GetWall
 
 func (x xlnServer) PayInvoice(ctx context.Context, request *xlnrpc.PayInvoiceRequest) (*xlnrpc.PayInvoiceResponse, error) {
 	log.WithField("req", request).Debug("Xln.PayInvoice called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.PayInvoice")
-	if err != nil {
-		return nil, handleAuthErr(err)
+	username := auth.IdentityFromContext(ctx).User
+	if err := x.authorizePayment(ctx, request.PaymentRequest, int64(request.Amount)); err != nil {
+		return nil, err
 	}
 
-	if request.Amount > 0 {
-		_, err = x.xln.Invoices.PayInvoiceAmount(username, request.WalletId, request.PaymentRequest, false, int64(request.Amount))
-	} else {
-		_, err = x.xln.Invoices.PayInvoice(username, request.WalletId, request.PaymentRequest, false)
-	}
+	options, err := convertPaymentOptions(request.Options)
 	if err != nil {
-		st := status.New(codes.InvalidArgument, fmt.Sprintf("Failed to pay invoice. Reason: %v", err))
-		return &xlnrpc.PayInvoiceResponse{PaymentInitiated: false}, st.Err()
+		return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Invalid payment options. Reason: %v", err)).Err()
 	}
-	return &xlnrpc.PayInvoiceResponse{PaymentInitiated: true}, nil
+
+	res := &xlnrpc.PayInvoiceResponse{}
+	err = x.idempotent(username, request.WalletId, request.IdempotencyKey, "Xln.PayInvoice", request, res, func() error {
+		var err error
+		if request.Amount > 0 {
+			_, err = x.xln.Invoices.PayInvoiceAmount(username, request.WalletId, request.PaymentRequest, false,
+				int64(request.Amount), options)
+		} else {
+			_, err = x.xln.Invoices.PayInvoice(username, request.WalletId, request.PaymentRequest, false, options)
+		}
+		if errors.Is(err, models.ErrSpendingPolicyViolated) {
+			return status.New(codes.FailedPrecondition, fmt.Sprintf("Failed to pay invoice. Reason: %v", err)).Err()
+		} else if err != nil {
+			st := status.New(codes.InvalidArgument, fmt.Sprintf("Failed to pay invoice. Reason: %v", err))
+			return st.Err()
+		}
+		res.PaymentInitiated = true
+		return nil
+	})
+	return res, err
 }
 
 func (x xlnServer) PayInvoiceSync(ctx context.Context, request *xlnrpc.PayInvoiceRequest) (*xlnrpc.PayInvoiceSyncResponse, error) {
 	log.WithField("req", request).Debug("Xln.PayInvoiceSync called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.PayInvoiceSync")
+	username := auth.IdentityFromContext(ctx).User
+	if err := x.authorizePayment(ctx, request.PaymentRequest, int64(request.Amount)); err != nil {
+		return nil, err
+	}
+
+	options, err := convertPaymentOptions(request.Options)
 	if err != nil {
-		return nil, handleAuthErr(err)
+		return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Invalid payment options. Reason: %v", err)).Err()
+	}
+
+	res := &xlnrpc.PayInvoiceSyncResponse{}
+	err = x.idempotent(username, request.WalletId, request.IdempotencyKey, "Xln.PayInvoiceSync", request, res, func() error {
+		var (
+			payment *invoice.Payment
+			err     error
+		)
+		if request.Amount > 0 {
+			payment, err = x.xln.Invoices.PayInvoiceAmount(username, request.WalletId, request.PaymentRequest, true,
+				int64(request.Amount), options)
+		} else {
+			payment, err = x.xln.Invoices.PayInvoice(username, request.WalletId, request.PaymentRequest, true, options)
+		}
+		if errors.Is(err, models.ErrSpendingPolicyViolated) {
+			res.FailureReason = "SPENDING_POLICY"
+			return status.New(codes.FailedPrecondition, fmt.Sprintf("Failed to pay invoice. Reason: %v", err)).Err()
+		} else if err != nil {
+			st := status.New(codes.InvalidArgument, fmt.Sprintf("Failed to pay invoice. Reason: %v", err))
+			res.FailureReason = "INVALID"
+			return st.Err()
+		} else if payment == nil {
+			res.Success = true
+			res.Amount = request.Amount
+		} else {
+			res.Success = payment.Success
+			res.Amount = payment.AmountMsat
+			res.FeesPaid = payment.FeeMsat
+			res.FailureReason = payment.FailureReason
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (x xlnServer) SendKeysend(ctx context.Context, request *xlnrpc.SendKeysendRequest) (*xlnrpc.SendKeysendResponse, error) {
+	log.WithField("req", request).Debug("Xln.SendKeysend called")
+	username := auth.IdentityFromContext(ctx).User
+	if err := x.authorizeAmount(ctx, request.AmountMsat); err != nil {
+		return nil, err
+	}
+	if err := util.ValidatePubkey(request.DestPubkey); err != nil {
+		st := status.New(codes.InvalidArgument, fmt.Sprintf("Invalid destination. Reason: %v", err))
+		return nil, st.Err()
+	}
+	options, err := convertPaymentOptions(request.Options)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Invalid payment options. Reason: %v", err)).Err()
+	}
+
+	res := &xlnrpc.SendKeysendResponse{}
+	err = x.idempotent(username, request.WalletId, request.IdempotencyKey, "Xln.SendKeysend", request, res, func() error {
+		payment, err := x.xln.Invoices.SendKeysend(username, request.WalletId, request.DestPubkey,
+			int64(request.AmountMsat), request.CustomRecords, options)
+		if errors.Is(err, models.ErrSpendingPolicyViolated) {
+			return status.New(codes.FailedPrecondition, fmt.Sprintf("Failed to send keysend. Reason: %v", err)).Err()
+		} else if err != nil {
+			log.WithError(err).Warn("SendKeysend request failed")
+			return status.New(codes.InvalidArgument, fmt.Sprintf("Failed to send keysend. Reason: %v", err)).Err()
+		}
+		res.PaymentHash = payment.PaymentHash
+		res.Success = payment.Success
+		res.Amount = payment.AmountMsat
+		res.FeesPaid = payment.FeeMsat
+		res.FailureReason = payment.FailureReason
+		if payment.Success {
+			res.Preimage = payment.Preimage
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return res, nil
+}
 
-	var payment *invoice.Payment
-	if request.Amount > 0 {
-		payment, err = x.xln.Invoices.PayInvoiceAmount(username, request.WalletId, request.PaymentRequest, true, int64(request.Amount))
-	} else {
-		payment, err = x.xln.Invoices.PayInvoice(username, request.WalletId, request.PaymentRequest, true)
-	}
-	if err != nil {
-		st := status.New(codes.InvalidArgument, fmt.Sprintf("Failed to pay invoice. Reason: %v", err))
-		return &xlnrpc.PayInvoiceSyncResponse{
-			Success:       false,
-			Amount:        0,
-			FeesPaid:      0,
-			FailureReason: "INVALID",
-		}, st.Err()
-	} else if payment == nil {
-		return &xlnrpc.PayInvoiceSyncResponse{
-			Success:       true,
-			Amount:        request.Amount,
-			FeesPaid:      0,
-			FailureReason: "",
-		}, nil
-	} else {
-		return &xlnrpc.PayInvoiceSyncResponse{
-			Success:       payment.Success,
-			Amount:        payment.AmountMsat,
-			FeesPaid:      payment.FeeMsat,
-			FailureReason: payment.FailureReason,
-		}, nil
+func (x xlnServer) QuotePayment(ctx context.Context, request *xlnrpc.QuotePaymentRequest) (*xlnrpc.QuotePaymentResponse, error) {
+	log.WithField("req", request).Debug("Xln.QuotePayment called")
+	username := auth.IdentityFromContext(ctx).User
+	options, err := convertPaymentOptions(request.Options)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Invalid payment options. Reason: %v", err)).Err()
 	}
+
+	quote, err := x.xln.Invoices.QuotePayment(username, request.WalletId, request.PaymentRequest,
+		int64(request.Amount), options)
+	if err != nil {
+		log.WithError(err).Warn("QuotePayment request failed")
+		return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Failed to quote payment. Reason: %v", err)).Err()
+	}
+	return &xlnrpc.QuotePaymentResponse{
+		Internal:          quote.Internal,
+		Amount:            quote.AmountMsat,
+		FeeMsat:           quote.FeeMsat,
+		RouteCount:        uint32(quote.RouteCount),
+		SufficientBalance: quote.SufficientBalance,
+		ServiceFeeMsat:    quote.ServiceFeeMsat,
+	}, nil
+}
+
+func (x xlnServer) BatchPayInvoices(request *xlnrpc.BatchPayInvoicesRequest, stream xlnrpc.Xln_BatchPayInvoicesServer) error {
+	log.WithField("req", request).Debug("Xln.BatchPayInvoices called")
+	username := auth.IdentityFromContext(stream.Context()).User
+	for _, pr := range request.PaymentRequests {
+		if err := x.authorizePayment(stream.Context(), pr, 0); err != nil {
+			return err
+		}
+	}
+	options, err := convertPaymentOptions(request.Options)
+	if err != nil {
+		return status.New(codes.InvalidArgument, fmt.Sprintf("Invalid payment options. Reason: %v", err)).Err()
+	}
+
+	results, err := x.xln.Invoices.BatchPayInvoices(username, request.WalletId, request.PaymentRequests, options)
+	if errors.Is(err, models.ErrSpendingPolicyViolated) {
+		return status.New(codes.FailedPrecondition, fmt.Sprintf("Failed to pay invoices. Reason: %v", err)).Err()
+	} else if err != nil {
+		log.WithError(err).Warn("BatchPayInvoices request failed")
+		return status.New(codes.InvalidArgument, fmt.Sprintf("Failed to pay invoices. Reason: %v", err)).Err()
+	}
+	// payments that are in flight complete regardless of whether their results are received
+	for result := range results {
+		res := &xlnrpc.BatchPaymentResult{
+			Index:          uint32(result.Index),
+			PaymentRequest: result.PaymentRequest,
+		}
+		if result.Err != nil {
+			res.FailureReason = result.Err.Error()
+		} else {
+			res.Success = result.Payment.Success
+			res.Amount = result.Payment.AmountMsat
+			res.FeesPaid = result.Payment.FeeMsat
+			res.FailureReason = result.Payment.FailureReason
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (x xlnServer) Transfer(ctx context.Context, request *xlnrpc.TransferRequest) (*xlnrpc.TransferResponse, error) {
 	log.WithField("req", request).Debug("Xln.Transfer called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.Transfer")
+	username := auth.IdentityFromContext(ctx).User
+	if err := x.authorizeAmount(ctx, request.Amount); err != nil {
+		return nil, err
+	}
+
+	res := &xlnrpc.TransferResponse{}
+	err := x.idempotent(username, request.WalletId, request.IdempotencyKey, "Xln.Transfer", request, res, func() error {
+		txn, err := x.xln.Wallets.Transfer(username, request.WalletId, request.ToWalletId, request.Amount)
+		if err == models.ErrCannotTransactWithLockedWallet || errors.Is(err, models.ErrSpendingPolicyViolated) {
+			return status.New(codes.FailedPrecondition, fmt.Sprintf(
+				"Failed to transfer funds between wallets. Reason: %v", err)).Err()
+		} else if err != nil {
+			st := status.New(codes.Internal, fmt.Sprintf("Failed to transfer funds between wallets. Reason: %v", err))
+			log.WithError(err).WithFields(log.Fields{
+				"user":        username,
+				"recipientId": request.WalletId,
+				"senderId":    request.ToWalletId,
+				"amount":      request.Amount,
+			}).Warn("Transfer request failed.")
+			return st.Err()
+		} else {
+			log.WithError(err).WithFields(log.Fields{
+				"from": request.WalletId,
+				"to":   request.ToWalletId,
+			}).Info("sent funds between wallets")
+
+			res.Success = true
+			res.TransactionId = txn.ID
+			return nil
+		}
+	})
 	if err != nil {
-		return nil, handleAuthErr(err)
+		return nil, err
 	}
+	return res, nil
+}
 
-	txn, err := x.xln.Wallets.Transfer(username, request.WalletId, request.ToWalletId, request.Amount)
-	if err == models.ErrCannotTransactWithLockedWallet {
-		return nil, status.New(codes.FailedPrecondition, fmt.Sprintf(
-			"Failed to transfer funds between wallets. Reason: %v", err)).Err()
-	} else if err != nil {
-		st := status.New(codes.Internal, fmt.Sprintf("Failed to transfer funds between wallets. Reason: %v", err))
-		log.WithError(err).WithFields(log.Fields{
-			"user":        username,
-			"recipientId": request.WalletId,
-			"senderId":    request.ToWalletId,
-			"amount":      request.Amount,
-		}).Warn("Transfer request failed.")
-		return nil, st.Err()
-	} else {
-		log.WithError(err).WithFields(log.Fields{
-			"from": request.WalletId,
-			"to":   request.ToWalletId,
-		}).Info("sent funds between wallets")
+func (x xlnServer) TransferToUser(ctx context.Context, request *xlnrpc.TransferToUserRequest) (*xlnrpc.TransferToUserResponse, error) {
+	log.WithField("req", request).Debug("Xln.TransferToUser called")
+	username := auth.IdentityFromContext(ctx).User
+	if err := x.authorizeAmount(ctx, request.Amount); err != nil {
+		return nil, err
+	}
+	if err := util.ValidateMemo(request.Memo); err != nil {
+		return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Invalid memo. Reason: %v", err)).Err()
+	}
+
+	res := &xlnrpc.TransferToUserResponse{}
+	err := x.idempotent(username, request.WalletId, request.IdempotencyKey, "Xln.TransferToUser", request, res, func() error {
+		txn, err := x.xln.Wallets.TransferToUser(username, request.WalletId, request.ToUsername, request.ToWalletId,
+			request.Amount, request.Memo)
+		if err == models.ErrCannotTransactWithLockedWallet || err == models.ErrTransfersNotAccepted ||
+			errors.Is(err, models.ErrSpendingPolicyViolated) {
+			return status.New(codes.FailedPrecondition, fmt.Sprintf(
+				"Failed to transfer funds to user. Reason: %v", err)).Err()
+		} else if err != nil {
+			log.WithError(err).WithFields(log.Fields{
+				"user":        username,
+				"wallet":      request.WalletId,
+				"recipient":   request.ToUsername,
+				"recipientId": request.ToWalletId,
+				"amount":      request.Amount,
+			}).Warn("TransferToUser request failed.")
+			return status.New(codes.Internal, fmt.Sprintf("Failed to transfer funds to user. Reason: %v", err)).Err()
+		}
+		log.WithFields(log.Fields{
+			"from":   request.WalletId,
+			"toUser": request.ToUsername,
+			"to":     request.ToWalletId,
+		}).Info("sent funds to user")
+		res.Success = true
+		res.TransactionId = txn.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
 
-		return &xlnrpc.TransferResponse{Success: true, TransactionId: txn.ID}, nil
+func (x xlnServer) BatchTransfer(ctx context.Context, request *xlnrpc.BatchTransferRequest) (*xlnrpc.BatchTransferResponse, error) {
+	log.WithField("req", request).Debug("Xln.BatchTransfer called")
+	username := auth.IdentityFromContext(ctx).User
+	transfers := make([]*wallet.BatchTransferItem, len(request.Transfers))
+	for i, item := range request.Transfers {
+		if err := x.authorizeAmount(ctx, item.Amount); err != nil {
+			return nil, err
+		}
+		transfers[i] = &wallet.BatchTransferItem{
+			FromWalletID: request.WalletId,
+			ToWalletID:   item.WalletId,
+			Amount:       item.Amount,
+		}
+		if request.Collect {
+			// funds leave each of the other wallets, so the caller must be able to spend from all of them
+			if _, err := x.xln.AuthService.ValidateWalletCredentials(ctx, item.WalletId, "Xln.BatchTransfer"); err != nil {
+				return nil, handleAuthErr(err)
+			}
+			transfers[i].FromWalletID, transfers[i].ToWalletID = item.WalletId, request.WalletId
+		}
 	}
+
+	res := &xlnrpc.BatchTransferResponse{}
+	err := x.idempotent(username, request.WalletId, request.IdempotencyKey, "Xln.BatchTransfer", request, res, func() error {
+		batchId, txns, err := x.xln.Wallets.BatchTransfer(username, transfers)
+		if err == models.ErrCannotTransactWithLockedWallet || errors.Is(err, models.ErrSpendingPolicyViolated) {
+			return status.New(codes.FailedPrecondition, fmt.Sprintf(
+				"Failed to transfer funds between wallets. Reason: %v", err)).Err()
+		} else if err != nil {
+			log.WithError(err).WithFields(log.Fields{
+				"user":      username,
+				"wallet":    request.WalletId,
+				"transfers": len(transfers),
+			}).Warn("BatchTransfer request failed.")
+			return status.New(codes.Internal, fmt.Sprintf(
+				"Failed to transfer funds between wallets. Reason: %v", err)).Err()
+		}
+		res.Success = true
+		res.BatchId = batchId
+		for _, txn := range txns {
+			res.TransactionIds = append(res.TransactionIds, txn.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (x xlnServer) ListUserTransactions(ctx context.Context, request *xlnrpc.ListUserTransactionsRequest) (*xlnrpc.ListUserTransactionsResponse, error) {
 	log.WithField("req", request).Debug("Xln.ListUserTransactions called")
-	username, err := x.xln.AuthService.ValidateUserCredentials(ctx, "Xln.ListUserTransactions")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 
 	var startTime, endTime time.Time
 	if request.FromTime != nil {
@@ -559,10 +894,7 @@ func (x xlnServer) Validate(ctx context.Context, request *xlnrpc.ValidateRequest
 
 func (x xlnServer) GetUser(ctx context.Context, request *xlnrpc.GetUserRequest) (*xlnrpc.GetUserResponse, error) {
 	log.WithField("req", request).Debug("Xln.GetUser called")
-	username, err := x.xln.AuthService.ValidateUserCredentials(ctx, "Xln.GetUser")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 	user, err := x.xln.Users.GetUser(username)
 	if err == models.ErrUserNotFound {
 		return nil, status.New(codes.NotFound, fmt.Sprintf("Failed to get user. Reason: %v", err)).Err()
@@ -573,7 +905,6 @@ func (x xlnServer) GetUser(ctx context.Context, request *xlnrpc.GetUserRequest)
 	}
 
 	res := xlnrpc.GetUserResponse{
-		ApiKey:    user.ApiKey,
 		CreatedAt: timestamppb.New(user.CreatedAt),
 	}
 	if user.LinkKey != nil {
@@ -582,16 +913,44 @@ func (x xlnServer) GetUser(ctx context.Context, request *xlnrpc.GetUserRequest)
 	if user.LinkLabel != nil {
 		res.LinkLabel = *user.LinkLabel
 	}
+	defaults, err := x.xln.Invoices.GetPaymentDefaults(username)
+	if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to get payment defaults. Reason: %v", err))
+		log.WithError(err).Warn("GetUser request failed to get payment defaults")
+		return nil, st.Err()
+	}
+	res.PaymentDefaults = &xlnrpc.PaymentDefaults{
+		MaxFeeMsat:     defaults.MaxFeeMsat,
+		MaxFeePpm:      defaults.MaxFeePpm,
+		TimeoutSeconds: defaults.TimeoutSeconds,
+		MaxParts:       defaults.MaxParts,
+	}
 
 	return &res, nil
 }
 
+func (x xlnServer) SetPaymentDefaults(ctx context.Context, request *xlnrpc.SetPaymentDefaultsRequest) (*xlnrpc.SetPaymentDefaultsResponse, error) {
+	log.WithField("req", request).Debug("Xln.SetPaymentDefaults called")
+	username := auth.IdentityFromContext(ctx).User
+
+	defaults := &models.PaymentDefaults{Username: username}
+	if request.Defaults != nil {
+		defaults.MaxFeeMsat = request.Defaults.MaxFeeMsat
+		defaults.MaxFeePpm = request.Defaults.MaxFeePpm
+		defaults.TimeoutSeconds = request.Defaults.TimeoutSeconds
+		defaults.MaxParts = request.Defaults.MaxParts
+	}
+	if err := x.xln.Invoices.SetPaymentDefaults(defaults); err != nil {
+		st := status.New(codes.InvalidArgument, fmt.Sprintf("Failed to set payment defaults. Reason: %v", err))
+		log.WithError(err).Warn("SetPaymentDefaults request failed")
+		return nil, st.Err()
+	}
+	return &xlnrpc.SetPaymentDefaultsResponse{}, nil
+}
+
 func (x xlnServer) UserLinkWallet(ctx context.Context, request *xlnrpc.UserLinkWalletRequest) (*xlnrpc.UserLinkWalletResponse, error) {
 	log.WithField("req", request).Debug("Xln.UserLinkWallet called")
-	username, err := x.xln.AuthService.ValidateUserCredentials(ctx, "Xln.UserLinkWallet")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 
 	lnurl, err := x.xln.LNURLAuths.UserLinkAuth(username, request.Label)
 	if err != nil {
@@ -604,10 +963,7 @@ func (x xlnServer) UserLinkWallet(ctx context.Context, request *xlnrpc.UserLinkW
 
 func (x xlnServer) LinkWallet(ctx context.Context, request *xlnrpc.LinkWalletRequest) (*xlnrpc.LinkWalletResponse, error) {
 	log.WithField("req", request).Debug("Xln.LinkWallet called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.LinkWallet")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 
 	lnurl, err := x.xln.LNURLAuths.WalletLinkAuth(username, &request.WalletId, request.Label)
 	if err != nil {
@@ -642,13 +998,25 @@ func (x xlnServer) WalletLogin(ctx context.Context, request *xlnrpc.WalletLoginR
 
 func (x xlnServer) LoginStatus(ctx context.Context, request *xlnrpc.LoginStatusRequest) (*xlnrpc.LoginStatusResponse, error) {
 	log.WithField("req", request).Debug("Xln.LoginStatus called")
-	apiKey, err := x.xln.LNURLAuths.GetAuthKey(request.K1)
+	auth, err := x.xln.LNURLAuths.ConsumeAuth(request.K1)
 	if err != nil {
 		st := status.New(codes.Unauthenticated, fmt.Sprintf("Not logged in. Reason: %v", err))
 		log.WithError(err).WithField("k1", request.K1).Debug("LoginStatus request failed")
 		return nil, st.Err()
 	}
-	return &xlnrpc.LoginStatusResponse{ApiKey: apiKey}, nil
+	// only hashes of keys are stored, so each login is issued its own key, which leaves the keys of other clients of
+	// the user or wallet valid. The key expires after the session lifetime, so that old logins stop working
+	now := time.Now().UTC()
+	name := "login " + now.Format(time.RFC3339)
+	expiresAt := now.Add(x.xln.Config.LoginSessionLifetime)
+	key, err := x.xln.ApiKeys.CreateApiKey(*auth.UserUsername, auth.WalletID, name, []string{models.ScopeAdmin},
+		&expiresAt)
+	if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to issue api key. Reason: %v", err))
+		log.WithError(err).WithField("k1", request.K1).Warn("LoginStatus request failed")
+		return nil, st.Err()
+	}
+	return &xlnrpc.LoginStatusResponse{ApiKey: key.Key}, nil
 }
 
 func convertWalletData(wallet *models.Wallet) *xlnrpc.Wallet {
@@ -665,9 +1033,10 @@ func convertWalletData(wallet *models.Wallet) *xlnrpc.Wallet {
 
 func (x xlnServer) CreateLNURLW(ctx context.Context, request *xlnrpc.CreateLNURLWRequest) (*xlnrpc.CreateLNURLWResponse, error) {
 	log.WithField("req", request).Debug("Xln.CreateLNURLW called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.GenerateLNURLW")
-	if err != nil {
-		return nil, handleAuthErr(err)
+	username := auth.IdentityFromContext(ctx).User
+	// withdrawals of links without a maximum are unlimited
+	if max, limited := x.xln.AuthService.MaxPaymentAmount(ctx); limited && (request.MaxMsats == 0 || request.MaxMsats > max) {
+		return nil, handleAuthErr(auth.ErrAmountNotAllowed)
 	}
 	var expiryUTC time.Time
 	if request.ExpireAt != nil {
@@ -690,10 +1059,7 @@ func (x xlnServer) CreateLNURLW(ctx context.Context, request *xlnrpc.CreateLNURL
 
 func (x xlnServer) GetLNURLW(ctx context.Context, request *xlnrpc.GetLNURLWRequest) (*xlnrpc.GetLNURLWResponse, error) {
 	log.WithField("req", request).Debug("Xln.GetLNURLW called")
-	username, err := x.xln.AuthService.ValidateWalletCredentials(ctx, request.WalletId, "Xln.GenerateLNURLW")
-	if err != nil {
-		return nil, handleAuthErr(err)
-	}
+	username := auth.IdentityFromContext(ctx).User
 	lnurl, err := x.xln.LNURLWithdraw.GetLNURLW(username, request.WalletId, request.K1)
 	if err != nil {
 		st := status.New(codes.Internal, fmt.Sprintf("Failed to generate LNURLW. Reason: %v", err))
@@ -708,6 +1074,477 @@ func (x xlnServer) GetLNURLW(ctx context.Context, request *xlnrpc.GetLNURLWReque
 	return &res, nil
 }
 
+func (x xlnServer) CreateLNURLP(ctx context.Context, request *xlnrpc.CreateLNURLPRequest) (*xlnrpc.CreateLNURLPResponse, error) {
+	log.WithField("req", request).Debug("Xln.CreateLNURLP called")
+	username := auth.IdentityFromContext(ctx).User
+	lnurl, err := x.xln.LNURLPay.CreateLNURLP(username, request.WalletId, request.Description, request.MinMsats, request.MaxMsats)
+	if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to create LNURLP. Reason: %v", err))
+		log.WithError(err).Warn("CreateLNURLP request failed")
+		return nil, st.Err()
+	}
+
+	res := xlnrpc.CreateLNURLPResponse{
+		Url: lnurl,
+	}
+
+	return &res, nil
+}
+
+func (x xlnServer) GetLNURLP(ctx context.Context, request *xlnrpc.GetLNURLPRequest) (*xlnrpc.GetLNURLPResponse, error) {
+	log.WithField("req", request).Debug("Xln.GetLNURLP called")
+	username := auth.IdentityFromContext(ctx).User
+	lnurl, err := x.xln.LNURLPay.GetLNURLP(username, request.WalletId, request.K1)
+	if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to generate LNURLP. Reason: %v", err))
+		log.WithError(err).Warn("GetLNURLP request failed")
+		return nil, st.Err()
+	}
+
+	res := xlnrpc.GetLNURLPResponse{
+		Url: lnurl,
+	}
+
+	return &res, nil
+}
+
+func (x xlnServer) CreateWebhook(ctx context.Context, request *xlnrpc.CreateWebhookRequest) (*xlnrpc.CreateWebhookResponse, error) {
+	log.WithField("req", request).Debug("Xln.CreateWebhook called")
+	username, walletId := callerOf(ctx)
+	if err := util.ValidateWebhookURL(request.Url); err != nil {
+		st := status.New(codes.InvalidArgument, fmt.Sprintf("Invalid webhook url. Reason: %v", err))
+		return nil, st.Err()
+	}
+
+	webhook, err := x.xln.Webhooks.CreateWebhook(username, walletId, request.Url)
+	if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to create webhook. Reason: %v", err))
+		log.WithError(err).Warn("CreateWebhook request failed")
+		return nil, st.Err()
+	}
+	return &xlnrpc.CreateWebhookResponse{Webhook: convertWebhook(webhook)}, nil
+}
+
+func (x xlnServer) ListWebhooks(ctx context.Context, request *xlnrpc.ListWebhooksRequest) (*xlnrpc.ListWebhooksResponse, error) {
+	log.WithField("req", request).Debug("Xln.ListWebhooks called")
+	username, walletId := callerOf(ctx)
+
+	webhooks, err := x.xln.Webhooks.ListWebhooks(username, walletId)
+	if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to list webhooks. Reason: %v", err))
+		log.WithError(err).Warn("ListWebhooks request failed")
+		return nil, st.Err()
+	}
+	res := &xlnrpc.ListWebhooksResponse{}
+	for _, webhook := range webhooks {
+		res.Webhooks = append(res.Webhooks, convertWebhook(webhook))
+	}
+	return res, nil
+}
+
+func (x xlnServer) DeleteWebhook(ctx context.Context, request *xlnrpc.DeleteWebhookRequest) (*xlnrpc.DeleteWebhookResponse, error) {
+	log.WithField("req", request).Debug("Xln.DeleteWebhook called")
+	username, walletId := callerOf(ctx)
+
+	err := x.xln.Webhooks.DeleteWebhook(username, walletId, request.WebhookId)
+	if err == models.ErrWebhookNotFound {
+		return nil, status.New(codes.NotFound, fmt.Sprintf("Failed to delete webhook. Reason: %v", err)).Err()
+	} else if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to delete webhook. Reason: %v", err))
+		log.WithError(err).Warn("DeleteWebhook request failed")
+		return nil, st.Err()
+	}
+	return &xlnrpc.DeleteWebhookResponse{}, nil
+}
+
+func (x xlnServer) CreateSchedule(ctx context.Context, request *xlnrpc.CreateScheduleRequest) (*xlnrpc.CreateScheduleResponse, error) {
+	log.WithField("req", request).Debug("Xln.CreateSchedule called")
+	username := auth.IdentityFromContext(ctx).User
+	if err := x.authorizeAmount(ctx, request.Amount); err != nil {
+		return nil, err
+	}
+
+	schedule := &models.Schedule{
+		Username: username,
+		WalletID: request.WalletId,
+		Amount:   request.Amount,
+		Memo:     request.Memo,
+		Interval: request.Interval,
+	}
+	if request.ToUsername != "" || request.ToWalletId != "" {
+		schedule.TargetUsername = &request.ToUsername
+		schedule.TargetWalletID = &request.ToWalletId
+	}
+	if request.KeysendPubkey != "" {
+		schedule.TargetPubkey = &request.KeysendPubkey
+	}
+	if request.Lnurl != "" {
+		schedule.TargetLNURL = &request.Lnurl
+	}
+	if request.StartTime != nil {
+		schedule.NextRun = request.StartTime.AsTime().UTC()
+	}
+	if request.EndTime != nil {
+		endTime := request.EndTime.AsTime().UTC()
+		schedule.EndsAt = &endTime
+	}
+	apiKeyId, expiresAt, err := x.xln.AuthService.CredentialsLifetime(ctx)
+	if err != nil {
+		return nil, handleAuthErr(err)
+	}
+	schedule.ApiKeyID, schedule.CredentialsExpireAt = apiKeyId, expiresAt
+	err = x.xln.Schedules.CreateSchedule(schedule)
+	if err == models.ErrTransfersNotAccepted {
+		return nil, status.New(codes.FailedPrecondition, fmt.Sprintf("Failed to create schedule. Reason: %v", err)).Err()
+	} else if err != nil {
+		st := status.New(codes.InvalidArgument, fmt.Sprintf("Failed to create schedule. Reason: %v", err))
+		log.WithError(err).Warn("CreateSchedule request failed")
+		return nil, st.Err()
+	}
+	return &xlnrpc.CreateScheduleResponse{Schedule: convertSchedule(schedule)}, nil
+}
+
+func (x xlnServer) ListSchedules(ctx context.Context, request *xlnrpc.ListSchedulesRequest) (*xlnrpc.ListSchedulesResponse, error) {
+	log.WithField("req", request).Debug("Xln.ListSchedules called")
+	username := auth.IdentityFromContext(ctx).User
+
+	schedules, err := x.xln.Schedules.ListSchedules(username, request.WalletId)
+	if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to list schedules. Reason: %v", err))
+		log.WithError(err).Warn("ListSchedules request failed")
+		return nil, st.Err()
+	}
+	res := &xlnrpc.ListSchedulesResponse{}
+	for _, schedule := range schedules {
+		res.Schedules = append(res.Schedules, convertSchedule(schedule))
+	}
+	return res, nil
+}
+
+func (x xlnServer) CancelSchedule(ctx context.Context, request *xlnrpc.CancelScheduleRequest) (*xlnrpc.CancelScheduleResponse, error) {
+	log.WithField("req", request).Debug("Xln.CancelSchedule called")
+	username := auth.IdentityFromContext(ctx).User
+
+	err := x.xln.Schedules.CancelSchedule(username, request.WalletId, request.ScheduleId)
+	if err == models.ErrScheduleNotFound {
+		return nil, status.New(codes.NotFound, fmt.Sprintf("Failed to cancel schedule. Reason: %v", err)).Err()
+	} else if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to cancel schedule. Reason: %v", err))
+		log.WithError(err).Warn("CancelSchedule request failed")
+		return nil, st.Err()
+	}
+	return &xlnrpc.CancelScheduleResponse{}, nil
+}
+
+func (x xlnServer) CreateApiKey(ctx context.Context, request *xlnrpc.CreateApiKeyRequest) (*xlnrpc.CreateApiKeyResponse, error) {
+	log.WithField("req", request).Debug("Xln.CreateApiKey called")
+	username := auth.IdentityFromContext(ctx).User
+	if err := x.requireFullAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	var walletId *string
+	if request.WalletId != "" {
+		walletId = &request.WalletId
+	}
+	var expiresAt *time.Time
+	if request.ExpiryTime != nil {
+		expiry := request.ExpiryTime.AsTime().UTC()
+		expiresAt = &expiry
+	}
+	key, err := x.xln.ApiKeys.CreateApiKey(username, walletId, request.Name, request.Scopes, expiresAt)
+	if err == models.ErrWalletNotFound {
+		return nil, status.New(codes.NotFound, fmt.Sprintf("Failed to create api key. Reason: %v", err)).Err()
+	} else if err != nil {
+		st := status.New(codes.InvalidArgument, fmt.Sprintf("Failed to create api key. Reason: %v", err))
+		log.WithError(err).Warn("CreateApiKey request failed")
+		return nil, st.Err()
+	}
+	return &xlnrpc.CreateApiKeyResponse{ApiKey: convertApiKey(key), Key: key.Key}, nil
+}
+
+func (x xlnServer) ListApiKeys(ctx context.Context, request *xlnrpc.ListApiKeysRequest) (*xlnrpc.ListApiKeysResponse, error) {
+	log.WithField("req", request).Debug("Xln.ListApiKeys called")
+	username := auth.IdentityFromContext(ctx).User
+
+	keys, err := x.xln.ApiKeys.ListApiKeys(username)
+	if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to list api keys. Reason: %v", err))
+		log.WithError(err).Warn("ListApiKeys request failed")
+		return nil, st.Err()
+	}
+	res := &xlnrpc.ListApiKeysResponse{}
+	for _, key := range keys {
+		res.ApiKeys = append(res.ApiKeys, convertApiKey(key))
+	}
+	return res, nil
+}
+
+func (x xlnServer) RevokeApiKey(ctx context.Context, request *xlnrpc.RevokeApiKeyRequest) (*xlnrpc.RevokeApiKeyResponse, error) {
+	log.WithField("req", request).Debug("Xln.RevokeApiKey called")
+	username := auth.IdentityFromContext(ctx).User
+	if err := x.requireFullAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	err := x.xln.ApiKeys.RevokeApiKey(username, request.ApiKeyId)
+	if err == models.ErrApiKeyNotFound {
+		return nil, status.New(codes.NotFound, fmt.Sprintf("Failed to revoke api key. Reason: %v", err)).Err()
+	} else if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to revoke api key. Reason: %v", err))
+		log.WithError(err).Warn("RevokeApiKey request failed")
+		return nil, st.Err()
+	}
+	return &xlnrpc.RevokeApiKeyResponse{}, nil
+}
+
+func (x xlnServer) RotateApiKey(ctx context.Context, request *xlnrpc.RotateApiKeyRequest) (*xlnrpc.RotateApiKeyResponse, error) {
+	log.WithField("req", request).Debug("Xln.RotateApiKey called")
+	username, walletId := callerOf(ctx)
+	if err := x.requireFullAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	var (
+		apiKey string
+		err    error
+	)
+	if walletId != nil {
+		apiKey, err = x.xln.Wallets.RotateApiKey(username, *walletId)
+	} else {
+		apiKey, err = x.xln.Users.RotateApiKey(username)
+	}
+	if err == models.ErrUserNotFound || err == models.ErrWalletNotFound {
+		return nil, status.New(codes.NotFound, fmt.Sprintf("Failed to rotate api key. Reason: %v", err)).Err()
+	} else if err != nil {
+		st := status.New(codes.Internal, fmt.Sprintf("Failed to rotate api key. Reason: %v", err))
+		log.WithError(err).Warn("RotateApiKey request failed")
+		return nil, st.Err()
+	}
+	x.xln.AuthService.InvalidateApiKey(username, walletId)
+	return &xlnrpc.RotateApiKeyResponse{ApiKey: apiKey}, nil
+}
+
+func (x xlnServer) SubscribeWalletEvents(request *xlnrpc.SubscribeWalletEventsRequest, stream xlnrpc.Xln_SubscribeWalletEventsServer) error {
+	log.WithField("req", request).Debug("Xln.SubscribeWalletEvents called")
+	ctx := stream.Context()
+	username := auth.IdentityFromContext(ctx).User
+
+	sub, cancel := x.xln.Events.Subscribe(username, request.WalletId)
+	defer cancel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, open := <-sub:
+			if !open {
+				return status.New(codes.Unavailable,
+					"Failed to stream wallet events. Reason: subscriber fell behind").Err()
+			}
+			wallet, err := x.xln.Wallets.GetWallet(username, request.WalletId)
+			if err != nil {
+				log.WithError(err).Warn("SubscribeWalletEvents request failed")
+				return status.New(codes.Internal, fmt.Sprintf("Failed to stream wallet events. Reason: %v", err)).Err()
+			}
+			if err := stream.Send(convertWalletEvent(event, wallet.Balance)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// requireFullAccess errors unless the credentials of the request context have full access, so that credentials with
+// restrictions cannot issue, revoke or replace keys without them.
+func (x xlnServer) requireFullAccess(ctx context.Context) error {
+	if !x.xln.AuthService.HasFullAccess(ctx) {
+		return handleAuthErr(auth.ErrInsufficientScope)
+	}
+	return nil
+}
+
+// callerOf returns the user that the caller of the RPC of ctx was authorized as, and the wallet if it was authorized
+// for one. The returned wallet id is nil if the request is made on behalf of the user.
+func callerOf(ctx context.Context) (string, *string) {
+	identity := auth.IdentityFromContext(ctx)
+	if identity.Wallet == "" {
+		return identity.User, nil
+	}
+	return identity.User, &identity.Wallet
+}
+
+// authorizeAmount errors if the credentials of the request context do not allow payments or transfers of amountMsat.
+func (x xlnServer) authorizeAmount(ctx context.Context, amountMsat uint64) error {
+	if max, limited := x.xln.AuthService.MaxPaymentAmount(ctx); limited && amountMsat > max {
+		return handleAuthErr(auth.ErrAmountNotAllowed)
+	}
+	return nil
+}
+
+// authorizePayment errors if the credentials of the request context do not allow paying the invoice pr with amount.
+// The invoice is only decoded if the credentials limit the amounts of payments.
+func (x xlnServer) authorizePayment(ctx context.Context, pr string, amount int64) error {
+	if _, limited := x.xln.AuthService.MaxPaymentAmount(ctx); !limited {
+		return nil
+	}
+	amountMsat, err := x.xln.Invoices.PaymentAmount(pr, amount)
+	if err != nil {
+		return status.New(codes.InvalidArgument, fmt.Sprintf("Failed to pay invoice. Reason: %v", err)).Err()
+	}
+	return x.authorizeAmount(ctx, amountMsat)
+}
+
+// idempotent executes call, which must populate res, unless the wallet already completed an identical request with
+// the idempotency key. In that case, the response of that request is unmarshalled into res instead.
+// The key is released if call fails so that the request may be retried. Requests without a key are always executed.
+func (x xlnServer) idempotent(username, walletId, key, method string, request, res proto.Message, call func() error) error {
+	if key == "" {
+		return call()
+	} else if err := util.ValidateIdempotencyKey(key); err != nil {
+		return status.New(codes.InvalidArgument, fmt.Sprintf("Invalid idempotency key. Reason: %v", err)).Err()
+	}
+
+	req, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		log.WithError(err).WithField("method", method).Error("Failed to marshal idempotent request")
+		return status.New(codes.Internal, "Failed to process idempotency key").Err()
+	}
+	stored, completed, err := x.xln.Idempotency.Begin(username, walletId, key, method, req)
+	if err == models.ErrIdempotencyKeyInProgress {
+		return status.New(codes.Aborted, fmt.Sprintf("Failed to process idempotency key. Reason: %v", err)).Err()
+	} else if err == models.ErrIdempotencyKeyReused {
+		return status.New(codes.InvalidArgument, fmt.Sprintf("Failed to process idempotency key. Reason: %v", err)).Err()
+	} else if err != nil {
+		return status.New(codes.Internal, fmt.Sprintf("Failed to process idempotency key. Reason: %v", err)).Err()
+	} else if completed {
+		if err = proto.Unmarshal(stored, res); err != nil {
+			log.WithError(err).WithField("method", method).Error("Failed to unmarshal stored idempotent response")
+			return status.New(codes.Internal, "Failed to process idempotency key").Err()
+		}
+		return nil
+	}
+
+	if err = call(); err != nil {
+		if releaseErr := x.xln.Idempotency.Release(username, walletId, key); releaseErr != nil {
+			log.WithError(releaseErr).WithFields(log.Fields{
+				"user":   username,
+				"wallet": walletId,
+				"method": method,
+			}).Error("Failed to release idempotency key of failed request")
+		}
+		return err
+	}
+	// the request has executed, so it succeeds even if its response cannot be stored. Retries with the key are
+	// then rejected as in progress rather than executed again, until the claim of the key times out.
+	if response, err := proto.Marshal(res); err != nil {
+		log.WithError(err).WithField("method", method).Error("Failed to marshal idempotent response")
+	} else if err = x.xln.Idempotency.Complete(username, walletId, key, response); err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"user":   username,
+			"wallet": walletId,
+			"method": method,
+		}).Error("Failed to store response of idempotent request")
+	}
+	return nil
+}
+
+// convertPaymentOptions converts the routing options of a payment, which are nil if none are set
+func convertPaymentOptions(options *xlnrpc.PaymentOptions) (*invoice.PaymentOptions, error) {
+	if options == nil {
+		return nil, nil
+	} else if options.LastHopPubkey != "" {
+		if err := util.ValidatePubkey(options.LastHopPubkey); err != nil {
+			return nil, err
+		}
+	}
+	return &invoice.PaymentOptions{
+		MaxFeeMsat:      options.MaxFeeMsat,
+		MaxFeePpm:       options.MaxFeePpm,
+		TimeoutSeconds:  options.TimeoutSeconds,
+		MaxParts:        options.MaxParts,
+		OutgoingChanIds: options.OutgoingChanIds,
+		LastHopPubkey:   options.LastHopPubkey,
+	}, nil
+}
+
+func convertWebhook(webhook *models.Webhook) *xlnrpc.Webhook {
+	res := &xlnrpc.Webhook{
+		Id:           webhook.ID,
+		Url:          webhook.URL,
+		CreationTime: timestamppb.New(webhook.CreatedAt),
+	}
+	if webhook.WalletID != nil {
+		res.WalletId = *webhook.WalletID
+	}
+	return res
+}
+
+func convertSchedule(schedule *models.Schedule) *xlnrpc.Schedule {
+	res := &xlnrpc.Schedule{
+		Id:                schedule.ID,
+		Amount:            schedule.Amount,
+		Memo:              schedule.Memo,
+		Interval:          schedule.Interval,
+		NextRunTime:       timestamppb.New(schedule.NextRun),
+		Status:            schedule.Status,
+		Runs:              schedule.Runs,
+		Failures:          schedule.Failures,
+		LastFailureReason: schedule.LastFailureReason,
+		CreationTime:      timestamppb.New(schedule.CreatedAt),
+	}
+	if schedule.TargetUsername != nil {
+		res.ToUsername = *schedule.TargetUsername
+	}
+	if schedule.TargetWalletID != nil {
+		res.ToWalletId = *schedule.TargetWalletID
+	}
+	if schedule.TargetPubkey != nil {
+		res.KeysendPubkey = *schedule.TargetPubkey
+	}
+	if schedule.TargetLNURL != nil {
+		res.Lnurl = *schedule.TargetLNURL
+	}
+	if schedule.EndsAt != nil {
+		res.EndTime = timestamppb.New(*schedule.EndsAt)
+	}
+	return res
+}
+
+func convertApiKey(key *models.ApiKey) *xlnrpc.ApiKey {
+	res := &xlnrpc.ApiKey{
+		Id:           key.ID,
+		Name:         key.Name,
+		Scopes:       key.ScopeList(),
+		CreationTime: timestamppb.New(key.CreatedAt),
+	}
+	if key.WalletID != nil {
+		res.WalletId = *key.WalletID
+	}
+	if key.ExpiresAt != nil {
+		res.ExpiryTime = timestamppb.New(*key.ExpiresAt)
+	}
+	if key.LastUsedAt != nil {
+		res.LastUsedTime = timestamppb.New(*key.LastUsedAt)
+	}
+	return res
+}
+
+func convertWalletEvent(event *events.Event, balance uint64) *xlnrpc.WalletEvent {
+	res := &xlnrpc.WalletEvent{
+		Event:         event.Type,
+		Time:          timestamppb.New(event.Time),
+		WalletId:      event.WalletID,
+		Balance:       balance,
+		PaymentHash:   event.PaymentHash,
+		AmountMsat:    event.AmountMsat,
+		FeeMsat:       event.FeeMsat,
+		FailureReason: event.FailureReason,
+	}
+	if event.Transaction != nil {
+		res.Transaction = convertTransaction(event.Transaction)
+	}
+	return res
+}
+
 func convertTransaction(transaction *models.Transaction) *xlnrpc.Transaction {
 	tx := &xlnrpc.Transaction{}
 	tx.Id = transaction.ID
@@ -726,16 +1563,24 @@ func convertTransaction(transaction *models.Transaction) *xlnrpc.Transaction {
 	}
 	tx.Amount = transaction.Amount
 	tx.FeesPaid = transaction.FeesPaid
+	tx.ServiceFee = transaction.ServiceFee
+	if transaction.BatchID != nil {
+		tx.BatchId = *transaction.BatchID
+	}
+	if transaction.Label != nil {
+		tx.Label = *transaction.Label
+	}
 	return tx
 }
 
-func convertFullTransaction(tx *models.Transaction) *xlnrpc.GetWalletTransactionResponse {
+func convertFullTransaction(tx *models.Transaction, username, walletId string) *xlnrpc.GetWalletTransactionResponse {
 	res := &xlnrpc.GetWalletTransactionResponse{
 		Id:           tx.ID,
 		CreationTime: timestamppb.New(tx.CreatedAt),
 		UpdateTime:   timestamppb.New(tx.UpdatedAt),
 		Amount:       tx.Amount,
 		FeesPaid:     tx.FeesPaid,
+		ServiceFee:   tx.ServiceFee,
 	}
 	if tx.FromID != nil {
 		res.From = *tx.FromID
@@ -746,28 +1591,44 @@ func convertFullTransaction(tx *models.Transaction) *xlnrpc.GetWalletTransaction
 	if tx.Label != nil {
 		res.Label = *tx.Label
 	}
+	if tx.BatchID != nil {
+		res.BatchId = *tx.BatchID
+	}
 	if tx.Invoice != nil {
-		res.Invoice = &xlnrpc.Invoice{
-			PaymentHash:    tx.Invoice.PaymentHash,
-			PaymentRequest: tx.Invoice.PaymentRequest,
-			Pubkey:         tx.Invoice.Pubkey,
-			Memo:           tx.Invoice.Memo,
-			Amount:         tx.Invoice.Amount,
-		}
-		res.Invoice.Amount = tx.Invoice.Amount
-		res.Invoice.Time = timestamppb.New(tx.Invoice.Timestamp)
-		if tx.Invoice.RecipientID != nil {
-			res.Invoice.RecipientId = *tx.Invoice.RecipientID
-		}
-		if tx.Invoice.RecipientUsername != nil {
-			res.Invoice.RecipientUsername = *tx.Invoice.RecipientUsername
-		}
-		if tx.Invoice.SenderID != nil {
-			res.Invoice.SenderId = *tx.Invoice.SenderID
-		}
-		if tx.Invoice.SenderUsername != nil {
-			res.Invoice.SenderUsername = *tx.Invoice.RecipientUsername
-		}
+		res.Invoice = convertInvoice(tx.Invoice, username, walletId, time.Now().UTC())
+	}
+	return res
+}
+
+// convertInvoice converts the invoice into its status and direction relative to the wallet at time now.
+func convertInvoice(invoice *models.Invoice, username, walletId string, now time.Time) *xlnrpc.Invoice {
+	res := &xlnrpc.Invoice{
+		PaymentHash:    invoice.PaymentHash,
+		PaymentRequest: invoice.PaymentRequest,
+		Pubkey:         invoice.Pubkey,
+		Memo:           invoice.Memo,
+		Amount:         invoice.Amount,
+		Time:           timestamppb.New(invoice.Timestamp),
+		Status:         invoice.Status(now),
+		Direction:      invoice.Direction(username, walletId),
+	}
+	if invoice.RecipientID != nil {
+		res.RecipientId = *invoice.RecipientID
+	}
+	if invoice.RecipientUsername != nil {
+		res.RecipientUsername = *invoice.RecipientUsername
+	}
+	if invoice.SenderID != nil {
+		res.SenderId = *invoice.SenderID
+	}
+	if invoice.SenderUsername != nil {
+		res.SenderUsername = *invoice.SenderUsername
+	}
+	if !invoice.Settled.IsZero() {
+		res.SettledAt = timestamppb.New(invoice.Settled)
+	}
+	if invoice.ExpiresAt != nil {
+		res.ExpiresAt = timestamppb.New(*invoice.ExpiresAt)
 	}
 	return res
 }
@@ -777,6 +1638,8 @@ func handleAuthErr(err error) error {
 	switch err {
 	case auth.ErrInvalidHeaderFormat, auth.ErrMissingUsername, auth.ErrParsingContext, auth.ErrMissingUsernameOrWallet:
 		st = status.New(codes.InvalidArgument, err.Error())
+	case auth.ErrInsufficientScope, auth.ErrAmountNotAllowed, auth.ErrNoPolicy:
+		st = status.New(codes.PermissionDenied, err.Error())
 	default:
 		st = status.New(codes.Unauthenticated, auth.MsgFailedAuthentication)
 	}
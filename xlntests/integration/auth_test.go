@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/xbit-gg/xln/auth"
+	"github.com/xbit-gg/xln/models"
 	"github.com/xbit-gg/xln/xlnrpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -204,3 +205,325 @@ func (s *integrationSuite) testWalletLvlMethodsUnauthorized(ctx context.Context,
 	s.Require().NotNil(walErr)
 	s.Require().Contains(walErr.Error(), expectedCode)
 }
+
+func (s *integrationSuite) TestScopedApiKeys() {
+	user1 := "user1-scoped-keys"
+	adminCtx := metadata.NewOutgoingContext(
+		context.Background(), metadata.New(map[string]string{
+			auth.AdminApiKeyHeader: s.config.XLNApiKey,
+			auth.UsernameHeader:    user1,
+		}))
+	s.createUser(adminCtx, user1)
+	s.createWallet(adminCtx, user1)
+
+	created, err := s.client.CreateApiKey(adminCtx, &xlnrpc.CreateApiKeyRequest{
+		Name:   "read only",
+		Scopes: []string{models.ScopeRead},
+	})
+	s.Require().Nil(err)
+	s.Require().NotEmpty(created.Key)
+	s.Require().Equal([]string{models.ScopeRead}, created.ApiKey.Scopes)
+	readCtx := metadata.NewOutgoingContext(
+		context.Background(), metadata.New(map[string]string{
+			auth.UserApiKeyHeader: created.Key,
+			auth.UsernameHeader:   user1,
+		}))
+
+	wallet, err := s.getWallet(readCtx, user1)
+	s.Require().Nil(err)
+	s.Require().Empty(wallet.WebhookSecret, "scoped keys cannot see webhook secrets")
+	_, err = s.client.CreateInvoice(readCtx, &xlnrpc.CreateInvoiceRequest{WalletId: user1, Value: 1000})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.PermissionDenied.String())
+	_, err = s.client.CreateApiKey(readCtx, &xlnrpc.CreateApiKeyRequest{Name: "pay", Scopes: []string{models.ScopePay}})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.PermissionDenied.String())
+
+	walletAdmin, err := s.client.CreateApiKey(adminCtx, &xlnrpc.CreateApiKeyRequest{
+		Name:     "wallet admin",
+		WalletId: user1,
+		Scopes:   []string{models.ScopeAdmin},
+	})
+	s.Require().Nil(err)
+	walletAdminCtx := metadata.NewOutgoingContext(
+		context.Background(), metadata.New(map[string]string{
+			auth.WalletApiKeyHeader: walletAdmin.Key,
+			auth.UsernameHeader:     user1,
+		}))
+	wallet, err = s.getWallet(walletAdminCtx, user1)
+	s.Require().Nil(err)
+	s.Require().NotEmpty(wallet.WebhookSecret, "keys of wallets with the admin scope have full access to the wallet")
+	_, err = s.client.ListWallets(walletAdminCtx, &xlnrpc.ListWalletsRequest{})
+	s.Require().NotNil(err, "keys of wallets cannot call the RPCs of their user")
+
+	_, err = s.client.RevokeApiKey(adminCtx, &xlnrpc.RevokeApiKeyRequest{ApiKeyId: created.ApiKey.Id})
+	s.Require().Nil(err)
+	_, err = s.getWallet(readCtx, user1)
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.Unauthenticated.String())
+}
+
+func (s *integrationSuite) TestRotateApiKey() {
+	user1 := "user1-rotate-key"
+	userRes, _ := s.createUser(metadata.NewOutgoingContext(
+		context.Background(), metadata.New(map[string]string{
+			auth.AdminApiKeyHeader: s.config.XLNApiKey,
+		})), user1)
+	userCtx := func(key string) context.Context {
+		return metadata.NewOutgoingContext(
+			context.Background(), metadata.New(map[string]string{
+				auth.UserApiKeyHeader: key,
+				auth.UsernameHeader:   user1,
+			}))
+	}
+	walletCtx := func(key string) context.Context {
+		return metadata.NewOutgoingContext(
+			context.Background(), metadata.New(map[string]string{
+				auth.WalletApiKeyHeader: key,
+				auth.UsernameHeader:     user1,
+			}))
+	}
+	_, err := s.getWallet(userCtx(userRes.ApiKey), user1)
+	s.Require().Nil(err)
+
+	rotated, err := s.client.RotateApiKey(userCtx(userRes.ApiKey), &xlnrpc.RotateApiKeyRequest{})
+	s.Require().Nil(err)
+	s.Require().NotEqual(userRes.ApiKey, rotated.ApiKey)
+	_, err = s.getWallet(userCtx(userRes.ApiKey), user1)
+	s.Require().NotNil(err, "previous key is rejected once it is rotated")
+	s.Require().Contains(err.Error(), codes.Unauthenticated.String())
+	_, err = s.getWallet(userCtx(rotated.ApiKey), user1)
+	s.Require().Nil(err)
+
+	walletKey, err := s.client.RotateApiKey(userCtx(rotated.ApiKey), &xlnrpc.RotateApiKeyRequest{WalletId: user1})
+	s.Require().Nil(err)
+	_, err = s.getWallet(walletCtx(walletKey.ApiKey), user1)
+	s.Require().Nil(err)
+	rotatedWalletKey, err := s.client.RotateApiKey(walletCtx(walletKey.ApiKey),
+		&xlnrpc.RotateApiKeyRequest{WalletId: user1})
+	s.Require().Nil(err)
+	_, err = s.getWallet(walletCtx(walletKey.ApiKey), user1)
+	s.Require().NotNil(err, "previous key is rejected once it is rotated")
+	_, err = s.getWallet(walletCtx(rotatedWalletKey.ApiKey), user1)
+	s.Require().Nil(err)
+}
+
+func (s *integrationSuite) TestMacaroons() {
+	user1 := "user1-macaroons"
+	adminCtx := metadata.NewOutgoingContext(
+		context.Background(), metadata.New(map[string]string{
+			auth.AdminApiKeyHeader: s.config.XLNApiKey,
+			auth.UsernameHeader:    user1,
+		}))
+	s.createUser(adminCtx, user1)
+	s.createWallet(adminCtx, user1)
+	macaroonCtx := func(mac string) context.Context {
+		return metadata.NewOutgoingContext(
+			context.Background(), metadata.New(map[string]string{
+				auth.MacaroonHeader: mac,
+				auth.UsernameHeader: user1,
+			}))
+	}
+
+	_, err := s.adminClient.BakeMacaroon(adminCtx, &xlnrpc.BakeMacaroonRequest{
+		Username: user1,
+		Methods:  []string{"Xln.Nonexistent"},
+	})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.InvalidArgument.String())
+
+	baked, err := s.adminClient.BakeMacaroon(adminCtx, &xlnrpc.BakeMacaroonRequest{
+		Username:      user1,
+		WalletId:      user1,
+		Methods:       []string{"Xln.GetWallet", "Xln.Transfer"},
+		MaxAmountMsat: 1000,
+	})
+	s.Require().Nil(err)
+	s.Require().NotEmpty(baked.Macaroon)
+
+	wallet, err := s.getWallet(macaroonCtx(baked.Macaroon), user1)
+	s.Require().Nil(err)
+	s.Require().Empty(wallet.WebhookSecret, "restricted macaroons cannot see webhook secrets")
+	_, err = s.client.CreateInvoice(macaroonCtx(baked.Macaroon), &xlnrpc.CreateInvoiceRequest{WalletId: user1, Value: 1000})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.PermissionDenied.String())
+	_, err = s.client.ListWallets(macaroonCtx(baked.Macaroon), &xlnrpc.ListWalletsRequest{})
+	s.Require().NotNil(err, "macaroons of wallets cannot call the RPCs of users")
+	s.Require().Contains(err.Error(), codes.PermissionDenied.String())
+	_, err = s.client.Transfer(macaroonCtx(baked.Macaroon), &xlnrpc.TransferRequest{
+		WalletId:   user1,
+		ToWalletId: user1,
+		Amount:     1001,
+	})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.PermissionDenied.String())
+	tampered := baked.Macaroon[:len(baked.Macaroon)-2] + "00"
+	if tampered == baked.Macaroon {
+		tampered = baked.Macaroon[:len(baked.Macaroon)-2] + "01"
+	}
+	_, err = s.getWallet(macaroonCtx(tampered), user1)
+	s.Require().NotNil(err, "macaroons with invalid signatures are rejected")
+	s.Require().Contains(err.Error(), codes.Unauthenticated.String())
+
+	unrestricted, err := s.adminClient.BakeMacaroon(adminCtx, &xlnrpc.BakeMacaroonRequest{Username: user1})
+	s.Require().Nil(err)
+	_, err = s.client.ListWallets(macaroonCtx(unrestricted.Macaroon), &xlnrpc.ListWalletsRequest{})
+	s.Require().Nil(err)
+	_, err = s.client.ListWallets(metadata.NewOutgoingContext(
+		context.Background(), metadata.New(map[string]string{
+			auth.MacaroonHeader: unrestricted.Macaroon,
+			auth.UsernameHeader: "other-user",
+		})), &xlnrpc.ListWalletsRequest{})
+	s.Require().NotNil(err, "macaroons only authenticate the user they were baked for")
+	s.Require().Contains(err.Error(), codes.Unauthenticated.String())
+}
+
+func (s *integrationSuite) TestRestrictedMacaroonsCannotManageKeys() {
+	user1 := "user1-macaroonkeys"
+	adminCtx := metadata.NewOutgoingContext(
+		context.Background(), metadata.New(map[string]string{
+			auth.AdminApiKeyHeader: s.config.XLNApiKey,
+			auth.UsernameHeader:    user1,
+		}))
+	s.createUser(adminCtx, user1)
+	s.createWallet(adminCtx, user1)
+	capped, err := s.adminClient.BakeMacaroon(adminCtx, &xlnrpc.BakeMacaroonRequest{
+		Username:      user1,
+		MaxAmountMsat: 1000,
+	})
+	s.Require().Nil(err)
+	macaroonCtx := metadata.NewOutgoingContext(
+		context.Background(), metadata.New(map[string]string{
+			auth.MacaroonHeader: capped.Macaroon,
+			auth.UsernameHeader: user1,
+		}))
+
+	_, err = s.client.CreateApiKey(macaroonCtx, &xlnrpc.CreateApiKeyRequest{
+		Name:   "escape",
+		Scopes: []string{models.ScopeAdmin},
+	})
+	s.Require().NotNil(err, "keys created by restricted macaroons would not be restricted by their caveats")
+	s.Require().Contains(err.Error(), codes.PermissionDenied.String())
+	_, err = s.client.RotateApiKey(macaroonCtx, &xlnrpc.RotateApiKeyRequest{})
+	s.Require().NotNil(err, "restricted macaroons cannot take over the key of their user")
+	s.Require().Contains(err.Error(), codes.PermissionDenied.String())
+	_, err = s.client.RotateApiKey(macaroonCtx, &xlnrpc.RotateApiKeyRequest{WalletId: user1})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.PermissionDenied.String())
+	_, err = s.client.ListWallets(macaroonCtx, &xlnrpc.ListWalletsRequest{})
+	s.Require().Nil(err, "the macaroon may still call the RPCs that its caveats allow")
+}
+
+func (s *integrationSuite) TestAdminRoles() {
+	user1 := "user1-adminroles"
+	bootstrapCtx := metadata.NewOutgoingContext(
+		context.Background(), metadata.New(map[string]string{
+			auth.AdminApiKeyHeader: s.config.XLNApiKey,
+			auth.UsernameHeader:    user1,
+		}))
+	s.createUser(bootstrapCtx, user1)
+	s.createWallet(bootstrapCtx, user1)
+	adminCtx := func(key string) context.Context {
+		return metadata.NewOutgoingContext(
+			context.Background(), metadata.New(map[string]string{
+				auth.AdminApiKeyHeader: key,
+				auth.UsernameHeader:    user1,
+			}))
+	}
+
+	_, err := s.adminClient.CreateAdmin(bootstrapCtx, &xlnrpc.CreateAdminRequest{Name: "root", Role: "superuser"})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.InvalidArgument.String())
+	auditor, err := s.adminClient.CreateAdmin(bootstrapCtx, &xlnrpc.CreateAdminRequest{
+		Name: "auditor",
+		Role: models.AdminRoleAuditor,
+	})
+	s.Require().Nil(err)
+	s.Require().NotEmpty(auditor.ApiKey)
+	support, err := s.adminClient.CreateAdmin(bootstrapCtx, &xlnrpc.CreateAdminRequest{
+		Name: "support",
+		Role: models.AdminRoleSupport,
+	})
+	s.Require().Nil(err)
+	_, err = s.adminClient.CreateAdmin(bootstrapCtx, &xlnrpc.CreateAdminRequest{
+		Name: "support",
+		Role: models.AdminRoleSupport,
+	})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.AlreadyExists.String())
+
+	// auditors may only read
+	_, err = s.adminClient.ListUsers(adminCtx(auditor.ApiKey), &xlnrpc.ListUsersRequest{})
+	s.Require().Nil(err)
+	wallet, err := s.getWallet(adminCtx(auditor.ApiKey), user1)
+	s.Require().Nil(err)
+	s.Require().Empty(wallet.WebhookSecret, "admins without the admin role cannot see webhook secrets")
+	_, err = s.client.CreateInvoice(adminCtx(auditor.ApiKey), &xlnrpc.CreateInvoiceRequest{WalletId: user1, Value: 1000})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.PermissionDenied.String())
+	_, err = s.adminClient.UpdateWallet(adminCtx(auditor.ApiKey), &xlnrpc.UpdateWalletRequest{
+		Username: user1,
+		WalletId: user1,
+		Lock:     true,
+	})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.PermissionDenied.String())
+
+	// support operators may also lock wallets, but not change anything else
+	_, err = s.adminClient.UpdateWallet(adminCtx(support.ApiKey), &xlnrpc.UpdateWalletRequest{
+		Username: user1,
+		WalletId: user1,
+		Lock:     true,
+	})
+	s.Require().Nil(err)
+	_, err = s.adminClient.UpdateWallet(adminCtx(support.ApiKey), &xlnrpc.UpdateWalletRequest{
+		Username:      user1,
+		WalletId:      user1,
+		UpdateBalance: true,
+		Balance:       1000,
+	})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.PermissionDenied.String())
+	_, err = s.adminClient.UpdateWallet(adminCtx(support.ApiKey), &xlnrpc.UpdateWalletRequest{
+		Username:       user1,
+		WalletId:       user1,
+		Unlock:         true,
+		SpendingPolicy: &xlnrpc.SpendingPolicy{},
+	})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.PermissionDenied.String())
+	_, err = s.adminClient.CreateAdmin(adminCtx(support.ApiKey), &xlnrpc.CreateAdminRequest{
+		Name: "escalated",
+		Role: models.AdminRoleAdmin,
+	})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.PermissionDenied.String())
+
+	// roles and keys of admins apply to their next request
+	updated, err := s.adminClient.UpdateAdminRole(bootstrapCtx, &xlnrpc.UpdateAdminRoleRequest{
+		Name: "auditor",
+		Role: models.AdminRoleAdmin,
+	})
+	s.Require().Nil(err)
+	s.Require().Equal(models.AdminRoleAdmin, updated.Admin.Role)
+	admins, err := s.adminClient.ListAdmins(adminCtx(auditor.ApiKey), &xlnrpc.ListAdminsRequest{})
+	s.Require().Nil(err)
+	s.Require().Len(admins.Admins, 2)
+	rotated, err := s.adminClient.RotateAdminKey(adminCtx(auditor.ApiKey), &xlnrpc.RotateAdminKeyRequest{
+		Name: "support",
+	})
+	s.Require().Nil(err)
+	_, err = s.adminClient.ListUsers(adminCtx(support.ApiKey), &xlnrpc.ListUsersRequest{})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.Unauthenticated.String())
+	_, err = s.adminClient.DeleteAdmin(adminCtx(auditor.ApiKey), &xlnrpc.DeleteAdminRequest{Name: "support"})
+	s.Require().Nil(err)
+	_, err = s.adminClient.ListUsers(adminCtx(rotated.ApiKey), &xlnrpc.ListUsersRequest{})
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), codes.Unauthenticated.String())
+
+	identity, err := s.client.GetInfo(context.Background(), &xlnrpc.GetInfoRequest{ApiKey: auditor.ApiKey})
+	s.Require().Nil(err)
+	s.Require().True(identity.Identity.Admin)
+	s.Require().Equal(models.AdminRoleAdmin, identity.Identity.Role)
+}
@@ -194,9 +194,9 @@ func (s *integrationSuite) TestInvoicesAsWallet() {
 			auth.AdminApiKeyHeader: s.config.XLNApiKey,
 			auth.UsernameHeader:    u2,
 		}))
-	u1w1res, _ := s.getWallet(adminCtxU1, u1)
+	u1w1res, _ := s.client.RotateApiKey(adminCtxU1, &xlnrpc.RotateApiKeyRequest{WalletId: u1})
 	u1w2res, _ := s.createWallet(adminCtxU1, u1w2)
-	u2w1res, _ := s.getWallet(adminCtxU2, u2)
+	u2w1res, _ := s.client.RotateApiKey(adminCtxU2, &xlnrpc.RotateApiKeyRequest{WalletId: u2})
 	u2w2res, _ := s.createWallet(adminCtxU2, u2w2)
 
 	ctxU1W1 := metadata.NewOutgoingContext(
@@ -239,9 +239,8 @@ func (s *integrationSuite) testCreateWalletGetWalletListWallet(ctx context.Conte
 	s.Require().Equal(uint64(0), getWal1Res1.Balance, "default wallet's balance should be initially 0")
 	s.Require().Equal(false, getWal1Res1.Locked, "default wallet's should not be initially locked")
 	s.Require().WithinDuration(time.Now(), getWal1Res1.CreationTime.AsTime(), time.Minute)
-	s.Require().NotEmpty(getWal1Res1.ApiKey)
-	s.Require().Equal(44, len(getWal1Res1.ApiKey))
-	s.Require().IsType("string", getWal1Res1.ApiKey)
+	s.Require().NotEmpty(getWal1Res1.WebhookSecret)
+	s.Require().Equal(44, len(getWal1Res1.WebhookSecret))
 
 	// list wallets
 	listWalRes1, err := s.listWallets(ctx, true)
@@ -369,7 +368,9 @@ func (s *integrationSuite) testInvoices(ctxU1W1, ctxU1W2, ctxU2W1, ctxU2W2 conte
 	s.Require().Nil(err)
 	s.Require().NotNil(invcsUsr1W2)
 	s.Require().Equal(1, len(invcsUsr1W2.Invoices))
-	s.Require().Equal(invc1.PaymentHash, invcsUsr1W2.Invoices[0])
+	s.Require().Equal(invc1.PaymentHash, invcsUsr1W2.Invoices[0].PaymentHash)
+	s.Require().Equal(models.InvoiceStatusPending, invcsUsr1W2.Invoices[0].Status)
+	s.Require().Equal(models.InvoiceDirectionIncoming, invcsUsr1W2.Invoices[0].Direction)
 
 	// create 2nd invoice and confirm invoices are recorded properly
 	createInv2, err := s.client.CreateInvoice(ctxU2W2, &xlnrpc.CreateInvoiceRequest{WalletId: u2w2, Value: 4000, Memo: "test-invoice"})
@@ -397,7 +398,7 @@ func (s *integrationSuite) testInvoices(ctxU1W1, ctxU1W2, ctxU2W1, ctxU2W2 conte
 	s.Require().Nil(err)
 	s.Require().NotNil(invcsUsr2)
 	s.Require().Equal(1, len(invcsUsr2.Invoices))
-	s.Require().Equal(invc2.PaymentHash, invcsUsr2.Invoices[0])
+	s.Require().Equal(invc2.PaymentHash, invcsUsr2.Invoices[0].PaymentHash)
 
 	pndInvcsAdmin, err := s.adminClient.ListPendingInvoices(adminCtxt, &xlnrpc.ListPendingInvoicesRequest{})
 	s.Require().Nil(err)
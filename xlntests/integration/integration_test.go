@@ -88,9 +88,21 @@ func (s *integrationSuite) BeforeTest(_, _ string) {
 	s.db.Unscoped().Where("1 = 1").Delete(&models.PendingInvoice{})
 	s.db.Unscoped().Where("1 = 1").Delete(&models.PendingPayment{})
 	s.db.Unscoped().Where("1 = 1").Delete(&models.Transaction{})
+	s.db.Unscoped().Where("1 = 1").Delete(&models.LedgerEntry{})
 	s.db.Unscoped().Where("1 = 1").Delete(&models.User{})
 	s.db.Unscoped().Where("1 = 1").Delete(&models.Wallet{})
 	s.db.Unscoped().Where("1 = 1").Delete(&models.Withdraw{})
+	s.db.Unscoped().Where("1 = 1").Delete(&models.Pay{})
+	s.db.Unscoped().Where("1 = 1").Delete(&models.WebhookDelivery{})
+	s.db.Unscoped().Where("1 = 1").Delete(&models.Webhook{})
+	s.db.Unscoped().Where("1 = 1").Delete(&models.IdempotencyKey{})
+	s.db.Unscoped().Where("1 = 1").Delete(&models.SpendingPolicy{})
+	s.db.Unscoped().Where("1 = 1").Delete(&models.PaymentDefaults{})
+	s.db.Unscoped().Where("1 = 1").Delete(&models.FeeSchedule{})
+	s.db.Unscoped().Where("1 = 1").Delete(&models.ScheduleRun{})
+	s.db.Unscoped().Where("1 = 1").Delete(&models.Schedule{})
+	s.db.Unscoped().Where("1 = 1").Delete(&models.ApiKey{})
+	s.db.Unscoped().Where("1 = 1").Delete(&models.Admin{})
 }
 
 func (s *integrationSuite) createUser(ctx context.Context, username string) (*xlnrpc.CreateUserResponse, error) {
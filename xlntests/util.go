@@ -83,6 +83,10 @@ func SetupPostgres(dbConnStr string) (postgres *db.DB, err error) {
 	if err != nil {
 		return nil, err
 	}
+	err = postgres.Migrator().DropTable(&models.LedgerEntry{})
+	if err != nil {
+		return nil, err
+	}
 	err = postgres.Migrator().DropTable(&models.PendingInvoice{})
 	if err != nil {
 		return nil, err
@@ -95,6 +99,54 @@ func SetupPostgres(dbConnStr string) (postgres *db.DB, err error) {
 	if err != nil {
 		return nil, err
 	}
+	err = postgres.Migrator().DropTable(&models.Pay{})
+	if err != nil {
+		return nil, err
+	}
+	err = postgres.Migrator().DropTable(&models.WebhookDelivery{})
+	if err != nil {
+		return nil, err
+	}
+	err = postgres.Migrator().DropTable(&models.Webhook{})
+	if err != nil {
+		return nil, err
+	}
+	err = postgres.Migrator().DropTable(&models.IdempotencyKey{})
+	if err != nil {
+		return nil, err
+	}
+	err = postgres.Migrator().DropTable(&models.SpendingPolicy{})
+	if err != nil {
+		return nil, err
+	}
+	err = postgres.Migrator().DropTable(&models.PaymentDefaults{})
+	if err != nil {
+		return nil, err
+	}
+	err = postgres.Migrator().DropTable(&models.FeeSchedule{})
+	if err != nil {
+		return nil, err
+	}
+	err = postgres.Migrator().DropTable(&models.ScheduleRun{})
+	if err != nil {
+		return nil, err
+	}
+	err = postgres.Migrator().DropTable(&models.Schedule{})
+	if err != nil {
+		return nil, err
+	}
+	err = postgres.Migrator().DropTable(&models.ApiKey{})
+	if err != nil {
+		return nil, err
+	}
+	err = postgres.Migrator().DropTable(&models.MacaroonRootKey{})
+	if err != nil {
+		return nil, err
+	}
+	err = postgres.Migrator().DropTable(&models.Admin{})
+	if err != nil {
+		return nil, err
+	}
 
 	if tables, err := postgres.Migrator().GetTables(); err != nil {
 		return nil, err